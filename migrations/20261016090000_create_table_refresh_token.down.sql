@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS refresh_tokens;
+DROP SEQUENCE IF EXISTS refresh_token_id_seq;
\ No newline at end of file
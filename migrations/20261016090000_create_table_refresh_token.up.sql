@@ -0,0 +1,26 @@
+CREATE SEQUENCE refresh_token_id_seq;
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+  id integer primary key DEFAULT nextval('refresh_token_id_seq'),
+  account_id integer NOT NULL,
+  role_id integer NOT NULL,
+  family uuid NOT NULL,
+  token_hash varchar(64) unique NOT NULL,
+  expired_at timestamp WITH TIME ZONE NOT NULL,
+  rotated_at timestamp WITH TIME ZONE,
+  revoked_at timestamp WITH TIME ZONE,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE refresh_token_id_seq OWNED BY refresh_tokens.id;
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family ON refresh_tokens (family);
+
+ALTER TABLE "refresh_tokens" ADD CONSTRAINT fk_refresh_tokens_a_key FOREIGN KEY("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "refresh_tokens" ADD CONSTRAINT fk_refresh_tokens_r_key FOREIGN KEY("role_id") REFERENCES "roles" ("id") ON DELETE CASCADE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS signing_keys;
+DROP SEQUENCE IF EXISTS signing_key_id_seq;
\ No newline at end of file
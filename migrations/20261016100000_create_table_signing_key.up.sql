@@ -0,0 +1,18 @@
+CREATE SEQUENCE signing_key_id_seq;
+
+CREATE TABLE IF NOT EXISTS signing_keys (
+  id integer primary key DEFAULT nextval('signing_key_id_seq'),
+  kid varchar(64) unique NOT NULL,
+  algorithm varchar(10) NOT NULL,
+  private_key text NOT NULL,
+  public_key text NOT NULL,
+  expired_at timestamp WITH TIME ZONE NOT NULL,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE signing_key_id_seq OWNED BY signing_keys.id;
@@ -0,0 +1 @@
+ALTER TABLE "roles" ADD COLUMN redirect_uris text default '' NOT NULL;
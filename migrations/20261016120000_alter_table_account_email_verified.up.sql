@@ -0,0 +1,3 @@
+ALTER TABLE "accounts" ADD COLUMN email_verified_at timestamp WITH TIME ZONE;
+
+UPDATE "accounts" SET email_verified_at = created_at;
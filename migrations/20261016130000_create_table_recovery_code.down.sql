@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS recovery_codes;
+
+ALTER TABLE "roles" DROP COLUMN mfa_required;
+
+ALTER TABLE "accounts" DROP COLUMN mfa_enabled_at;
+
+ALTER TABLE "accounts" DROP COLUMN mfa_secret;
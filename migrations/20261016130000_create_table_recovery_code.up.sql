@@ -0,0 +1,28 @@
+ALTER TABLE "accounts" ADD COLUMN mfa_secret text default '' NOT NULL;
+
+ALTER TABLE "accounts" ADD COLUMN mfa_enabled_at timestamp WITH TIME ZONE;
+
+ALTER TABLE "roles" ADD COLUMN mfa_required boolean default false NOT NULL;
+
+UPDATE "roles" SET mfa_required = true WHERE scope = 'sup';
+
+CREATE SEQUENCE recovery_code_id_seq;
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+  id integer primary key DEFAULT nextval('recovery_code_id_seq'),
+  account_id integer NOT NULL,
+  code_hash varchar(64) NOT NULL,
+  used_at timestamp WITH TIME ZONE,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE recovery_code_id_seq OWNED BY recovery_codes.id;
+
+CREATE INDEX IF NOT EXISTS idx_recovery_codes_account_id ON recovery_codes (account_id);
+
+ALTER TABLE "recovery_codes" ADD CONSTRAINT fk_recovery_codes_a_key FOREIGN KEY("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS password_histories;
+
+ALTER TABLE "roles" DROP COLUMN password_max_age_days;
+
+ALTER TABLE "accounts" DROP COLUMN password_changed_at;
@@ -0,0 +1,28 @@
+ALTER TABLE "accounts" ADD COLUMN password_changed_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL;
+
+ALTER TABLE "roles" ADD COLUMN password_max_age_days integer default 0 NOT NULL;
+
+UPDATE "roles" SET password_max_age_days = 90 WHERE scope = 'sup';
+
+CREATE SEQUENCE password_history_id_seq;
+
+CREATE TABLE IF NOT EXISTS password_histories (
+  id integer primary key DEFAULT nextval('password_history_id_seq'),
+  account_id integer NOT NULL,
+  password text NOT NULL,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE password_history_id_seq OWNED BY password_histories.id;
+
+CREATE INDEX IF NOT EXISTS idx_password_histories_account_id ON password_histories (account_id, created_at DESC);
+
+ALTER TABLE "password_histories" ADD CONSTRAINT fk_password_histories_a_key FOREIGN KEY("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+INSERT INTO password_histories (account_id, password, created_by, updated_by)
+SELECT id, password, id, id FROM accounts WHERE deleted_at IS NULL;
@@ -0,0 +1,56 @@
+CREATE SEQUENCE permission_id_seq;
+
+CREATE TABLE IF NOT EXISTS permissions (
+  id integer primary key DEFAULT nextval('permission_id_seq'),
+  name varchar(100) NOT NULL,
+  description text default '' NOT NULL,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE permission_id_seq OWNED BY permissions.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_permissions_name ON permissions (name) WHERE deleted_at IS NULL;
+
+CREATE SEQUENCE role_permission_id_seq;
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+  id integer primary key DEFAULT nextval('role_permission_id_seq'),
+  role_id integer NOT NULL,
+  permission_id integer NOT NULL,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE role_permission_id_seq OWNED BY role_permissions.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_role_permissions_role_id_permission_id ON role_permissions (role_id, permission_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE "role_permissions" ADD CONSTRAINT fk_role_permissions_r_key FOREIGN KEY("role_id") REFERENCES "roles" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "role_permissions" ADD CONSTRAINT fk_role_permissions_p_key FOREIGN KEY("permission_id") REFERENCES "permissions" ("id") ON DELETE CASCADE;
+
+INSERT INTO permissions(name, description, created_by, updated_by)
+	VALUES ('account:read', 'List and read any account', 1, 1),
+  ('account:write', 'Update any account', 1, 1),
+  ('account:delete', 'Delete any account', 1, 1),
+  ('account:unlock', 'Lift the login lockout of an account', 1, 1),
+  ('role:read', 'List and read roles and their permissions', 1, 1),
+  ('role:write', 'Create and update roles, grant and revoke their permissions', 1, 1),
+  ('role:delete', 'Delete roles', 1, 1),
+  ('account-role:read', 'List and read account roles', 1, 1),
+  ('account-role:delete', 'Delete any account role', 1, 1),
+  ('permission:read', 'List and read permissions', 1, 1),
+  ('permission:write', 'Create and update permissions', 1, 1),
+  ('permission:delete', 'Delete permissions', 1, 1);
+
+INSERT INTO role_permissions(role_id, permission_id, created_by, updated_by)
+	SELECT r.id, p.id, 1, 1 FROM roles r CROSS JOIN permissions p WHERE r.scope = 'sup';
@@ -0,0 +1 @@
+ALTER TABLE "refresh_tokens" DROP COLUMN scope;
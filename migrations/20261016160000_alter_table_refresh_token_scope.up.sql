@@ -0,0 +1 @@
+ALTER TABLE "refresh_tokens" ADD COLUMN scope text default '' NOT NULL;
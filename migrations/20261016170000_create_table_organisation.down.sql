@@ -0,0 +1,9 @@
+DELETE FROM permissions WHERE name LIKE 'organisation:%';
+
+ALTER TABLE "refresh_tokens" DROP COLUMN organisation_id;
+
+ALTER TABLE "account_roles" DROP COLUMN organisation_id;
+
+DROP TABLE IF EXISTS organisation_members;
+
+DROP TABLE IF EXISTS organisations;
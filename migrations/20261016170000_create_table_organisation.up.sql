@@ -0,0 +1,56 @@
+CREATE SEQUENCE organisation_id_seq;
+
+CREATE TABLE IF NOT EXISTS organisations (
+  id integer primary key DEFAULT nextval('organisation_id_seq'),
+  code varchar(50) NOT NULL,
+  name varchar(255) NOT NULL,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE organisation_id_seq OWNED BY organisations.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organisations_code ON organisations (code) WHERE deleted_at IS NULL;
+
+CREATE SEQUENCE organisation_member_id_seq;
+
+CREATE TABLE IF NOT EXISTS organisation_members (
+  id integer primary key DEFAULT nextval('organisation_member_id_seq'),
+  organisation_id integer NOT NULL,
+  account_id integer NOT NULL,
+  role varchar(10) NOT NULL,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE organisation_member_id_seq OWNED BY organisation_members.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organisation_members_organisation_id_account_id ON organisation_members (organisation_id, account_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE "organisation_members" ADD CONSTRAINT fk_organisation_members_o_key FOREIGN KEY("organisation_id") REFERENCES "organisations" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "organisation_members" ADD CONSTRAINT fk_organisation_members_a_key FOREIGN KEY("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "account_roles" ADD COLUMN organisation_id integer;
+
+ALTER TABLE "account_roles" ADD CONSTRAINT fk_account_roles_o_key FOREIGN KEY("organisation_id") REFERENCES "organisations" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "refresh_tokens" ADD COLUMN organisation_id integer;
+
+ALTER TABLE "refresh_tokens" ADD CONSTRAINT fk_refresh_tokens_o_key FOREIGN KEY("organisation_id") REFERENCES "organisations" ("id") ON DELETE CASCADE;
+
+INSERT INTO permissions(name, description, created_by, updated_by)
+	VALUES ('organisation:read', 'List and read any organisation and its members', 1, 1),
+  ('organisation:write', 'Create and update organisations, manage the members of any organisation', 1, 1),
+  ('organisation:delete', 'Delete organisations', 1, 1);
+
+INSERT INTO role_permissions(role_id, permission_id, created_by, updated_by)
+	SELECT r.id, p.id, 1, 1 FROM roles r CROSS JOIN permissions p WHERE r.scope = 'sup' AND p.name LIKE 'organisation:%';
@@ -0,0 +1,3 @@
+DELETE FROM permissions WHERE name LIKE 'invitation:%';
+
+DROP TABLE IF EXISTS invitations;
@@ -0,0 +1,36 @@
+CREATE SEQUENCE invitation_id_seq;
+
+CREATE TABLE IF NOT EXISTS invitations (
+  id integer primary key DEFAULT nextval('invitation_id_seq'),
+  email varchar(100) NOT NULL,
+  role_id integer NOT NULL,
+  organisation_id integer,
+  organisation_role varchar(10) default '' NOT NULL,
+  account_id integer,
+  expired_at timestamp WITH TIME ZONE NOT NULL,
+  accepted_at timestamp WITH TIME ZONE,
+  revoked_at timestamp WITH TIME ZONE,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE invitation_id_seq OWNED BY invitations.id;
+
+CREATE INDEX IF NOT EXISTS idx_invitations_email ON invitations (email);
+
+ALTER TABLE "invitations" ADD CONSTRAINT fk_invitations_r_key FOREIGN KEY("role_id") REFERENCES "roles" ("id");
+
+ALTER TABLE "invitations" ADD CONSTRAINT fk_invitations_o_key FOREIGN KEY("organisation_id") REFERENCES "organisations" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "invitations" ADD CONSTRAINT fk_invitations_a_key FOREIGN KEY("account_id") REFERENCES "accounts" ("id") ON DELETE SET NULL;
+
+INSERT INTO permissions(name, description, created_by, updated_by)
+	VALUES ('invitation:read', 'List and read any invitation', 1, 1),
+  ('invitation:write', 'Invite staff to any role or organisation and revoke invitations', 1, 1);
+
+INSERT INTO role_permissions(role_id, permission_id, created_by, updated_by)
+	SELECT r.id, p.id, 1, 1 FROM roles r CROSS JOIN permissions p WHERE r.scope = 'sup' AND p.name LIKE 'invitation:%';
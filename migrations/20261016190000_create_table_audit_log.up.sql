@@ -0,0 +1,29 @@
+CREATE SEQUENCE audit_log_id_seq;
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+  id integer primary key DEFAULT nextval('audit_log_id_seq'),
+  action varchar(50) NOT NULL,
+  actor_id integer NOT NULL,
+  subject_id integer NOT NULL,
+  token_id varchar(64) default '' NOT NULL,
+  reason text default '' NOT NULL,
+  ip_address varchar(45) default '' NOT NULL,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE audit_log_id_seq OWNED BY audit_logs.id;
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_id ON audit_logs (actor_id);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_subject_id ON audit_logs (subject_id);
+
+INSERT INTO permissions(name, description, created_by, updated_by)
+	VALUES ('audit-log:read', 'List the audit log, such as impersonations', 1, 1);
+
+INSERT INTO role_permissions(role_id, permission_id, created_by, updated_by)
+	SELECT r.id, p.id, 1, 1 FROM roles r CROSS JOIN permissions p WHERE r.scope = 'sup' AND p.name = 'audit-log:read';
@@ -0,0 +1,26 @@
+CREATE SEQUENCE api_key_id_seq;
+
+CREATE TABLE IF NOT EXISTS api_keys (
+  id integer primary key DEFAULT nextval('api_key_id_seq'),
+  account_id integer NOT NULL,
+  name varchar(100) NOT NULL,
+  prefix varchar(16) NOT NULL,
+  key_hash varchar(64) NOT NULL,
+  scope text default '' NOT NULL,
+  expired_at timestamp WITH TIME ZONE NOT NULL,
+  last_used_at timestamp WITH TIME ZONE,
+  created_by integer default 0 NOT NULL,
+  created_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_by integer default 0 NOT NULL,
+  updated_at timestamp WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  deleted_by integer,
+  deleted_at timestamp WITH TIME ZONE
+);
+
+ALTER SEQUENCE api_key_id_seq OWNED BY api_keys.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_prefix ON api_keys (prefix);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_account_id ON api_keys (account_id);
+
+ALTER TABLE "api_keys" ADD CONSTRAINT fk_api_keys_a_key FOREIGN KEY("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
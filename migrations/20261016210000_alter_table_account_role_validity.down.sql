@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS account_roles_valid_until_idx;
+ALTER TABLE "account_roles" DROP COLUMN valid_until;
+ALTER TABLE "account_roles" DROP COLUMN valid_from;
@@ -0,0 +1,3 @@
+ALTER TABLE "account_roles" ADD COLUMN valid_from timestamptz;
+ALTER TABLE "account_roles" ADD COLUMN valid_until timestamptz;
+CREATE INDEX IF NOT EXISTS account_roles_valid_until_idx ON "account_roles" (valid_until) WHERE valid_until IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,3 @@
+DELETE FROM permissions WHERE name = 'account-role:write';
+
+UPDATE permissions SET description = 'Update any account' WHERE name = 'account:write';
@@ -0,0 +1,7 @@
+UPDATE permissions SET description = 'Create and update any account' WHERE name = 'account:write';
+
+INSERT INTO permissions(name, description, created_by, updated_by)
+	VALUES ('account-role:write', 'Grant roles to any account', 1, 1);
+
+INSERT INTO role_permissions(role_id, permission_id, created_by, updated_by)
+	SELECT r.id, p.id, 1, 1 FROM roles r CROSS JOIN permissions p WHERE r.scope = 'sup' AND p.name = 'account-role:write';
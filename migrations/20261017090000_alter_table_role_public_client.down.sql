@@ -0,0 +1 @@
+ALTER TABLE "roles" DROP COLUMN public_client;
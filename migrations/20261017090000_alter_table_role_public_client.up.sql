@@ -0,0 +1 @@
+ALTER TABLE "roles" ADD COLUMN public_client boolean default false NOT NULL;
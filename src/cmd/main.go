@@ -3,13 +3,18 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/achwanyusuf/carrent-accountsvc/conf"
 	"github.com/achwanyusuf/carrent-accountsvc/docs"
 	"github.com/achwanyusuf/carrent-accountsvc/src/domain"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/cache"
 	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
 	"github.com/achwanyusuf/carrent-accountsvc/src/usecase"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/passwordhash"
 	"github.com/achwanyusuf/carrent-lib/pkg/httpserver"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
 	"github.com/achwanyusuf/carrent-lib/pkg/migration"
@@ -27,7 +32,8 @@ import (
 // @tokenUrl %s
 var (
 	staticConfPath, Namespace, BuildTime, Version string
-	migrateup, migratedown                        bool
+	migrateup, migratedown, calibratehash         bool
+	calibratetarget                               time.Duration
 	OAuth2PasswordTokenUrl                        string
 )
 
@@ -35,12 +41,22 @@ func main() {
 	flag.StringVar(&staticConfPath, "staticConfPath", "./conf/conf.yaml", "config path")
 	flag.BoolVar(&migrateup, "migrateup", false, "run migration up")
 	flag.BoolVar(&migratedown, "migratedown", false, "run migration up")
+	flag.BoolVar(&calibratehash, "calibratehash", false, "benchmark argon2id and print password_hash parameters for this host")
+	flag.DurationVar(&calibratetarget, "calibratetarget", model.DefaultPasswordHashCalibration, "time one password hash may take when calibrating")
 	flag.Parse()
 	cfg, err := conf.New(staticConfPath)
 	if err != nil {
 		panic(err)
 	}
 
+	if calibratehash {
+		hashConf, took := passwordhash.Calibrate(cfg.Usecase.PasswordHash, calibratetarget)
+		fmt.Printf("# one hash takes %s on this host\n", took.Round(time.Millisecond))
+		fmt.Printf("password_hash:\n    algorithm: %q\n    argon2_memory: %d\n    argon2_iterations: %d\n    argon2_parallelism: %d\n    argon2_salt_length: %d\n    argon2_key_length: %d\n",
+			hashConf.Algorithm, hashConf.Argon2Memory, hashConf.Argon2Iterations, hashConf.Argon2Parallelism, hashConf.Argon2SaltLength, hashConf.Argon2KeyLength)
+		return
+	}
+
 	if Version == "" {
 		Version = "v1.0.0"
 	}
@@ -85,6 +101,8 @@ func main() {
 		DB:    psql,
 		Redis: redis,
 	})
+	// evict the local cache entries invalidated by other instances
+	go cache.Listen(context.Background(), redis)
 
 	// init usecase
 	uc := usecase.New(&usecase.UsecaseDep{
@@ -92,6 +110,11 @@ func main() {
 		Log:    &log,
 		Domain: dom,
 	})
+	// keep signing keys rotated and the verification key set fresh
+	go uc.Token.Run(context.Background())
+	// expire time-bounded role grants and the tokens issued under them
+	go uc.GrantExpiry.Run(context.Background())
+
 	cfg.App.Swagger.Title = Namespace
 	cfg.App.Swagger.Version = Version
 	// setup http server
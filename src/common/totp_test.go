@@ -0,0 +1,78 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA1 seed of the RFC 6238 test vectors,
+// "12345678901234567890", in base32.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 appendix B, the 8 digit codes cut to TOTPDigits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		code, err := TOTPCode(rfc6238Secret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("%d: %v", tt.unix, err)
+		}
+		if code != tt.code {
+			t.Errorf("%d: got %s, want %s", tt.unix, code, tt.code)
+		}
+	}
+}
+
+func TestTOTPCodeInvalidSecret(t *testing.T) {
+	if _, err := TOTPCode("not base32!", time.Now()); err == nil {
+		t.Fatal("got no error")
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := now.Unix() / TOTPPeriod
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+		step   int64
+		ok     bool
+	}{
+		{"current step", rfc6238Secret, "050471", step, true},
+		{"lowercase secret", "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", "050471", step, true},
+		{"previous step", rfc6238Secret, totpAt(t, now.Add(-TOTPPeriod*time.Second)), step - 1, true},
+		{"next step", rfc6238Secret, totpAt(t, now.Add(TOTPPeriod*time.Second)), step + 1, true},
+		{"beyond skew", rfc6238Secret, totpAt(t, now.Add(-2*TOTPPeriod*time.Second)), 0, false},
+		{"wrong code", rfc6238Secret, "000000", 0, false},
+		{"wrong length", rfc6238Secret, "50471", 0, false},
+		{"invalid secret", "not base32!", "050471", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := ValidateTOTP(tt.secret, tt.code, now)
+			if ok != tt.ok || step != tt.step {
+				t.Fatalf("got %d, %v, want %d, %v", step, ok, tt.step, tt.ok)
+			}
+		})
+	}
+}
+
+func totpAt(t *testing.T, at time.Time) string {
+	t.Helper()
+	code, err := TOTPCode(rfc6238Secret, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
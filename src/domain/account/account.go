@@ -2,31 +2,23 @@ package account
 
 import (
 	"database/sql"
-	"encoding/json"
-	"fmt"
-	"time"
 
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/repository"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
-	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
-	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
 
 	"github.com/gin-gonic/gin"
 	goredislib "github.com/redis/go-redis/v9"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type AccountDep struct {
-	Log   logger.Logger
-	DB    *sql.DB
-	Redis *goredislib.Client
-	Conf  Conf
+	*repository.Repository[psqlmodel.Account, *psqlmodel.Account, psqlmodel.AccountSlice, *model.GetAccountByParam, *model.GetAccountsByParam]
 }
 
-type Conf struct {
-	DefaultPageLimit    int           `mapstructure:"page_limit"`
-	RedisExpirationTime time.Duration `mapstructure:"expiration_time"`
-}
+type Conf = repository.Conf
 
 type AccountInterface interface {
 	Insert(ctx *gin.Context, data *psqlmodel.Account) error
@@ -36,129 +28,33 @@ type AccountInterface interface {
 	GetByParam(ctx *gin.Context, cacheControl string, param *model.GetAccountsByParam) (psqlmodel.AccountSlice, model.Pagination, error)
 }
 
-func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client) AccountInterface {
-	return &AccountDep{
-		Log:   *log,
-		DB:    db,
-		Redis: rds,
-		Conf:  conf,
-	}
-}
-
-func (a *AccountDep) Insert(ctx *gin.Context, data *psqlmodel.Account) error {
-	return a.insertPSQL(ctx, data)
+// schema caches an account under its id, a hard delete cascades to the
+// account roles, organisation memberships and invitations of the account.
+var schema = repository.Schema[psqlmodel.Account, psqlmodel.AccountSlice]{
+	Name: "account",
+	Query: func(mods ...qm.QueryMod) repository.Query[psqlmodel.Account, psqlmodel.AccountSlice] {
+		return psqlmodel.Accounts(mods...)
+	},
+	ID: func(a *psqlmodel.Account) int {
+		return a.ID
+	},
+	SetDeletedBy: func(a *psqlmodel.Account, id int) {
+		a.DeletedBy = null.NewInt(id, true)
+	},
+	SingleKey: model.GetSingleByParamAccountKey,
+	ListKey:   model.GetByParamAccountKey,
+	ListPgKey: model.GetByParamAccountPgKey,
+	Tag:       model.AccountCacheTag,
+	ListTag:   model.AccountListCacheTag,
+	Cascade: []string{
+		model.AccountRoleListCacheTag,
+		model.OrganisationMemberListCacheTag,
+		model.InvitationListCacheTag,
+	},
 }
 
-func (a *AccountDep) GetSingleByParam(ctx *gin.Context, cacheControl string, param *model.GetAccountByParam) (psqlmodel.Account, error) {
-	str, err := json.Marshal(param)
-	if err != nil {
-		return psqlmodel.Account{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal param")
-	}
-
-	key := fmt.Sprintf(model.GetSingleByParamAccountKey, str)
-	if cacheControl != model.MustRevalidate {
-		res, err := a.getSingleByParamRedis(ctx, key)
-		if err != nil {
-			if err == goredislib.Nil {
-				res, err := a.getSingleByParamPSQL(ctx, param)
-				if err == nil {
-					dataStr, err := json.Marshal(&res)
-					if err != nil {
-						return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-					}
-					err = a.setRedis(ctx, key, string(dataStr))
-					if err != nil {
-						return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-					}
-				}
-				return res, err
-			}
-			return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal param")
-		}
-		return res, nil
-	}
-
-	res, err := a.getSingleByParamPSQL(ctx, param)
-	if err == nil {
-		dataStr, err := json.Marshal(&res)
-		if err != nil {
-			return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-		}
-		err = a.setRedis(ctx, key, string(dataStr))
-		if err != nil {
-			return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-		}
-	}
-	return res, nil
-}
-
-func (a *AccountDep) Update(ctx *gin.Context, account *psqlmodel.Account) error {
-	return a.updatePSQL(ctx, account)
-}
-
-func (a *AccountDep) Delete(ctx *gin.Context, account *psqlmodel.Account, id int64, isHardDelete bool) error {
-	return a.deletePSQL(ctx, account, id, isHardDelete)
-}
-func (a *AccountDep) GetByParam(ctx *gin.Context, cacheControl string, param *model.GetAccountsByParam) (psqlmodel.AccountSlice, model.Pagination, error) {
-	var pg model.Pagination
-	var res psqlmodel.AccountSlice
-
-	str, err := json.Marshal(param)
-	if err != nil {
-		return psqlmodel.AccountSlice{}, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal param")
-	}
-
-	key := fmt.Sprintf(model.GetByParamAccountKey, str)
-	keyPg := fmt.Sprintf(model.GetByParamAccountPgKey, str)
-	if cacheControl != model.MustRevalidate {
-		res, err1 := a.getByParamRedis(ctx, key)
-		pg, err2 := a.getByParamPaginationRedis(ctx, keyPg)
-		if err1 != nil || err2 != nil {
-			if err1 == goredislib.Nil || err2 == goredislib.Nil {
-				res, pg, err := a.getByParamPSQL(ctx, param)
-				if err == nil {
-					dataStr, err := json.Marshal(&res)
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-					}
-					err = a.setRedis(ctx, key, string(dataStr))
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-					}
-					dataStr, err = json.Marshal(&pg)
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-					}
-					err = a.setRedis(ctx, key, string(dataStr))
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-					}
-				}
-				return res, pg, err
-			}
-			return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal param")
-		}
-		return res, pg, nil
-	}
-
-	res, pg, err = a.getByParamPSQL(ctx, param)
-	if err == nil {
-		dataStr, err := json.Marshal(&res)
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-		}
-		err = a.setRedis(ctx, key, string(dataStr))
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-		}
-		dataStr, err = json.Marshal(&pg)
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-		}
-		err = a.setRedis(ctx, key, string(dataStr))
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-		}
+func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client) AccountInterface {
+	return &AccountDep{
+		Repository: repository.New[psqlmodel.Account, *psqlmodel.Account, psqlmodel.AccountSlice, *model.GetAccountByParam, *model.GetAccountsByParam](conf, log, db, rds, schema),
 	}
-	return res, pg, err
 }
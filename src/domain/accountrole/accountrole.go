@@ -1,11 +1,12 @@
 package accountrole
 
 import (
+	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
 	"time"
 
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/repository"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
@@ -14,19 +15,15 @@ import (
 
 	"github.com/gin-gonic/gin"
 	goredislib "github.com/redis/go-redis/v9"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type AccountRoleDep struct {
-	Log   logger.Logger
-	DB    *sql.DB
-	Redis *goredislib.Client
-	Conf  Conf
+	*repository.Repository[psqlmodel.AccountRole, *psqlmodel.AccountRole, psqlmodel.AccountRoleSlice, *model.GetAccountRoleByParam, *model.GetAccountRolesByParam]
 }
 
-type Conf struct {
-	DefaultPageLimit    int           `mapstructure:"page_limit"`
-	RedisExpirationTime time.Duration `mapstructure:"expiration_time"`
-}
+type Conf = repository.Conf
 
 type AccountRoleInterface interface {
 	Insert(ctx *gin.Context, data *psqlmodel.AccountRole) error
@@ -34,131 +31,79 @@ type AccountRoleInterface interface {
 	Update(ctx *gin.Context, AccountRole *psqlmodel.AccountRole) error
 	Delete(ctx *gin.Context, AccountRole *psqlmodel.AccountRole, id int64, isHardDelete bool) error
 	GetByParam(ctx *gin.Context, cacheControl string, param *model.GetAccountRolesByParam) (psqlmodel.AccountRoleSlice, model.Pagination, error)
+	Expire(ctx context.Context, at time.Time, limit int) (psqlmodel.AccountRoleSlice, error)
+}
+
+// schema caches an account role under its own id and the ids of its
+// account, role and organisation, so writing any of them evicts it.
+var schema = repository.Schema[psqlmodel.AccountRole, psqlmodel.AccountRoleSlice]{
+	Name: "account_role",
+	Query: func(mods ...qm.QueryMod) repository.Query[psqlmodel.AccountRole, psqlmodel.AccountRoleSlice] {
+		return psqlmodel.AccountRoles(mods...)
+	},
+	ID: func(a *psqlmodel.AccountRole) int {
+		return a.ID
+	},
+	SetDeletedBy: func(a *psqlmodel.AccountRole, id int) {
+		a.DeletedBy = null.NewInt(id, true)
+	},
+	SingleKey: model.GetSingleByParamAccountRoleKey,
+	ListKey:   model.GetByParamAccountRoleKey,
+	ListPgKey: model.GetByParamAccountRolePgKey,
+	Tag:       model.AccountRoleCacheTag,
+	ListTag:   model.AccountRoleListCacheTag,
+	DependsOn: func(a *psqlmodel.AccountRole) []string {
+		tags := []string{
+			fmt.Sprintf(model.AccountCacheTag, a.AccountID),
+			fmt.Sprintf(model.RoleCacheTag, a.RoleID),
+		}
+		if a.OrganisationID.Valid {
+			tags = append(tags, fmt.Sprintf(model.OrganisationCacheTag, a.OrganisationID.Int))
+		}
+		return tags
+	},
 }
 
 func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client) AccountRoleInterface {
 	return &AccountRoleDep{
-		Log:   *log,
-		DB:    db,
-		Redis: rds,
-		Conf:  conf,
+		Repository: repository.New[psqlmodel.AccountRole, *psqlmodel.AccountRole, psqlmodel.AccountRoleSlice, *model.GetAccountRoleByParam, *model.GetAccountRolesByParam](conf, log, db, rds, schema),
 	}
 }
 
-func (a *AccountRoleDep) Insert(ctx *gin.Context, data *psqlmodel.AccountRole) error {
-	return a.insertPSQL(ctx, data)
-}
-
+// GetSingleByParam only finds grants whose validity window holds, unless
+// param.IncludeInactive is set. Cached grants are checked again, they may
+// have expired since they were cached.
 func (a *AccountRoleDep) GetSingleByParam(ctx *gin.Context, cacheControl string, param *model.GetAccountRoleByParam) (psqlmodel.AccountRole, error) {
-	str, err := json.Marshal(param)
-	if err != nil {
-		return psqlmodel.AccountRole{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal param")
-	}
-
-	key := fmt.Sprintf(model.GetSingleByParamAccountRoleKey, str)
-	if cacheControl != model.MustRevalidate {
-		res, err := a.getSingleByParamRedis(ctx, key)
-		if err != nil {
-			if err == goredislib.Nil {
-				res, err := a.getSingleByParamPSQL(ctx, param)
-				if err == nil {
-					dataStr, err := json.Marshal(&res)
-					if err != nil {
-						return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-					}
-					err = a.setRedis(ctx, key, string(dataStr))
-					if err != nil {
-						return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-					}
-				}
-				return res, err
-			}
-			return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal param")
-		}
-		return res, nil
-	}
-
-	res, err := a.getSingleByParamPSQL(ctx, param)
-	if err == nil {
-		dataStr, err := json.Marshal(&res)
-		if err != nil {
-			return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-		}
-		err = a.setRedis(ctx, key, string(dataStr))
-		if err != nil {
-			return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-		}
+	res, err := a.Repository.GetSingleByParam(ctx, cacheControl, param)
+	if err == nil && !param.IncludeInactive && !model.AccountRoleActive(&res, time.Now()) {
+		return psqlmodel.AccountRole{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "account role outside its validity window")
 	}
-	return res, nil
-}
-
-func (a *AccountRoleDep) Update(ctx *gin.Context, AccountRole *psqlmodel.AccountRole) error {
-	return a.updatePSQL(ctx, AccountRole)
+	return res, err
 }
 
-func (a *AccountRoleDep) Delete(ctx *gin.Context, AccountRole *psqlmodel.AccountRole, id int64, isHardDelete bool) error {
-	return a.deletePSQL(ctx, AccountRole, id, isHardDelete)
-}
+// GetByParam reads lists of active grants past the cache, a cached list
+// can hold grants that have expired since or miss grants that have started.
 func (a *AccountRoleDep) GetByParam(ctx *gin.Context, cacheControl string, param *model.GetAccountRolesByParam) (psqlmodel.AccountRoleSlice, model.Pagination, error) {
-	var pg model.Pagination
-	var res psqlmodel.AccountRoleSlice
-
-	str, err := json.Marshal(param)
-	if err != nil {
-		return psqlmodel.AccountRoleSlice{}, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal param")
+	if param.ActiveOnly {
+		cacheControl = model.MustRevalidate
 	}
+	return a.Repository.GetByParam(ctx, cacheControl, param)
+}
 
-	key := fmt.Sprintf(model.GetByParamAccountRoleKey, str)
-	keyPg := fmt.Sprintf(model.GetByParamAccountRolePgKey, str)
-	if cacheControl != model.MustRevalidate {
-		res, err1 := a.getByParamRedis(ctx, key)
-		pg, err2 := a.getByParamPaginationRedis(ctx, keyPg)
-		if err1 != nil || err2 != nil {
-			if err1 == goredislib.Nil || err2 == goredislib.Nil {
-				res, pg, err := a.getByParamPSQL(ctx, param)
-				if err == nil {
-					dataStr, err := json.Marshal(&res)
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-					}
-					err = a.setRedis(ctx, key, string(dataStr))
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-					}
-					dataStr, err = json.Marshal(&pg)
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-					}
-					err = a.setRedis(ctx, key, string(dataStr))
-					if err != nil {
-						return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-					}
-				}
-				return res, pg, err
-			}
-			return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal param")
-		}
-		return res, pg, nil
+// Expire soft deletes up to limit grants whose validity window ended by at
+// and returns them, grants being expired by another instance are skipped.
+// It takes context.Context because it runs in the background, outside of
+// any handler.
+func (a *AccountRoleDep) Expire(ctx context.Context, at time.Time, limit int) (psqlmodel.AccountRoleSlice, error) {
+	accountRoles, err := a.expirePSQL(ctx, at, limit)
+	if err != nil || len(accountRoles) == 0 {
+		return accountRoles, err
 	}
 
-	res, pg, err = a.getByParamPSQL(ctx, param)
-	if err == nil {
-		dataStr, err := json.Marshal(&res)
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-		}
-		err = a.setRedis(ctx, key, string(dataStr))
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-		}
-		dataStr, err = json.Marshal(&pg)
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get psql")
-		}
-		err = a.setRedis(ctx, key, string(dataStr))
-		if err != nil {
-			return res, pg, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set redis")
-		}
+	ids := make([]int, 0, len(accountRoles))
+	for _, v := range accountRoles {
+		ids = append(ids, v.ID)
 	}
-	return res, pg, err
+	a.Evict(ctx, false, ids...)
+	return accountRoles, nil
 }
@@ -0,0 +1,120 @@
+package accountrole
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/cache"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+	"github.com/volatiletech/null/v8"
+)
+
+var accountRoleColumns = []string{"id", "account_id", "role_id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "organisation_id", "valid_from", "valid_until"}
+
+func newTestAccountRole(t *testing.T) (AccountRoleInterface, sqlmock.Sqlmock, *gin.Context) {
+	t.Helper()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	mr := miniredis.RunT(t)
+	rds := goredislib.NewClient(&goredislib.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rds.Close() })
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("GET", "/", nil)
+	return New(Conf{DefaultPageLimit: 10, RedisExpirationTime: time.Minute}, &log, db, rds), mock, ctx
+}
+
+func TestGetByParamActiveOnlySkipsCache(t *testing.T) {
+	tests := []struct {
+		name       string
+		activeOnly bool
+		// reads is how many of the two lookups reach the database
+		reads int
+	}{
+		{name: "active grants", activeOnly: true, reads: 2},
+		{name: "every grant", reads: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, mock, ctx := newTestAccountRole(t)
+			for i := 0; i < tt.reads; i++ {
+				now := time.Now()
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM "account_roles"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(`SELECT "account_roles"\.\* FROM "account_roles"`).WillReturnRows(sqlmock.NewRows(accountRoleColumns).
+					AddRow(1, 5, 2, 1, now, 1, now, nil, nil, nil, nil, now.Add(time.Hour)))
+			}
+
+			for i := 0; i < 2; i++ {
+				res, _, err := a.GetByParam(ctx, "", &model.GetAccountRolesByParam{
+					GetAccountRoleByParam: model.GetAccountRoleByParam{AccountID: null.NewInt64(5, true)},
+					ActiveOnly:            tt.activeOnly,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(res) != 1 || res[0].ID != 1 {
+					t.Fatalf("got %+v", res)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestWritesToDependenciesEvictGrant(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+		// evicted is set when the tag names a row the grant depends on
+		evicted bool
+	}{
+		{name: "account", tag: fmt.Sprintf(model.AccountCacheTag, 5), evicted: true},
+		{name: "role", tag: fmt.Sprintf(model.RoleCacheTag, 2), evicted: true},
+		{name: "organisation", tag: fmt.Sprintf(model.OrganisationCacheTag, 3), evicted: true},
+		{name: "grant", tag: fmt.Sprintf(model.AccountRoleCacheTag, 1), evicted: true},
+		{name: "another account", tag: fmt.Sprintf(model.AccountCacheTag, 6)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, mock, ctx := newTestAccountRole(t)
+			param := &model.GetAccountRoleByParam{ID: null.NewInt64(1, true)}
+			reads := 1
+			if tt.evicted {
+				reads = 2
+			}
+			for i := 0; i < reads; i++ {
+				now := time.Now()
+				mock.ExpectQuery(`SELECT "account_roles"\.\* FROM "account_roles"`).WillReturnRows(sqlmock.NewRows(accountRoleColumns).
+					AddRow(1, 5, 2, 1, now, 1, now, nil, nil, 3, nil, nil))
+			}
+
+			if _, err := a.GetSingleByParam(ctx, "", param); err != nil {
+				t.Fatal(err)
+			}
+			if err := cache.Invalidate(ctx, a.(*AccountRoleDep).Redis, tt.tag); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := a.GetSingleByParam(ctx, "", param); err != nil {
+				t.Fatal(err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
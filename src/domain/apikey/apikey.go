@@ -0,0 +1,73 @@
+package apikey
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeyDep struct {
+	Log  logger.Logger
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct {
+	DefaultPageLimit int `mapstructure:"page_limit"`
+}
+
+// APIKeyInterface stores the API keys of accounts. Keys are not cached, so
+// a revoked key stops working on the next request.
+type APIKeyInterface interface {
+	Insert(ctx *gin.Context, data *psqlmodel.APIKey) error
+	GetSingleByParam(ctx *gin.Context, param *model.GetAPIKeyByParam) (psqlmodel.APIKey, error)
+	GetByParam(ctx *gin.Context, param *model.GetAPIKeysByParam) (psqlmodel.APIKeySlice, model.Pagination, error)
+	Count(ctx *gin.Context, param *model.GetAPIKeyByParam) (int64, error)
+	Update(ctx *gin.Context, data *psqlmodel.APIKey) error
+	Delete(ctx *gin.Context, data *psqlmodel.APIKey, deletedBy int64) error
+	RevokeByAccount(ctx context.Context, accountID int, deletedBy int64) error
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB) APIKeyInterface {
+	return &APIKeyDep{
+		Log:  *log,
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+func (a *APIKeyDep) Insert(ctx *gin.Context, data *psqlmodel.APIKey) error {
+	return a.insertPSQL(ctx, data)
+}
+
+func (a *APIKeyDep) GetSingleByParam(ctx *gin.Context, param *model.GetAPIKeyByParam) (psqlmodel.APIKey, error) {
+	return a.getSingleByParamPSQL(ctx, param)
+}
+
+func (a *APIKeyDep) GetByParam(ctx *gin.Context, param *model.GetAPIKeysByParam) (psqlmodel.APIKeySlice, model.Pagination, error) {
+	return a.getByParamPSQL(ctx, param)
+}
+
+func (a *APIKeyDep) Count(ctx *gin.Context, param *model.GetAPIKeyByParam) (int64, error) {
+	return a.countPSQL(ctx, param)
+}
+
+func (a *APIKeyDep) Update(ctx *gin.Context, data *psqlmodel.APIKey) error {
+	return a.updatePSQL(ctx, data)
+}
+
+// Delete revokes the key, it is soft deleted so it still shows up in the
+// audit of who created it.
+func (a *APIKeyDep) Delete(ctx *gin.Context, data *psqlmodel.APIKey, deletedBy int64) error {
+	return a.deletePSQL(ctx, data, deletedBy)
+}
+
+// RevokeByAccount revokes every live key of the account, along with its
+// tokens, recording deletedBy like Delete.
+func (a *APIKeyDep) RevokeByAccount(ctx context.Context, accountID int, deletedBy int64) error {
+	return a.revokeByAccountPSQL(ctx, accountID, deletedBy)
+}
@@ -0,0 +1,128 @@
+package apikey
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (a *APIKeyDep) insertPSQL(ctx *gin.Context, data *psqlmodel.APIKey) error {
+	err := data.Insert(ctx, a.DB, boil.Infer())
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, err, "error insert api key")
+	}
+	return nil
+}
+
+func (a *APIKeyDep) getSingleByParamPSQL(ctx *gin.Context, param *model.GetAPIKeyByParam) (psqlmodel.APIKey, error) {
+	var res psqlmodel.APIKey
+	qr := param.GetQuery()
+	apiKey, err := psqlmodel.APIKeys(qr...).One(ctx, a.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.AccountSVCNotFound, err, "error get api key")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get api key")
+	}
+
+	return *apiKey, nil
+}
+
+func (a *APIKeyDep) getByParamPSQL(ctx *gin.Context, param *model.GetAPIKeysByParam) (psqlmodel.APIKeySlice, model.Pagination, error) {
+	var totalPages int64 = 1
+	limit, page, orderBy := param.Paging(int64(a.Conf.DefaultPageLimit))
+
+	qr := param.GetQuery()
+	count, err := psqlmodel.APIKeys(qr...).Count(ctx, a.DB)
+	if err != nil {
+		return psqlmodel.APIKeySlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error count data")
+	}
+	qr = append(qr, qm.Offset(int((page-1)*limit)))
+	qr = append(qr, qm.Limit(int(limit)))
+	apiKeys, err := psqlmodel.APIKeys(qr...).All(ctx, a.DB)
+	if err != nil {
+		return apiKeys, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get api keys")
+	}
+	if count > 0 {
+		totalPages = (count + limit - 1) / limit
+	}
+	return apiKeys, model.Pagination{
+		CurrentPage:     page,
+		CurrentElements: int64(len(apiKeys)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+		SortBy:          orderBy,
+	}, nil
+}
+
+func (a *APIKeyDep) countPSQL(ctx *gin.Context, param *model.GetAPIKeyByParam) (int64, error) {
+	count, err := psqlmodel.APIKeys(param.GetQuery()...).Count(ctx, a.DB)
+	if err != nil {
+		return 0, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error count api keys")
+	}
+	return count, nil
+}
+
+func (a *APIKeyDep) updatePSQL(ctx *gin.Context, data *psqlmodel.APIKey) error {
+	_, err := data.Update(ctx, a.DB, boil.Infer())
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorUpdate, err, "error update api key")
+	}
+	return nil
+}
+
+func (a *APIKeyDep) deletePSQL(ctx *gin.Context, data *psqlmodel.APIKey, deletedBy int64) error {
+	tx, err := a.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	_, err = data.Delete(ctx, tx, false)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			a.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorUpdate, err, "error delete")
+	}
+
+	data.DeletedBy = null.NewInt(int(deletedBy), true)
+	_, err = data.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			a.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorUpdate, err, "error update")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (a *APIKeyDep) revokeByAccountPSQL(ctx context.Context, accountID int, deletedBy int64) error {
+	now := time.Now()
+	_, err := psqlmodel.APIKeys(
+		qm.Where("account_id=?", accountID),
+		qm.Where("deleted_at is null"),
+	).UpdateAll(ctx, a.DB, psqlmodel.M{
+		psqlmodel.APIKeyColumns.DeletedAt: null.TimeFrom(now),
+		psqlmodel.APIKeyColumns.DeletedBy: null.NewInt(int(deletedBy), true),
+		psqlmodel.APIKeyColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorUpdate, err, "error revoke account api keys")
+	}
+	return nil
+}
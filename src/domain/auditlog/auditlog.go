@@ -0,0 +1,43 @@
+package auditlog
+
+import (
+	"database/sql"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditLogDep struct {
+	Log  logger.Logger
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct {
+	DefaultPageLimit int `mapstructure:"page_limit"`
+}
+
+// AuditLogInterface stores who did what to which account. Entries are only
+// ever appended, there is no update or delete.
+type AuditLogInterface interface {
+	Insert(ctx *gin.Context, data *psqlmodel.AuditLog) error
+	GetByParam(ctx *gin.Context, param *model.GetAuditLogsByParam) (psqlmodel.AuditLogSlice, model.Pagination, error)
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB) AuditLogInterface {
+	return &AuditLogDep{
+		Log:  *log,
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+func (a *AuditLogDep) Insert(ctx *gin.Context, data *psqlmodel.AuditLog) error {
+	return a.insertPSQL(ctx, data)
+}
+
+func (a *AuditLogDep) GetByParam(ctx *gin.Context, param *model.GetAuditLogsByParam) (psqlmodel.AuditLogSlice, model.Pagination, error) {
+	return a.getByParamPSQL(ctx, param)
+}
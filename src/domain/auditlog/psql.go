@@ -0,0 +1,46 @@
+package auditlog
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (a *AuditLogDep) insertPSQL(ctx *gin.Context, data *psqlmodel.AuditLog) error {
+	err := data.Insert(ctx, a.DB, boil.Infer())
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, err, "error insert audit log")
+	}
+	return nil
+}
+
+func (a *AuditLogDep) getByParamPSQL(ctx *gin.Context, param *model.GetAuditLogsByParam) (psqlmodel.AuditLogSlice, model.Pagination, error) {
+	var totalPages int64 = 1
+	limit, page, orderBy := param.Paging(int64(a.Conf.DefaultPageLimit))
+
+	qr := param.GetQuery()
+	count, err := psqlmodel.AuditLogs(qr...).Count(ctx, a.DB)
+	if err != nil {
+		return psqlmodel.AuditLogSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error count data")
+	}
+	qr = append(qr, qm.Offset(int((page-1)*limit)))
+	qr = append(qr, qm.Limit(int(limit)))
+	auditLogs, err := psqlmodel.AuditLogs(qr...).All(ctx, a.DB)
+	if err != nil {
+		return auditLogs, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get audit logs")
+	}
+	if count > 0 {
+		totalPages = (count + limit - 1) / limit
+	}
+	return auditLogs, model.Pagination{
+		CurrentPage:     page,
+		CurrentElements: int64(len(auditLogs)),
+		TotalElements:   count,
+		TotalPages:      totalPages,
+		SortBy:          orderBy,
+	}, nil
+}
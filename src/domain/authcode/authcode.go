@@ -0,0 +1,45 @@
+package authcode
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+type AuthCodeDep struct {
+	Log   logger.Logger
+	Redis *goredislib.Client
+	Conf  Conf
+}
+
+type Conf struct {
+	RedisExpirationTime time.Duration `mapstructure:"expiration_time"`
+}
+
+// AuthCodeInterface stores authorization codes in redis only. Codes live for
+// a minute at most, so there is nothing worth persisting in postgres.
+type AuthCodeInterface interface {
+	Insert(ctx *gin.Context, codeHash string, data *model.AuthorizationCode) error
+	Take(ctx *gin.Context, codeHash string) (model.AuthorizationCode, error)
+}
+
+func New(conf Conf, log *logger.Logger, rds *goredislib.Client) AuthCodeInterface {
+	return &AuthCodeDep{
+		Log:   *log,
+		Redis: rds,
+		Conf:  conf,
+	}
+}
+
+func (a *AuthCodeDep) Insert(ctx *gin.Context, codeHash string, data *model.AuthorizationCode) error {
+	return a.setRedis(ctx, codeHash, data)
+}
+
+// Take returns the code and deletes it in the same command, so a code can
+// only ever be exchanged once even under concurrent requests.
+func (a *AuthCodeDep) Take(ctx *gin.Context, codeHash string) (model.AuthorizationCode, error) {
+	return a.getDelRedis(ctx, codeHash)
+}
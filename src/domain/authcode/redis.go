@@ -0,0 +1,47 @@
+package authcode
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+func (a *AuthCodeDep) setRedis(ctx *gin.Context, codeHash string, data *model.AuthorizationCode) error {
+	expTime := a.Conf.RedisExpirationTime
+	if a.Conf.RedisExpirationTime == 0 {
+		expTime = model.DefaultAuthorizationCodeExpiration
+	}
+
+	res, err := json.Marshal(data)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal authorization code")
+	}
+
+	_, err = a.Redis.Set(ctx, fmt.Sprintf(model.AuthorizationCodeKey, codeHash), string(res), expTime).Result()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set authorization code")
+	}
+	return nil
+}
+
+func (a *AuthCodeDep) getDelRedis(ctx *gin.Context, codeHash string) (model.AuthorizationCode, error) {
+	var res model.AuthorizationCode
+	data, err := a.Redis.GetDel(ctx, fmt.Sprintf(model.AuthorizationCodeKey, codeHash)).Result()
+	if err == goredislib.Nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCInvalidAuthorizationCode, err, "authorization code not found")
+	}
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get authorization code")
+	}
+
+	err = json.Unmarshal([]byte(data), &res)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal authorization code")
+	}
+	return res, nil
+}
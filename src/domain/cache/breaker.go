@@ -0,0 +1,186 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+// ErrUnavailable is returned by the redis calls the breaker refuses while
+// it is open.
+var ErrUnavailable = errors.New("redis unavailable, cache breaker open")
+
+// breakers are the breakers of the process, the cache health reports them.
+var breakers struct {
+	sync.Mutex
+	all []*Breaker
+}
+
+func init() {
+	expvar.Publish(model.CacheHealthVar, expvar.Func(func() interface{} {
+		return Health()
+	}))
+}
+
+type BreakerConf struct {
+	Threshold int           `mapstructure:"breaker_threshold"`
+	Cooldown  time.Duration `mapstructure:"breaker_cooldown"`
+}
+
+// Breaker is a redis client hook that stops calling redis once Threshold
+// calls in a row failed to reach it, so the caches read through to psql
+// right away instead of waiting on redis for every request. After
+// Cooldown one call probes redis, closing the breaker if it succeeds.
+// Replies of redis, errors included, count as reaching it.
+type Breaker struct {
+	Log  logger.Logger
+	Conf BreakerConf
+	// OnClose, when set, is run in its own goroutine each time the breaker
+	// closes again.
+	OnClose  func(ctx context.Context)
+	mu       sync.Mutex
+	state    string
+	failures int
+	openedAt time.Time
+}
+
+func NewBreaker(conf BreakerConf, log *logger.Logger) *Breaker {
+	if conf.Threshold == 0 {
+		conf.Threshold = model.DefaultCacheBreakerThreshold
+	}
+	if conf.Cooldown == 0 {
+		conf.Cooldown = model.DefaultCacheBreakerCooldown
+	}
+
+	b := &Breaker{
+		Log:   *log,
+		Conf:  conf,
+		state: model.CacheBreakerClosed,
+	}
+	breakers.Lock()
+	breakers.all = append(breakers.all, b)
+	breakers.Unlock()
+	return b
+}
+
+func (b *Breaker) DialHook(next goredislib.DialHook) goredislib.DialHook {
+	return next
+}
+
+func (b *Breaker) ProcessHook(next goredislib.ProcessHook) goredislib.ProcessHook {
+	return func(ctx context.Context, cmd goredislib.Cmder) error {
+		if !b.allow() {
+			cmd.SetErr(ErrUnavailable)
+			return ErrUnavailable
+		}
+		err := next(ctx, cmd)
+		b.record(ctx, err)
+		return err
+	}
+}
+
+func (b *Breaker) ProcessPipelineHook(next goredislib.ProcessPipelineHook) goredislib.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []goredislib.Cmder) error {
+		if !b.allow() {
+			for _, cmd := range cmds {
+				cmd.SetErr(ErrUnavailable)
+			}
+			return ErrUnavailable
+		}
+		err := next(ctx, cmds)
+		b.record(ctx, err)
+		return err
+	}
+}
+
+// State returns whether the breaker is closed, open or half open.
+func (b *Breaker) State() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// allow tells whether a call goes to redis, once the cooldown passed the
+// first call does while the others are still refused.
+func (b *Breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case model.CacheBreakerOpen:
+		if time.Since(b.openedAt) < b.Conf.Cooldown {
+			return false
+		}
+		b.state = model.CacheBreakerHalfOpen
+		return true
+	case model.CacheBreakerHalfOpen:
+		return false
+	}
+	return true
+}
+
+func (b *Breaker) record(ctx context.Context, err error) {
+	var rerr goredislib.Error
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch {
+	case errors.Is(err, context.Canceled):
+		// the caller gave up, it tells nothing about redis
+		if b.state == model.CacheBreakerHalfOpen {
+			b.state = model.CacheBreakerOpen
+		}
+	case err == nil || errors.As(err, &rerr):
+		if b.state != model.CacheBreakerClosed {
+			b.Log.Info(ctx, "redis reachable again, cache breaker closed")
+			if b.OnClose != nil {
+				go b.OnClose(context.Background())
+			}
+		}
+		b.state = model.CacheBreakerClosed
+		b.failures = 0
+	default:
+		b.failures++
+		if b.state == model.CacheBreakerClosed && b.failures < b.Conf.Threshold {
+			return
+		}
+		if b.state == model.CacheBreakerClosed {
+			b.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "redis unreachable, cache breaker open"))
+		}
+		b.state = model.CacheBreakerOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// Health reports the caches degraded while any breaker is not closed.
+func Health() model.CacheHealth {
+	breakers.Lock()
+	defer breakers.Unlock()
+	health := model.CacheHealth{
+		Status:  model.CacheStatusOK,
+		Breaker: model.CacheBreakerClosed,
+	}
+	for _, b := range breakers.all {
+		if state := b.State(); state != model.CacheBreakerClosed {
+			health.Status = model.CacheStatusDegraded
+			health.Breaker = state
+		}
+	}
+	return health
+}
+
+// Warn logs a redis call failing that the caller goes on without, the
+// calls refused by an open breaker are not, opening it was logged.
+func Warn(ctx context.Context, log logger.Logger, err error, msg string) {
+	if errors.Is(err, ErrUnavailable) {
+		return
+	}
+	log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, msg))
+}
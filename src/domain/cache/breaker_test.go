@@ -0,0 +1,157 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+func newTestBreaker(t *testing.T, conf BreakerConf) (*Breaker, *goredislib.Client, *miniredis.Miniredis) {
+	t.Helper()
+	// only the breaker of the test is reported
+	breakers.Lock()
+	breakers.all = nil
+	breakers.Unlock()
+
+	mr := miniredis.RunT(t)
+	rds := goredislib.NewClient(&goredislib.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { rds.Close() })
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	b := NewBreaker(conf, &log)
+	rds.AddHook(b)
+	return b, rds, mr
+}
+
+func TestBreakerOpensAndRecovers(t *testing.T) {
+	b, rds, mr := newTestBreaker(t, BreakerConf{Threshold: 2, Cooldown: 50 * time.Millisecond})
+	ctx := context.Background()
+
+	mr.Close()
+	for i := 0; i < 2; i++ {
+		if err := rds.Get(ctx, "k").Err(); err == nil || errors.Is(err, ErrUnavailable) {
+			t.Fatalf("call %d: got %v, want a connection error", i, err)
+		}
+	}
+	if b.State() != model.CacheBreakerOpen {
+		t.Fatalf("state %s, want open", b.State())
+	}
+	if err := rds.Get(ctx, "k").Err(); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("got %v, want %v", err, ErrUnavailable)
+	}
+	if _, err := rds.Pipelined(ctx, func(pipe goredislib.Pipeliner) error {
+		pipe.Get(ctx, "k")
+		return nil
+	}); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("pipeline got %v, want %v", err, ErrUnavailable)
+	}
+	if h := Health(); h.Status != model.CacheStatusDegraded || h.Breaker != model.CacheBreakerOpen {
+		t.Fatalf("health %+v", h)
+	}
+
+	if err := mr.Restart(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(60 * time.Millisecond)
+	// the probe after the cooldown closes it, a miss is a reply too
+	if err := rds.Get(ctx, "k").Err(); err != goredislib.Nil {
+		t.Fatalf("got %v, want %v", err, goredislib.Nil)
+	}
+	if b.State() != model.CacheBreakerClosed {
+		t.Fatalf("state %s, want closed", b.State())
+	}
+	if h := Health(); h.Status != model.CacheStatusOK {
+		t.Fatalf("health %+v", h)
+	}
+}
+
+func TestBreakerReopensOnFailedProbe(t *testing.T) {
+	b, rds, mr := newTestBreaker(t, BreakerConf{Threshold: 1, Cooldown: 20 * time.Millisecond})
+	ctx := context.Background()
+
+	mr.Close()
+	rds.Get(ctx, "k")
+	time.Sleep(30 * time.Millisecond)
+	if err := rds.Get(ctx, "k").Err(); errors.Is(err, ErrUnavailable) {
+		t.Fatal("probe refused after the cooldown")
+	}
+	if b.State() != model.CacheBreakerOpen {
+		t.Fatalf("state %s, want open", b.State())
+	}
+	if err := rds.Get(ctx, "k").Err(); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("got %v, want %v", err, ErrUnavailable)
+	}
+}
+
+func TestBreakerCountsRepliesAsReached(t *testing.T) {
+	b, rds, mr := newTestBreaker(t, BreakerConf{Threshold: 1})
+	ctx := context.Background()
+
+	mr.SetError("LOADING")
+	if err := rds.Get(ctx, "k").Err(); err == nil {
+		t.Fatal("got no error")
+	}
+	if b.State() != model.CacheBreakerClosed {
+		t.Fatalf("state %s, want closed", b.State())
+	}
+}
+
+func TestFetchLoadsWhileRedisDown(t *testing.T) {
+	l, mr := newTestLoader(t, "testRedisDown", LoaderConf{Lock: true})
+	src := &testSource{rds: l.Redis, key: "k"}
+
+	mr.Close()
+	res, err := Fetch(context.Background(), l, src.key, src.get, func(ctx context.Context) (string, error) {
+		src.loads.Add(1)
+		return "loaded", nil
+	})
+	if err != nil || res != "loaded" {
+		t.Fatalf("got %q, %v", res, err)
+	}
+	if src.loads.Load() != 1 {
+		t.Fatalf("loaded %d times, want 1", src.loads.Load())
+	}
+}
+
+func TestBreakerReplaysFailedInvalidations(t *testing.T) {
+	b, rds, mr := newTestBreaker(t, BreakerConf{Threshold: 1, Cooldown: 20 * time.Millisecond})
+	ctx := context.Background()
+	replayed := make(chan error, 1)
+	b.OnClose = func(ctx context.Context) {
+		replayed <- Replay(ctx, rds)
+	}
+
+	if err := Set(ctx, rds, "k", "v", time.Minute, "tag"); err != nil {
+		t.Fatal(err)
+	}
+	mr.Close()
+	if err := Invalidate(ctx, rds, "tag"); err == nil {
+		t.Fatal("invalidated while redis is down")
+	}
+
+	if err := mr.Restart(); err != nil {
+		t.Fatal(err)
+	}
+	if !mr.Exists("k") {
+		t.Fatal("key lost on restart")
+	}
+	time.Sleep(30 * time.Millisecond)
+	rds.Get(ctx, "probe")
+	select {
+	case err := <-replayed:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed invalidation not replayed")
+	}
+	if mr.Exists("k") || mr.Exists("tag") {
+		t.Error("entry still cached after the replay")
+	}
+}
@@ -0,0 +1,221 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+
+	"github.com/google/uuid"
+	goredislib "github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+// unlockScript releases a lock only if it is still held with the token in
+// ARGV, a lock that timed out may be held by another instance already.
+var unlockScript = goredislib.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type LoaderConf struct {
+	// Lock coalesces the loads of a key across instances with a redis
+	// lock, within an instance they are always coalesced.
+	Lock        bool
+	LockTimeout time.Duration
+	// EarlyRefresh reloads a key before it expires, with a probability
+	// growing as the expiry nears and scaled by EarlyRefreshBeta and by
+	// how long loading takes.
+	EarlyRefresh     bool
+	EarlyRefreshBeta float64
+	// LoadTimeout bounds a shared load, it runs apart from the requests
+	// waiting on it.
+	LoadTimeout time.Duration
+}
+
+// Loader loads the entries missing from a redis cache, so a popular key
+// expiring is loaded once rather than by every request missing it.
+type Loader struct {
+	Log     logger.Logger
+	Redis   *goredislib.Client
+	Conf    LoaderConf
+	Metrics *Metrics
+	group   singleflight.Group
+	// loadTime is how long the last load took in nanoseconds
+	loadTime atomic.Int64
+}
+
+func NewLoader(name string, conf LoaderConf, log *logger.Logger, rds *goredislib.Client) *Loader {
+	return &Loader{
+		Log:     *log,
+		Redis:   rds,
+		Conf:    conf,
+		Metrics: NewMetrics(name),
+	}
+}
+
+// Fetch returns the entry get reads from the cache under key. On a miss, or
+// when it is refreshed early, load reads it from the source and caches it.
+// get returns how long the entry has left to live with it, and
+// goredislib.Nil on a miss. The cache is optional, when get fails the
+// entry is loaded as on a miss.
+func Fetch[T any](ctx context.Context, l *Loader, key string, get func(ctx context.Context) (T, time.Duration, error), load func(ctx context.Context) (T, error)) (T, error) {
+	res, ttl, err := get(ctx)
+	if err != nil && err != goredislib.Nil {
+		Warn(ctx, l.Log, err, "error get redis")
+	}
+
+	if err == nil {
+		if !l.refreshEarly(ttl) {
+			l.Metrics.Hits.Add(1)
+			return res, nil
+		}
+		l.Metrics.EarlyRefreshes.Add(1)
+		// the cached entry is still valid, it is served if reloading fails
+		fresh, err := loadShared(ctx, l, key, true, get, load)
+		if err != nil {
+			l.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error refresh cache"))
+			return res, nil
+		}
+		return fresh, nil
+	}
+
+	l.Metrics.Misses.Add(1)
+	return loadShared(ctx, l, key, false, get, load)
+}
+
+// loadShared loads the entry under key with load, concurrent loads of key
+// share one call of load. refresh tells that the entry is still cached.
+// The shared load runs on its own context bounded by LoadTimeout, so the
+// caller that started it going away does not fail it for the others, and
+// each caller stops waiting once its own ctx is done. The request context
+// is not carried over, gin reuses it once the request is served.
+func loadShared[T any](ctx context.Context, l *Loader, key string, refresh bool, get func(ctx context.Context) (T, time.Duration, error), load func(ctx context.Context) (T, error)) (T, error) {
+	leader := false
+	ch := l.group.DoChan(key, func() (interface{}, error) {
+		leader = true
+		ctx, cancel := context.WithTimeout(context.Background(), l.loadTimeout())
+		defer cancel()
+		if l.Conf.Lock {
+			return loadLocked(ctx, l, key, refresh, get, load)
+		}
+		return timedLoad(ctx, l, load)
+	})
+
+	var res T
+	select {
+	case <-ctx.Done():
+		return res, ctx.Err()
+	case r := <-ch:
+		if !leader {
+			l.Metrics.Coalesced.Add(1)
+		}
+		res, _ = r.Val.(T)
+		return res, r.Err
+	}
+}
+
+func (l *Loader) loadTimeout() time.Duration {
+	if l.Conf.LoadTimeout == 0 {
+		return model.DefaultCacheLoadTimeout
+	}
+	return l.Conf.LoadTimeout
+}
+
+// loadLocked loads the entry under key once across instances. The instance
+// holding the lock loads it while the others wait for it to be cached, and
+// load it themselves if it is not by the time the lock times out. When
+// refreshing, the others keep the entry that is still cached.
+func loadLocked[T any](ctx context.Context, l *Loader, key string, refresh bool, get func(ctx context.Context) (T, time.Duration, error), load func(ctx context.Context) (T, error)) (T, error) {
+	timeout := l.Conf.LockTimeout
+	if timeout == 0 {
+		timeout = model.DefaultCacheLockTimeout
+	}
+
+	lockKey := fmt.Sprintf(model.CacheLockKey, key)
+	token := uuid.NewString()
+	locked, err := l.Redis.SetNX(ctx, lockKey, token, timeout).Result()
+	if err != nil {
+		Warn(ctx, l.Log, err, "error lock cache")
+		return timedLoad(ctx, l, load)
+	}
+
+	if locked {
+		defer func() {
+			if err := unlockScript.Run(ctx, l.Redis, []string{lockKey}, token).Err(); err != nil {
+				Warn(ctx, l.Log, err, "error unlock cache")
+			}
+		}()
+
+		if !refresh {
+			// the previous holder may have cached it just before
+			res, _, err := get(ctx)
+			if err == nil {
+				l.Metrics.Coalesced.Add(1)
+				return res, nil
+			}
+		}
+		return timedLoad(ctx, l, load)
+	}
+
+	ticker := time.NewTicker(model.CacheLockPollInterval)
+	defer ticker.Stop()
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			var res T
+			return res, ctx.Err()
+		case <-ticker.C:
+		}
+
+		res, _, err := get(ctx)
+		if err == nil {
+			l.Metrics.Coalesced.Add(1)
+			return res, nil
+		}
+		if err != goredislib.Nil {
+			break
+		}
+	}
+	return timedLoad(ctx, l, load)
+}
+
+// timedLoad calls load and records how long it took.
+func timedLoad[T any](ctx context.Context, l *Loader, load func(ctx context.Context) (T, error)) (T, error) {
+	start := time.Now()
+	res, err := load(ctx)
+	if err == nil {
+		l.loadTime.Store(int64(time.Since(start)))
+	}
+	return res, err
+}
+
+// refreshEarly decides whether an entry with ttl left is reloaded before it
+// expires. Following probabilistic early expiration, it is when the load
+// time scaled by beta and a random factor reaches past the expiry, so the
+// reload rarely happens long before and one request likely does it first.
+func (l *Loader) refreshEarly(ttl time.Duration) bool {
+	if !l.Conf.EarlyRefresh || ttl <= 0 {
+		return false
+	}
+
+	beta := l.Conf.EarlyRefreshBeta
+	if beta == 0 {
+		beta = model.DefaultEarlyRefreshBeta
+	}
+	delta := float64(l.loadTime.Load())
+	if delta == 0 {
+		delta = float64(model.DefaultCacheLoadTime)
+	}
+	return -delta*beta*math.Log(1-rand.Float64()) >= float64(ttl)
+}
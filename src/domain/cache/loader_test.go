@@ -0,0 +1,239 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+// testSource is a cached string loaded from a counted source.
+type testSource struct {
+	rds     *goredislib.Client
+	key     string
+	loads   atomic.Int64
+	release chan struct{}
+}
+
+func (s *testSource) get(ctx context.Context) (string, time.Duration, error) {
+	res, err := s.rds.Get(ctx, s.key).Result()
+	if err != nil {
+		return res, 0, err
+	}
+	ttl, err := s.rds.PTTL(ctx, s.key).Result()
+	return res, ttl, err
+}
+
+func (s *testSource) load(ctx context.Context) (string, error) {
+	s.loads.Add(1)
+	if s.release != nil {
+		<-s.release
+	}
+	return "loaded", s.rds.Set(ctx, s.key, "loaded", time.Minute).Err()
+}
+
+func newTestLoader(t *testing.T, name string, conf LoaderConf) (*Loader, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rds := goredislib.NewClient(&goredislib.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rds.Close() })
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	l := NewLoader(name, conf, &log, rds)
+	// published metrics outlive the test, count from zero on every run
+	l.Metrics = &Metrics{}
+	return l, mr
+}
+
+func TestFetchHitAndMiss(t *testing.T) {
+	l, _ := newTestLoader(t, "testHitMiss", LoaderConf{})
+	src := &testSource{rds: l.Redis, key: "k"}
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		res, err := Fetch(ctx, l, src.key, src.get, src.load)
+		if err != nil || res != "loaded" {
+			t.Fatalf("got %q, %v", res, err)
+		}
+	}
+	if src.loads.Load() != 1 {
+		t.Fatalf("loaded %d times, want 1", src.loads.Load())
+	}
+	if l.Metrics.Misses.Load() != 1 || l.Metrics.Hits.Load() != 2 {
+		t.Fatalf("metrics %s", l.Metrics)
+	}
+}
+
+func TestFetchCoalescesConcurrentMisses(t *testing.T) {
+	l, _ := newTestLoader(t, "testCoalesce", LoaderConf{})
+	src := &testSource{rds: l.Redis, key: "k", release: make(chan struct{})}
+	ctx := context.Background()
+
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := Fetch(ctx, l, src.key, src.get, src.load)
+			errs <- err
+		}()
+	}
+	// every request misses and joins the load before it is released
+	for l.Metrics.Misses.Load() < n {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(src.release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if src.loads.Load() != 1 {
+		t.Fatalf("loaded %d times, want 1", src.loads.Load())
+	}
+	if l.Metrics.Coalesced.Load() != n-1 {
+		t.Fatalf("metrics %s", l.Metrics)
+	}
+}
+
+func TestFetchSharedLoadOutlivesLeader(t *testing.T) {
+	l, _ := newTestLoader(t, "testDetached", LoaderConf{})
+	src := &testSource{rds: l.Redis, key: "k", release: make(chan struct{})}
+	leaderCtx, cancel := context.WithCancel(context.Background())
+
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := Fetch(leaderCtx, l, src.key, src.get, src.load)
+		leaderErr <- err
+	}()
+	for src.loads.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	res := make(chan string, 1)
+	go func() {
+		v, err := Fetch(context.Background(), l, src.key, src.get, src.load)
+		if err != nil {
+			t.Error(err)
+		}
+		res <- v
+	}()
+	for l.Metrics.Misses.Load() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	// the request that started the load goes away, the load goes on
+	cancel()
+	if err := <-leaderErr; err != context.Canceled {
+		t.Fatalf("got %v, want %v", err, context.Canceled)
+	}
+	close(src.release)
+	if v := <-res; v != "loaded" {
+		t.Fatalf("got %q, want loaded", v)
+	}
+	if src.loads.Load() != 1 {
+		t.Fatalf("loaded %d times, want 1", src.loads.Load())
+	}
+}
+
+func TestFetchLockWaitsForOtherInstance(t *testing.T) {
+	l, mr := newTestLoader(t, "testLock", LoaderConf{Lock: true, LockTimeout: time.Second})
+	src := &testSource{rds: l.Redis, key: "k"}
+	ctx := context.Background()
+
+	// another instance holds the lock and caches the entry meanwhile
+	mr.Set("lockCache:k", "other")
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		mr.Set("k", "cached")
+	}()
+
+	res, err := Fetch(ctx, l, src.key, src.get, src.load)
+	if err != nil || res != "cached" {
+		t.Fatalf("got %q, %v", res, err)
+	}
+	if src.loads.Load() != 0 {
+		t.Fatalf("loaded %d times, want 0", src.loads.Load())
+	}
+	if l.Metrics.Coalesced.Load() != 1 {
+		t.Fatalf("metrics %s", l.Metrics)
+	}
+}
+
+func TestFetchLockTimesOut(t *testing.T) {
+	l, mr := newTestLoader(t, "testLockTimeout", LoaderConf{Lock: true, LockTimeout: 100 * time.Millisecond})
+	src := &testSource{rds: l.Redis, key: "k"}
+
+	mr.Set("lockCache:k", "other")
+	res, err := Fetch(context.Background(), l, src.key, src.get, src.load)
+	if err != nil || res != "loaded" {
+		t.Fatalf("got %q, %v", res, err)
+	}
+	if src.loads.Load() != 1 {
+		t.Fatalf("loaded %d times, want 1", src.loads.Load())
+	}
+	if v, _ := mr.Get("lockCache:k"); v != "other" {
+		t.Fatalf("lock of the other instance released, got %q", v)
+	}
+}
+
+func TestFetchLockReleased(t *testing.T) {
+	l, mr := newTestLoader(t, "testLockRelease", LoaderConf{Lock: true})
+	src := &testSource{rds: l.Redis, key: "k"}
+
+	if _, err := Fetch(context.Background(), l, src.key, src.get, src.load); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists("lockCache:k") {
+		t.Fatal("lock still held after loading")
+	}
+}
+
+func TestFetchEarlyRefresh(t *testing.T) {
+	// a beta this large refreshes on every hit
+	l, mr := newTestLoader(t, "testEarlyRefresh", LoaderConf{EarlyRefresh: true, EarlyRefreshBeta: 1e9, Lock: true})
+	src := &testSource{rds: l.Redis, key: "k"}
+	mr.Set("k", "stale")
+	mr.SetTTL("k", time.Minute)
+
+	res, err := Fetch(context.Background(), l, src.key, src.get, src.load)
+	if err != nil || res != "loaded" {
+		t.Fatalf("got %q, %v", res, err)
+	}
+	if l.Metrics.EarlyRefreshes.Load() != 1 || l.Metrics.Hits.Load() != 0 {
+		t.Fatalf("metrics %s", l.Metrics)
+	}
+}
+
+func TestRefreshEarly(t *testing.T) {
+	l, _ := newTestLoader(t, "testRefreshEarly", LoaderConf{})
+	if l.refreshEarly(time.Nanosecond) {
+		t.Fatal("refreshed with early refresh off")
+	}
+
+	l.Conf.EarlyRefresh = true
+	l.loadTime.Store(int64(time.Millisecond))
+	refreshed := 0
+	for i := 0; i < 1000; i++ {
+		if l.refreshEarly(time.Hour) {
+			refreshed++
+		}
+	}
+	if refreshed != 0 {
+		t.Fatalf("refreshed %d of 1000 entries an hour from expiring", refreshed)
+	}
+	if l.refreshEarly(0) {
+		t.Fatal("refreshed an entry without expiry")
+	}
+}
@@ -0,0 +1,209 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+// locals are the local caches of the process, Invalidate and Listen evict
+// from all of them.
+var locals struct {
+	sync.Mutex
+	all []*Local
+}
+
+// Local is a bounded in-process cache in front of redis, it evicts the
+// least recently used entry when full and keeps each entry for a short
+// ttl. Entries are tagged like in redis and evicted along with them, by
+// any instance through the invalidation channel. A nil Local caches
+// nothing.
+type Local struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	ll    *list.List
+	items map[string]*list.Element
+	tags  map[string]map[string]struct{}
+}
+
+type localEntry struct {
+	key     string
+	data    string
+	expires time.Time
+	tags    []string
+}
+
+func NewLocal(size int, ttl time.Duration) *Local {
+	if size == 0 {
+		size = model.DefaultLocalCacheSize
+	}
+	if ttl == 0 {
+		ttl = model.DefaultLocalCacheTTL
+	}
+
+	l := &Local{
+		size:  size,
+		ttl:   ttl,
+		ll:    list.New(),
+		items: map[string]*list.Element{},
+		tags:  map[string]map[string]struct{}{},
+	}
+	locals.Lock()
+	locals.all = append(locals.all, l)
+	locals.Unlock()
+	return l
+}
+
+// Get returns the data cached under key.
+func (l *Local) Get(key string) (string, bool) {
+	if l == nil {
+		return "", false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	el, ok := l.items[key]
+	if !ok {
+		return "", false
+	}
+	entry := el.Value.(*localEntry)
+	if time.Now().After(entry.expires) {
+		l.remove(el)
+		return "", false
+	}
+	l.ll.MoveToFront(el)
+	return entry.data, true
+}
+
+// Set caches data under key with tags, for at most ttl or the ttl of l.
+func (l *Local) Set(key string, data string, ttl time.Duration, tags ...string) {
+	if l == nil {
+		return
+	}
+	if ttl <= 0 || ttl > l.ttl {
+		ttl = l.ttl
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if el, ok := l.items[key]; ok {
+		l.remove(el)
+	}
+	l.items[key] = l.ll.PushFront(&localEntry{
+		key:     key,
+		data:    data,
+		expires: time.Now().Add(ttl),
+		tags:    tags,
+	})
+	for _, tag := range tags {
+		if l.tags[tag] == nil {
+			l.tags[tag] = map[string]struct{}{}
+		}
+		l.tags[tag][key] = struct{}{}
+	}
+
+	for l.ll.Len() > l.size {
+		l.remove(l.ll.Back())
+	}
+}
+
+// Evict evicts every entry cached with any of tags.
+func (l *Local) Evict(tags ...string) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, tag := range tags {
+		for key := range l.tags[tag] {
+			if el, ok := l.items[key]; ok {
+				l.remove(el)
+			}
+		}
+	}
+}
+
+// Flush evicts every entry.
+func (l *Local) Flush() {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.ll.Init()
+	l.items = map[string]*list.Element{}
+	l.tags = map[string]map[string]struct{}{}
+}
+
+// Len returns how many entries are cached.
+func (l *Local) Len() int {
+	if l == nil {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.ll.Len()
+}
+
+func (l *Local) remove(el *list.Element) {
+	entry := l.ll.Remove(el).(*localEntry)
+	delete(l.items, entry.key)
+	for _, tag := range entry.tags {
+		delete(l.tags[tag], entry.key)
+		if len(l.tags[tag]) == 0 {
+			delete(l.tags, tag)
+		}
+	}
+}
+
+func evictLocal(tags ...string) {
+	locals.Lock()
+	defer locals.Unlock()
+	for _, l := range locals.all {
+		l.Evict(tags...)
+	}
+}
+
+func flushLocal() {
+	locals.Lock()
+	defer locals.Unlock()
+	for _, l := range locals.all {
+		l.Flush()
+	}
+}
+
+// Listen evicts the local entries of the tags invalidated by any instance
+// until ctx is done. Invalidations published while it is disconnected are
+// lost, so the local caches are flushed whenever it subscribes again.
+func Listen(ctx context.Context, rds *goredislib.Client) {
+	sub := rds.Subscribe(ctx, model.CacheInvalidationChannel)
+	defer sub.Close()
+
+	ch := sub.ChannelWithSubscriptions()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			switch m := msg.(type) {
+			case *goredislib.Subscription:
+				flushLocal()
+			case *goredislib.Message:
+				evictLocal(strings.Fields(m.Payload)...)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/alicebob/miniredis/v2"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+func TestLocalEvictsLeastRecentlyUsed(t *testing.T) {
+	l := NewLocal(2, time.Minute)
+	l.Set("a", "1", 0)
+	l.Set("b", "2", 0)
+	l.Get("a")
+	l.Set("c", "3", 0)
+
+	if _, ok := l.Get("b"); ok {
+		t.Fatal("least recently used entry kept")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := l.Get(key); !ok {
+			t.Fatalf("entry %s evicted", key)
+		}
+	}
+	if l.Len() != 2 {
+		t.Fatalf("len %d, want 2", l.Len())
+	}
+}
+
+func TestLocalExpires(t *testing.T) {
+	l := NewLocal(0, 20*time.Millisecond)
+	l.Set("a", "1", time.Hour)
+	l.Set("b", "2", time.Millisecond)
+
+	time.Sleep(5 * time.Millisecond)
+	if _, ok := l.Get("b"); ok {
+		t.Fatal("entry kept past its ttl")
+	}
+	if _, ok := l.Get("a"); !ok {
+		t.Fatal("entry expired early")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := l.Get("a"); ok {
+		t.Fatal("entry kept past the ttl of the cache")
+	}
+}
+
+func TestLocalEvictsTags(t *testing.T) {
+	l := NewLocal(0, time.Minute)
+	l.Set("a", "1", 0, "x", "y")
+	l.Set("b", "2", 0, "y")
+	l.Set("c", "3", 0, "z")
+
+	l.Evict("y")
+	if l.Len() != 1 {
+		t.Fatalf("len %d, want 1", l.Len())
+	}
+	if _, ok := l.Get("c"); !ok {
+		t.Fatal("untagged entry evicted")
+	}
+	if len(l.tags) != 1 {
+		t.Fatalf("tags of evicted entries kept: %v", l.tags)
+	}
+}
+
+func TestNilLocal(t *testing.T) {
+	var l *Local
+	l.Set("a", "1", 0, "x")
+	if _, ok := l.Get("a"); ok {
+		t.Fatal("nil local cached")
+	}
+	l.Evict("x")
+	l.Flush()
+}
+
+func TestListenEvictsPublishedTags(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rds := goredislib.NewClient(&goredislib.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rds.Close() })
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// subscribing flushes the local caches
+	local := NewLocal(0, time.Minute)
+	local.Set("s", "0", 0)
+	go Listen(ctx, rds)
+	for local.Len() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+	local.Set("a", "1", 0, "x")
+	local.Set("b", "2", 0, "y")
+	local.Set("c", "3", 0, "z")
+
+	// published by the invalidation of another instance
+	mr.Publish(model.CacheInvalidationChannel, "x z")
+	deadline := time.Now().Add(time.Second)
+	for local.Len() != 1 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if _, ok := local.Get("b"); !ok || local.Len() != 1 {
+		t.Fatalf("len %d, want only b cached", local.Len())
+	}
+}
+
+func TestInvalidatePublishesTags(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rds := goredislib.NewClient(&goredislib.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rds.Close() })
+	ctx := context.Background()
+
+	sub := rds.Subscribe(ctx, model.CacheInvalidationChannel)
+	t.Cleanup(func() { sub.Close() })
+	if _, err := sub.Receive(ctx); err != nil {
+		t.Fatal(err)
+	}
+	local := NewLocal(0, time.Minute)
+	local.Set("a", "1", 0, "x")
+	if err := Set(ctx, rds, "a", "1", time.Minute, "x"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Invalidate(ctx, rds, "x", "y"); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists("a") || local.Len() != 0 {
+		t.Fatal("entry not invalidated")
+	}
+	msg, err := sub.ReceiveMessage(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Payload != "x y" {
+		t.Fatalf("published %q, want \"x y\"", msg.Payload)
+	}
+}
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"encoding/json"
+	"expvar"
+	"sync"
+	"sync/atomic"
+)
+
+// metrics publishes the Metrics of every cache by name, it is served as
+// the cache expvar.
+var (
+	metrics   = expvar.NewMap("cache")
+	metricsMu sync.Mutex
+)
+
+// Metrics counts how the reads of a cache were served. Coalesced reads
+// missed but shared the load of another read, early refreshes hit but
+// were reloaded before expiring, local hits are the hits served in
+// process.
+type Metrics struct {
+	Hits           atomic.Int64
+	Misses         atomic.Int64
+	Coalesced      atomic.Int64
+	EarlyRefreshes atomic.Int64
+	LocalHits      atomic.Int64
+}
+
+// NewMetrics returns the Metrics published as name, caches of the same
+// name share them.
+func NewMetrics(name string) *Metrics {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	if m, ok := metrics.Get(name).(*Metrics); ok {
+		return m
+	}
+
+	m := &Metrics{}
+	metrics.Set(name, m)
+	return m
+}
+
+func (m *Metrics) String() string {
+	data, _ := json.Marshal(map[string]int64{
+		"hits":            m.Hits.Load(),
+		"misses":          m.Misses.Load(),
+		"coalesced":       m.Coalesced.Load(),
+		"early_refreshes": m.EarlyRefreshes.Load(),
+		"local_hits":      m.LocalHits.Load(),
+	})
+	return string(data)
+}
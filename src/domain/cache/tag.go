@@ -0,0 +1,102 @@
+// Package cache holds the helpers shared by the redis read-through caches
+// of the domains.
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+// invalidateScript evicts the keys listed in every tag of KEYS and the tags
+// themselves in one step, so a key tagged while invalidating is not left
+// behind untracked. The tags are published to the channel ARGV[1] for the
+// local caches of every instance.
+var invalidateScript = goredislib.NewScript(`
+for _, tag in ipairs(KEYS) do
+	local keys = redis.call("SMEMBERS", tag)
+	for i = 1, #keys, 500 do
+		redis.call("DEL", unpack(keys, i, math.min(i + 499, #keys)))
+	end
+	redis.call("DEL", tag)
+end
+redis.call("PUBLISH", ARGV[1], table.concat(KEYS, " "))
+return 0
+`)
+
+// failed holds per redis client the tags whose invalidation failed. They
+// are invalidated again along with the next tags invalidated, or by Replay
+// once redis is reachable again, so their entries do not stay stale until
+// they expire.
+var failed struct {
+	sync.Mutex
+	tags map[*goredislib.Client]map[string]bool
+}
+
+// Set stores data under key for exp and adds key to the redis set of each
+// tag, a tag names what the cached data depends on, like an entity id.
+// The tag sets expire together with their newest key.
+func Set(ctx context.Context, rds *goredislib.Client, key string, data string, exp time.Duration, tags ...string) error {
+	_, err := rds.TxPipelined(ctx, func(pipe goredislib.Pipeliner) error {
+		pipe.Set(ctx, key, data, exp)
+		for _, tag := range tags {
+			pipe.SAdd(ctx, tag, key)
+			pipe.Expire(ctx, tag, exp)
+		}
+		return nil
+	})
+	return err
+}
+
+// Invalidate evicts every key stored with any of tags, from redis and from
+// the local caches. Those of this instance are evicted right away, the
+// others once the tags published reach them. Tags that failed to be
+// invalidated before are retried with them, and kept for later again if
+// redis still fails.
+func Invalidate(ctx context.Context, rds *goredislib.Client, tags ...string) error {
+	tags = append(tags, takeFailed(rds)...)
+	if len(tags) == 0 {
+		return nil
+	}
+	evictLocal(tags...)
+	err := invalidateScript.Run(ctx, rds, tags, model.CacheInvalidationChannel).Err()
+	if err != nil {
+		addFailed(rds, tags...)
+	}
+	return err
+}
+
+// Replay invalidates the tags that failed to be invalidated on rds, called
+// when redis is reachable again.
+func Replay(ctx context.Context, rds *goredislib.Client) error {
+	return Invalidate(ctx, rds)
+}
+
+func addFailed(rds *goredislib.Client, tags ...string) {
+	failed.Lock()
+	defer failed.Unlock()
+	if failed.tags == nil {
+		failed.tags = map[*goredislib.Client]map[string]bool{}
+	}
+	if failed.tags[rds] == nil {
+		failed.tags[rds] = map[string]bool{}
+	}
+	for _, tag := range tags {
+		failed.tags[rds][tag] = true
+	}
+}
+
+func takeFailed(rds *goredislib.Client) []string {
+	failed.Lock()
+	defer failed.Unlock()
+	var tags []string
+	for tag := range failed.tags[rds] {
+		tags = append(tags, tag)
+	}
+	delete(failed.tags, rds)
+	return tags
+}
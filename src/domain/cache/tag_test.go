@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+func newTestRedis(t *testing.T) (*goredislib.Client, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rds := goredislib.NewClient(&goredislib.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rds.Close() })
+	return rds, mr
+}
+
+func TestSetTagsKey(t *testing.T) {
+	rds, mr := newTestRedis(t)
+	ctx := context.Background()
+
+	if err := Set(ctx, rds, "a", "1", time.Minute, "x", "y"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Set(ctx, rds, "b", "2", time.Minute, "x"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, _ := mr.Get("a"); got != "1" {
+		t.Fatalf("got %q, want \"1\"", got)
+	}
+	for tag, want := range map[string][]string{"x": {"a", "b"}, "y": {"a"}} {
+		members, err := mr.Members(tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(members, want) {
+			t.Errorf("tag %s holds %v, want %v", tag, members, want)
+		}
+		if ttl := mr.TTL(tag); ttl != time.Minute {
+			t.Errorf("tag %s expires in %s, want %s", tag, ttl, time.Minute)
+		}
+	}
+}
+
+func TestInvalidateEvictsTaggedKeys(t *testing.T) {
+	rds, mr := newTestRedis(t)
+	ctx := context.Background()
+
+	// more keys than the script deletes at once
+	for i := 0; i < 1200; i++ {
+		if err := Set(ctx, rds, fmt.Sprintf("k%d", i), "v", time.Minute, "x"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := Set(ctx, rds, "kept", "v", time.Minute, "y"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Invalidate(ctx, rds, "x"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 1200; i++ {
+		if mr.Exists(fmt.Sprintf("k%d", i)) {
+			t.Fatalf("k%d still cached", i)
+		}
+	}
+	if mr.Exists("x") {
+		t.Error("tag kept after its keys were evicted")
+	}
+	if !mr.Exists("kept") || !mr.Exists("y") {
+		t.Error("key of another tag evicted")
+	}
+}
+
+func TestInvalidateRetriesFailedTags(t *testing.T) {
+	rds, mr := newTestRedis(t)
+	ctx := context.Background()
+
+	if err := Set(ctx, rds, "a", "1", time.Minute, "x"); err != nil {
+		t.Fatal(err)
+	}
+	mr.Close()
+	if err := Invalidate(ctx, rds, "x"); err == nil {
+		t.Fatal("invalidated while redis is down")
+	}
+	if err := mr.Restart(); err != nil {
+		t.Fatal(err)
+	}
+	if !mr.Exists("a") {
+		t.Fatal("key lost on restart")
+	}
+
+	// the failed tag goes along with the next tags invalidated
+	if err := Invalidate(ctx, rds, "y"); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists("a") || mr.Exists("x") {
+		t.Error("entry of the failed tag still cached")
+	}
+	if tags := takeFailed(rds); len(tags) != 0 {
+		t.Errorf("tags %v kept after a successful invalidation", tags)
+	}
+}
@@ -0,0 +1,62 @@
+package denylist
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+type DenyListDep struct {
+	Log   logger.Logger
+	Redis *goredislib.Client
+	Conf  Conf
+}
+
+type Conf struct{}
+
+// DenyListInterface keeps revoked access tokens in redis until they would
+// have expired anyway. Single tokens are listed by jti; revoking an account
+// records the time, to the millisecond, before which all of its tokens are
+// rejected. Methods take context.Context because they are called while
+// verifying tokens, outside of any handler.
+type DenyListInterface interface {
+	RevokeToken(ctx context.Context, jti string, ttl time.Duration) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	ConsumeToken(ctx context.Context, jti string, ttl time.Duration) (bool, error)
+	RevokeAccount(ctx context.Context, accountID int64, at time.Time, ttl time.Duration) error
+	GetAccountRevokedAt(ctx context.Context, accountID int64) (time.Time, error)
+}
+
+func New(conf Conf, log *logger.Logger, rds *goredislib.Client) DenyListInterface {
+	return &DenyListDep{
+		Log:   *log,
+		Redis: rds,
+		Conf:  conf,
+	}
+}
+
+func (d *DenyListDep) RevokeToken(ctx context.Context, jti string, ttl time.Duration) error {
+	return d.revokeTokenRedis(ctx, jti, ttl)
+}
+
+func (d *DenyListDep) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	return d.isTokenRevokedRedis(ctx, jti)
+}
+
+// ConsumeToken records jti as used and reports whether this call was the
+// first to do so.
+func (d *DenyListDep) ConsumeToken(ctx context.Context, jti string, ttl time.Duration) (bool, error) {
+	return d.consumeTokenRedis(ctx, jti, ttl)
+}
+
+func (d *DenyListDep) RevokeAccount(ctx context.Context, accountID int64, at time.Time, ttl time.Duration) error {
+	return d.revokeAccountRedis(ctx, accountID, at, ttl)
+}
+
+// GetAccountRevokedAt returns the zero time when the account has never
+// been revoked or the record already expired.
+func (d *DenyListDep) GetAccountRevokedAt(ctx context.Context, accountID int64) (time.Time, error) {
+	return d.getAccountRevokedAtRedis(ctx, accountID)
+}
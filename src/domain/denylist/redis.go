@@ -0,0 +1,61 @@
+package denylist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+func (d *DenyListDep) revokeTokenRedis(ctx context.Context, jti string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	_, err := d.Redis.Set(ctx, fmt.Sprintf(model.RevokedTokenKey, jti), 1, ttl).Result()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set revoked token")
+	}
+	return nil
+}
+
+func (d *DenyListDep) isTokenRevokedRedis(ctx context.Context, jti string) (bool, error) {
+	n, err := d.Redis.Exists(ctx, fmt.Sprintf(model.RevokedTokenKey, jti)).Result()
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get revoked token")
+	}
+	return n > 0, nil
+}
+
+func (d *DenyListDep) consumeTokenRedis(ctx context.Context, jti string, ttl time.Duration) (bool, error) {
+	if ttl <= 0 {
+		return false, nil
+	}
+	ok, err := d.Redis.SetNX(ctx, fmt.Sprintf(model.UsedTokenKey, jti), 1, ttl).Result()
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set used token")
+	}
+	return ok, nil
+}
+
+func (d *DenyListDep) revokeAccountRedis(ctx context.Context, accountID int64, at time.Time, ttl time.Duration) error {
+	_, err := d.Redis.Set(ctx, fmt.Sprintf(model.RevokedAccountKey, accountID), at.UnixMilli(), ttl).Result()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set revoked account")
+	}
+	return nil
+}
+
+func (d *DenyListDep) getAccountRevokedAtRedis(ctx context.Context, accountID int64) (time.Time, error) {
+	at, err := d.Redis.Get(ctx, fmt.Sprintf(model.RevokedAccountKey, accountID)).Int64()
+	if err == goredislib.Nil {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get revoked account")
+	}
+	return time.UnixMilli(at), nil
+}
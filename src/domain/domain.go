@@ -1,11 +1,30 @@
 package domain
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/achwanyusuf/carrent-accountsvc/src/domain/account"
 	"github.com/achwanyusuf/carrent-accountsvc/src/domain/accountrole"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/apikey"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/auditlog"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/authcode"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/cache"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/denylist"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/invitation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/loginattempt"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/mailer"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/organisation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/organisationmember"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/passwordhistory"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/passwordreset"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/permission"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/ratelimit"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/recoverycode"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/refreshtoken"
 	"github.com/achwanyusuf/carrent-accountsvc/src/domain/role"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/rolepermission"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/signingkey"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
 	goredislib "github.com/redis/go-redis/v9"
 )
@@ -18,21 +37,86 @@ type DomainDep struct {
 }
 
 type Config struct {
-	Account     account.Conf     `mapstructure:"account"`
-	Role        role.Conf        `mapstructure:"role"`
-	AccountRole accountrole.Conf `mapstructure:"account_role"`
+	Account            account.Conf            `mapstructure:"account"`
+	Role               role.Conf               `mapstructure:"role"`
+	AccountRole        accountrole.Conf        `mapstructure:"account_role"`
+	RefreshToken       refreshtoken.Conf       `mapstructure:"refresh_token"`
+	SigningKey         signingkey.Conf         `mapstructure:"signing_key"`
+	AuthCode           authcode.Conf           `mapstructure:"auth_code"`
+	DenyList           denylist.Conf           `mapstructure:"deny_list"`
+	Mailer             mailer.Conf             `mapstructure:"mailer"`
+	RateLimit          ratelimit.Conf          `mapstructure:"rate_limit"`
+	PasswordReset      passwordreset.Conf      `mapstructure:"password_reset"`
+	RecoveryCode       recoverycode.Conf       `mapstructure:"recovery_code"`
+	LoginAttempt       loginattempt.Conf       `mapstructure:"login_attempt"`
+	PasswordHistory    passwordhistory.Conf    `mapstructure:"password_history"`
+	Permission         permission.Conf         `mapstructure:"permission"`
+	RolePermission     rolepermission.Conf     `mapstructure:"role_permission"`
+	Organisation       organisation.Conf       `mapstructure:"organisation"`
+	OrganisationMember organisationmember.Conf `mapstructure:"organisation_member"`
+	Invitation         invitation.Conf         `mapstructure:"invitation"`
+	AuditLog           auditlog.Conf           `mapstructure:"audit_log"`
+	APIKey             apikey.Conf             `mapstructure:"api_key"`
+	Cache              cache.BreakerConf       `mapstructure:"cache"`
 }
 
 type DomainInterface struct {
-	Account     account.AccountInterface
-	Role        role.RoleInterface
-	AccountRole accountrole.AccountRoleInterface
+	Account            account.AccountInterface
+	Role               role.RoleInterface
+	AccountRole        accountrole.AccountRoleInterface
+	RefreshToken       refreshtoken.RefreshTokenInterface
+	SigningKey         signingkey.SigningKeyInterface
+	AuthCode           authcode.AuthCodeInterface
+	DenyList           denylist.DenyListInterface
+	Mailer             mailer.MailerInterface
+	RateLimit          ratelimit.RateLimitInterface
+	PasswordReset      passwordreset.PasswordResetInterface
+	RecoveryCode       recoverycode.RecoveryCodeInterface
+	LoginAttempt       loginattempt.LoginAttemptInterface
+	PasswordHistory    passwordhistory.PasswordHistoryInterface
+	Permission         permission.PermissionInterface
+	RolePermission     rolepermission.RolePermissionInterface
+	Organisation       organisation.OrganisationInterface
+	OrganisationMember organisationmember.OrganisationMemberInterface
+	Invitation         invitation.InvitationInterface
+	AuditLog           auditlog.AuditLogInterface
+	APIKey             apikey.APIKeyInterface
 }
 
 func New(d *DomainDep) *DomainInterface {
+	// the caches get their own client behind the breaker, so they read
+	// through to psql while redis is unreachable without the deny-list,
+	// lockouts and one-time tokens sharing the client being cut off too
+	cacheRedis := goredislib.NewClient(d.Redis.Options())
+	breaker := cache.NewBreaker(d.Conf.Cache, d.Log)
+	// invalidations that failed while redis was unreachable are replayed
+	// once it is back, the entries they missed would be stale until expiry
+	breaker.OnClose = func(ctx context.Context) {
+		if err := cache.Replay(ctx, cacheRedis); err != nil {
+			cache.Warn(ctx, *d.Log, err, "error replay cache invalidation")
+		}
+	}
+	cacheRedis.AddHook(breaker)
 	return &DomainInterface{
-		account.New(d.Conf.Account, d.Log, d.DB, d.Redis),
-		role.New(d.Conf.Role, d.Log, d.DB, d.Redis),
-		accountrole.New(d.Conf.AccountRole, d.Log, d.DB, d.Redis),
+		account.New(d.Conf.Account, d.Log, d.DB, cacheRedis),
+		role.New(d.Conf.Role, d.Log, d.DB, cacheRedis),
+		accountrole.New(d.Conf.AccountRole, d.Log, d.DB, cacheRedis),
+		refreshtoken.New(d.Conf.RefreshToken, d.Log, d.DB),
+		signingkey.New(d.Conf.SigningKey, d.Log, d.DB),
+		authcode.New(d.Conf.AuthCode, d.Log, d.Redis),
+		denylist.New(d.Conf.DenyList, d.Log, d.Redis),
+		mailer.New(d.Conf.Mailer, d.Log),
+		ratelimit.New(d.Conf.RateLimit, d.Log, d.Redis),
+		passwordreset.New(d.Conf.PasswordReset, d.Log, d.Redis),
+		recoverycode.New(d.Conf.RecoveryCode, d.Log, d.DB),
+		loginattempt.New(d.Conf.LoginAttempt, d.Log, d.Redis),
+		passwordhistory.New(d.Conf.PasswordHistory, d.Log, d.DB),
+		permission.New(d.Conf.Permission, d.Log, d.DB, cacheRedis),
+		rolepermission.New(d.Conf.RolePermission, d.Log, d.DB, cacheRedis),
+		organisation.New(d.Conf.Organisation, d.Log, d.DB, cacheRedis),
+		organisationmember.New(d.Conf.OrganisationMember, d.Log, d.DB, cacheRedis),
+		invitation.New(d.Conf.Invitation, d.Log, d.DB, cacheRedis),
+		auditlog.New(d.Conf.AuditLog, d.Log, d.DB),
+		apikey.New(d.Conf.APIKey, d.Log, d.DB),
 	}
 }
@@ -0,0 +1,90 @@
+package invitation
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/cache"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/repository"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type InvitationDep struct {
+	*repository.Repository[psqlmodel.Invitation, *psqlmodel.Invitation, psqlmodel.InvitationSlice, *model.GetInvitationByParam, *model.GetInvitationsByParam]
+}
+
+type Conf = repository.Conf
+
+type InvitationInterface interface {
+	Insert(ctx *gin.Context, data *psqlmodel.Invitation) error
+	GetSingleByParam(ctx *gin.Context, cacheControl string, param *model.GetInvitationByParam) (psqlmodel.Invitation, error)
+	Update(ctx *gin.Context, v *psqlmodel.Invitation) error
+	Delete(ctx *gin.Context, v *psqlmodel.Invitation, id int64, isHardDelete bool) error
+	GetByParam(ctx *gin.Context, cacheControl string, param *model.GetInvitationsByParam) (psqlmodel.InvitationSlice, model.Pagination, error)
+	Accept(ctx *gin.Context, invitation *psqlmodel.Invitation, account *psqlmodel.Account, accountRole *psqlmodel.AccountRole, member *psqlmodel.OrganisationMember) error
+}
+
+// schema caches an invitation under its own id and the ids of its role,
+// organisation and account, so writing any of them evicts it.
+var schema = repository.Schema[psqlmodel.Invitation, psqlmodel.InvitationSlice]{
+	Name: "invitation",
+	Query: func(mods ...qm.QueryMod) repository.Query[psqlmodel.Invitation, psqlmodel.InvitationSlice] {
+		return psqlmodel.Invitations(mods...)
+	},
+	ID: func(i *psqlmodel.Invitation) int {
+		return i.ID
+	},
+	SetDeletedBy: func(i *psqlmodel.Invitation, id int) {
+		i.DeletedBy = null.NewInt(id, true)
+	},
+	SingleKey: model.GetSingleByParamInvitationKey,
+	ListKey:   model.GetByParamInvitationKey,
+	ListPgKey: model.GetByParamInvitationPgKey,
+	Tag:       model.InvitationCacheTag,
+	ListTag:   model.InvitationListCacheTag,
+	DependsOn: func(i *psqlmodel.Invitation) []string {
+		tags := []string{fmt.Sprintf(model.RoleCacheTag, i.RoleID)}
+		if i.OrganisationID.Valid {
+			tags = append(tags, fmt.Sprintf(model.OrganisationCacheTag, i.OrganisationID.Int))
+		}
+		if i.AccountID.Valid {
+			tags = append(tags, fmt.Sprintf(model.AccountCacheTag, i.AccountID.Int))
+		}
+		return tags
+	},
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client) InvitationInterface {
+	return &InvitationDep{
+		Repository: repository.New[psqlmodel.Invitation, *psqlmodel.Invitation, psqlmodel.InvitationSlice, *model.GetInvitationByParam, *model.GetInvitationsByParam](conf, log, db, rds, schema),
+	}
+}
+
+// Accept creates account with its role grant, and its organisation
+// membership if member is set, and marks invitation accepted, all in one
+// transaction. It fails without creating anything when invitation is no
+// longer pending, so an invitation can only be accepted once. The cached
+// invitation is evicted along with the account, account role and member
+// lists that miss the new rows.
+func (i *InvitationDep) Accept(ctx *gin.Context, invitation *psqlmodel.Invitation, account *psqlmodel.Account, accountRole *psqlmodel.AccountRole, member *psqlmodel.OrganisationMember) error {
+	err := i.acceptPSQL(ctx, invitation, account, accountRole, member)
+	if err != nil {
+		return err
+	}
+
+	i.Evict(ctx, false, invitation.ID)
+	err = cache.Invalidate(ctx, i.Redis, model.AccountListCacheTag, model.AccountRoleListCacheTag, model.OrganisationMemberListCacheTag)
+	if err != nil {
+		i.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error invalidate cache"))
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package invitation
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (i *InvitationDep) acceptPSQL(ctx *gin.Context, invitation *psqlmodel.Invitation, account *psqlmodel.Account, accountRole *psqlmodel.AccountRole, member *psqlmodel.OrganisationMember) error {
+	tx, err := i.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	now := time.Now()
+	rows, err := psqlmodel.Invitations(
+		qm.Where("id=?", invitation.ID),
+		qm.Where("accepted_at is null"),
+		qm.Where("revoked_at is null"),
+		qm.Where("expired_at>?", now),
+	).UpdateAll(ctx, tx, psqlmodel.M{
+		psqlmodel.InvitationColumns.AcceptedAt: null.TimeFrom(now),
+		psqlmodel.InvitationColumns.UpdatedAt:  now,
+	})
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			i.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorUpdate, err, "error update")
+	}
+
+	if rows == 0 {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			i.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, errRollback, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, nil, "invitation no longer pending")
+	}
+
+	err = account.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			i.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, err, "error insert account")
+	}
+
+	accountRole.AccountID = account.ID
+	err = accountRole.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			i.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, err, "error insert account role")
+	}
+
+	if member != nil {
+		member.AccountID = account.ID
+		err = member.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				i.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, err, "error insert organisation member")
+		}
+	}
+
+	invitation.AcceptedAt = null.TimeFrom(now)
+	invitation.AccountID = null.IntFrom(account.ID)
+	invitation.UpdatedBy = account.ID
+	_, err = invitation.Update(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			i.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorUpdate, err, "error update")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package loginattempt
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+type LoginAttemptDep struct {
+	Log   logger.Logger
+	Redis *goredislib.Client
+	Conf  Conf
+}
+
+type Conf struct{}
+
+// LoginAttemptInterface tracks failed logins per subject, an account email
+// or a client ip, in redis. Every key expires on its own, so nothing has to
+// clean up after a quiet period.
+type LoginAttemptInterface interface {
+	RecordFailure(ctx *gin.Context, subject string, window time.Duration) (int64, error)
+	Delay(ctx *gin.Context, subject string, d time.Duration) error
+	Lock(ctx *gin.Context, subject string, d time.Duration) error
+	Status(ctx *gin.Context, subject string) (model.LoginAttemptStatus, error)
+	Reset(ctx *gin.Context, subject string) error
+}
+
+func New(conf Conf, log *logger.Logger, rds *goredislib.Client) LoginAttemptInterface {
+	return &LoginAttemptDep{
+		Log:   *log,
+		Redis: rds,
+		Conf:  conf,
+	}
+}
+
+// RecordFailure counts one failure and returns the number of failures in
+// the window opened by the first one.
+func (l *LoginAttemptDep) RecordFailure(ctx *gin.Context, subject string, window time.Duration) (int64, error) {
+	return l.incrFailureRedis(ctx, subject, window)
+}
+
+// Delay refuses logins for subject during d.
+func (l *LoginAttemptDep) Delay(ctx *gin.Context, subject string, d time.Duration) error {
+	return l.setRedis(ctx, model.LoginDelayKey, subject, d)
+}
+
+// Lock refuses logins for subject during d and clears its failure count, so
+// counting starts over once the lock ends.
+func (l *LoginAttemptDep) Lock(ctx *gin.Context, subject string, d time.Duration) error {
+	return l.lockRedis(ctx, subject, d)
+}
+
+func (l *LoginAttemptDep) Status(ctx *gin.Context, subject string) (model.LoginAttemptStatus, error) {
+	return l.statusRedis(ctx, subject)
+}
+
+// Reset forgets the failures, delay and lock of subject.
+func (l *LoginAttemptDep) Reset(ctx *gin.Context, subject string) error {
+	return l.delRedis(ctx, subject)
+}
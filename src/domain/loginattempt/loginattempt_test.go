@@ -0,0 +1,75 @@
+package loginattempt
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+func newTestLoginAttempt(t *testing.T) (LoginAttemptInterface, *miniredis.Miniredis, *gin.Context) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rds := goredislib.NewClient(&goredislib.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rds.Close() })
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("POST", "/", nil)
+	return New(Conf{}, &log, rds), mr, ctx
+}
+
+func TestRecordFailureWindow(t *testing.T) {
+	l, mr, ctx := newTestLoginAttempt(t)
+	key := fmt.Sprintf(model.LoginFailureKey, "a@b.c")
+
+	for i := int64(1); i <= 3; i++ {
+		count, err := l.RecordFailure(ctx, "a@b.c", time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != i {
+			t.Fatalf("count %d, want %d", count, i)
+		}
+		mr.FastForward(10 * time.Second)
+	}
+	// the window opened by the first failure is not extended
+	if ttl := mr.TTL(key); ttl != 30*time.Second {
+		t.Fatalf("ttl %s, want 30s", ttl)
+	}
+
+	mr.FastForward(30 * time.Second)
+	count, err := l.RecordFailure(ctx, "a@b.c", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("count %d after the window, want 1", count)
+	}
+}
+
+func TestRecordFailureOpensMissingWindow(t *testing.T) {
+	l, mr, ctx := newTestLoginAttempt(t)
+	key := fmt.Sprintf(model.LoginFailureKey, "a@b.c")
+
+	// a count left without a window, as an INCR not followed by its EXPIRE
+	// used to leave it, gets one on the next failure
+	mr.Set(key, "4")
+	count, err := l.RecordFailure(ctx, "a@b.c", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 5 {
+		t.Fatalf("count %d, want 5", count)
+	}
+	if ttl := mr.TTL(key); ttl != time.Minute {
+		t.Fatalf("ttl %s, want 1m", ttl)
+	}
+}
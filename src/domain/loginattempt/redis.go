@@ -0,0 +1,86 @@
+package loginattempt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+// incrScript counts one failure under KEYS[1] and opens a window of ARGV[1]
+// milliseconds when the count has none, in one step so a count is never
+// left without a window to expire with.
+var incrScript = goredislib.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+func (l *LoginAttemptDep) incrFailureRedis(ctx *gin.Context, subject string, window time.Duration) (int64, error) {
+	key := fmt.Sprintf(model.LoginFailureKey, subject)
+	count, err := incrScript.Run(ctx, l.Redis, []string{key}, window.Milliseconds()).Int64()
+	if err != nil {
+		return 0, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error incr login failure")
+	}
+	return count, nil
+}
+
+func (l *LoginAttemptDep) setRedis(ctx *gin.Context, keyFormat, subject string, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	_, err := l.Redis.Set(ctx, fmt.Sprintf(keyFormat, subject), 1, d).Result()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set login attempt")
+	}
+	return nil
+}
+
+func (l *LoginAttemptDep) lockRedis(ctx *gin.Context, subject string, d time.Duration) error {
+	pipe := l.Redis.TxPipeline()
+	pipe.Set(ctx, fmt.Sprintf(model.LoginLockKey, subject), 1, d)
+	pipe.Del(ctx, fmt.Sprintf(model.LoginFailureKey, subject), fmt.Sprintf(model.LoginDelayKey, subject))
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error lock login")
+	}
+	return nil
+}
+
+func (l *LoginAttemptDep) statusRedis(ctx *gin.Context, subject string) (model.LoginAttemptStatus, error) {
+	var res model.LoginAttemptStatus
+	pipe := l.Redis.Pipeline()
+	lock := pipe.PTTL(ctx, fmt.Sprintf(model.LoginLockKey, subject))
+	delay := pipe.PTTL(ctx, fmt.Sprintf(model.LoginDelayKey, subject))
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get login attempt")
+	}
+
+	// PTTL answers with a negative duration for missing keys
+	if d := lock.Val(); d > 0 {
+		res.LockedFor = d
+	}
+	if d := delay.Val(); d > 0 {
+		res.DelayedFor = d
+	}
+	return res, nil
+}
+
+func (l *LoginAttemptDep) delRedis(ctx *gin.Context, subject string) error {
+	_, err := l.Redis.Del(ctx,
+		fmt.Sprintf(model.LoginFailureKey, subject),
+		fmt.Sprintf(model.LoginDelayKey, subject),
+		fmt.Sprintf(model.LoginLockKey, subject),
+	).Result()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error reset login attempt")
+	}
+	return nil
+}
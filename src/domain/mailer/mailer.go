@@ -0,0 +1,38 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+)
+
+var (
+	DriverSMTP   string = "smtp"
+	DriverMemory string = "memory"
+)
+
+type Conf struct {
+	Driver   string `mapstructure:"driver"`
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	UserName string `mapstructure:"user_name"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+}
+
+// MailerInterface delivers transactional mail. The driver is picked from
+// Conf so tests and local setups can swap SMTP for the in-memory sender.
+type MailerInterface interface {
+	Send(ctx context.Context, mail model.Mail) error
+}
+
+func New(conf Conf, log *logger.Logger) MailerInterface {
+	if conf.Driver == DriverMemory {
+		return NewMemory()
+	}
+	return &SMTPDep{
+		Log:  *log,
+		Conf: conf,
+	}
+}
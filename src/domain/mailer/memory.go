@@ -0,0 +1,33 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+)
+
+// MemoryMailer keeps sent mail in memory instead of delivering it. It is
+// meant for tests and local development.
+type MemoryMailer struct {
+	mu       sync.Mutex
+	messages []model.Mail
+}
+
+func NewMemory() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (m *MemoryMailer) Send(ctx context.Context, mail model.Mail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, mail)
+	return nil
+}
+
+// Messages returns a copy of everything sent so far.
+func (m *MemoryMailer) Messages() []model.Mail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]model.Mail(nil), m.messages...)
+}
@@ -0,0 +1,40 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+)
+
+type SMTPDep struct {
+	Log  logger.Logger
+	Conf Conf
+}
+
+func (s *SMTPDep) Send(ctx context.Context, mail model.Mail) error {
+	if strings.ContainsAny(mail.To+mail.Subject, "\r\n") {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "invalid mail header")
+	}
+
+	var auth smtp.Auth
+	if s.Conf.UserName != "" {
+		auth = smtp.PlainAuth("", s.Conf.UserName, s.Conf.Password, s.Conf.Host)
+	}
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s",
+		s.Conf.From, mail.To, mail.Subject, mail.Body)
+	addr := net.JoinHostPort(s.Conf.Host, strconv.Itoa(s.Conf.Port))
+	err := smtp.SendMail(addr, auth, s.Conf.From, []string{mail.To}, []byte(msg))
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error send mail")
+	}
+	return nil
+}
@@ -5,7 +5,9 @@
 package mock_accountrole
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/achwanyusuf/carrent-accountsvc/src/model"
 	psqlmodel "github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
@@ -50,6 +52,21 @@ func (mr *MockAccountRoleInterfaceMockRecorder) Delete(ctx, AccountRole, id, isH
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountRoleInterface)(nil).Delete), ctx, AccountRole, id, isHardDelete)
 }
 
+// Expire mocks base method.
+func (m *MockAccountRoleInterface) Expire(ctx context.Context, at time.Time, limit int) (psqlmodel.AccountRoleSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", ctx, at, limit)
+	ret0, _ := ret[0].(psqlmodel.AccountRoleSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockAccountRoleInterfaceMockRecorder) Expire(ctx, at, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockAccountRoleInterface)(nil).Expire), ctx, at, limit)
+}
+
 // GetByParam mocks base method.
 func (m *MockAccountRoleInterface) GetByParam(ctx *gin.Context, cacheControl string, param *model.GetAccountRolesByParam) (psqlmodel.AccountRoleSlice, model.Pagination, error) {
 	m.ctrl.T.Helper()
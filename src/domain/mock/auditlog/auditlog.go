@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/domain/auditlog/auditlog.go
+
+// Package mock_auditlog is a generated GoMock package.
+package mock_auditlog
+
+import (
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/carrent-accountsvc/src/model"
+	psqlmodel "github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditLogInterface is a mock of AuditLogInterface interface.
+type MockAuditLogInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogInterfaceMockRecorder
+}
+
+// MockAuditLogInterfaceMockRecorder is the mock recorder for MockAuditLogInterface.
+type MockAuditLogInterfaceMockRecorder struct {
+	mock *MockAuditLogInterface
+}
+
+// NewMockAuditLogInterface creates a new mock instance.
+func NewMockAuditLogInterface(ctrl *gomock.Controller) *MockAuditLogInterface {
+	mock := &MockAuditLogInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditLogInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogInterface) EXPECT() *MockAuditLogInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetByParam mocks base method.
+func (m *MockAuditLogInterface) GetByParam(ctx *gin.Context, param *model.GetAuditLogsByParam) (psqlmodel.AuditLogSlice, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, param)
+	ret0, _ := ret[0].(psqlmodel.AuditLogSlice)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockAuditLogInterfaceMockRecorder) GetByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockAuditLogInterface)(nil).GetByParam), ctx, param)
+}
+
+// Insert mocks base method.
+func (m *MockAuditLogInterface) Insert(ctx *gin.Context, data *psqlmodel.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockAuditLogInterfaceMockRecorder) Insert(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockAuditLogInterface)(nil).Insert), ctx, data)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/domain/authcode/authcode.go
+
+// Package mock_authcode is a generated GoMock package.
+package mock_authcode
+
+import (
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/carrent-accountsvc/src/model"
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthCodeInterface is a mock of AuthCodeInterface interface.
+type MockAuthCodeInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthCodeInterfaceMockRecorder
+}
+
+// MockAuthCodeInterfaceMockRecorder is the mock recorder for MockAuthCodeInterface.
+type MockAuthCodeInterfaceMockRecorder struct {
+	mock *MockAuthCodeInterface
+}
+
+// NewMockAuthCodeInterface creates a new mock instance.
+func NewMockAuthCodeInterface(ctrl *gomock.Controller) *MockAuthCodeInterface {
+	mock := &MockAuthCodeInterface{ctrl: ctrl}
+	mock.recorder = &MockAuthCodeInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthCodeInterface) EXPECT() *MockAuthCodeInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockAuthCodeInterface) Insert(ctx *gin.Context, codeHash string, data *model.AuthorizationCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, codeHash, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockAuthCodeInterfaceMockRecorder) Insert(ctx, codeHash, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockAuthCodeInterface)(nil).Insert), ctx, codeHash, data)
+}
+
+// Take mocks base method.
+func (m *MockAuthCodeInterface) Take(ctx *gin.Context, codeHash string) (model.AuthorizationCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, codeHash)
+	ret0, _ := ret[0].(model.AuthorizationCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockAuthCodeInterfaceMockRecorder) Take(ctx, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockAuthCodeInterface)(nil).Take), ctx, codeHash)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/domain/passwordhistory/passwordhistory.go
+
+// Package mock_passwordhistory is a generated GoMock package.
+package mock_passwordhistory
+
+import (
+	reflect "reflect"
+
+	psqlmodel "github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPasswordHistoryInterface is a mock of PasswordHistoryInterface interface.
+type MockPasswordHistoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordHistoryInterfaceMockRecorder
+}
+
+// MockPasswordHistoryInterfaceMockRecorder is the mock recorder for MockPasswordHistoryInterface.
+type MockPasswordHistoryInterfaceMockRecorder struct {
+	mock *MockPasswordHistoryInterface
+}
+
+// NewMockPasswordHistoryInterface creates a new mock instance.
+func NewMockPasswordHistoryInterface(ctrl *gomock.Controller) *MockPasswordHistoryInterface {
+	mock := &MockPasswordHistoryInterface{ctrl: ctrl}
+	mock.recorder = &MockPasswordHistoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordHistoryInterface) EXPECT() *MockPasswordHistoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetRecent mocks base method.
+func (m *MockPasswordHistoryInterface) GetRecent(ctx *gin.Context, accountID, limit int) (psqlmodel.PasswordHistorySlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecent", ctx, accountID, limit)
+	ret0, _ := ret[0].(psqlmodel.PasswordHistorySlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecent indicates an expected call of GetRecent.
+func (mr *MockPasswordHistoryInterfaceMockRecorder) GetRecent(ctx, accountID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecent", reflect.TypeOf((*MockPasswordHistoryInterface)(nil).GetRecent), ctx, accountID, limit)
+}
+
+// Insert mocks base method.
+func (m *MockPasswordHistoryInterface) Insert(ctx *gin.Context, data *psqlmodel.PasswordHistory, keep int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, data, keep)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockPasswordHistoryInterfaceMockRecorder) Insert(ctx, data, keep interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockPasswordHistoryInterface)(nil).Insert), ctx, data, keep)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/domain/ratelimit/ratelimit.go
+
+// Package mock_ratelimit is a generated GoMock package.
+package mock_ratelimit
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRateLimitInterface is a mock of RateLimitInterface interface.
+type MockRateLimitInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitInterfaceMockRecorder
+}
+
+// MockRateLimitInterfaceMockRecorder is the mock recorder for MockRateLimitInterface.
+type MockRateLimitInterfaceMockRecorder struct {
+	mock *MockRateLimitInterface
+}
+
+// NewMockRateLimitInterface creates a new mock instance.
+func NewMockRateLimitInterface(ctrl *gomock.Controller) *MockRateLimitInterface {
+	mock := &MockRateLimitInterface{ctrl: ctrl}
+	mock.recorder = &MockRateLimitInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitInterface) EXPECT() *MockRateLimitInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockRateLimitInterface) Allow(ctx *gin.Context, key string, limit int64, window time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, key, limit, window)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimitInterfaceMockRecorder) Allow(ctx, key, limit, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimitInterface)(nil).Allow), ctx, key, limit, window)
+}
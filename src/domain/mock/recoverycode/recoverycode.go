@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/domain/recoverycode/recoverycode.go
+
+// Package mock_recoverycode is a generated GoMock package.
+package mock_recoverycode
+
+import (
+	reflect "reflect"
+
+	psqlmodel "github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRecoveryCodeInterface is a mock of RecoveryCodeInterface interface.
+type MockRecoveryCodeInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecoveryCodeInterfaceMockRecorder
+}
+
+// MockRecoveryCodeInterfaceMockRecorder is the mock recorder for MockRecoveryCodeInterface.
+type MockRecoveryCodeInterfaceMockRecorder struct {
+	mock *MockRecoveryCodeInterface
+}
+
+// NewMockRecoveryCodeInterface creates a new mock instance.
+func NewMockRecoveryCodeInterface(ctrl *gomock.Controller) *MockRecoveryCodeInterface {
+	mock := &MockRecoveryCodeInterface{ctrl: ctrl}
+	mock.recorder = &MockRecoveryCodeInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecoveryCodeInterface) EXPECT() *MockRecoveryCodeInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteByAccount mocks base method.
+func (m *MockRecoveryCodeInterface) DeleteByAccount(ctx *gin.Context, accountID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByAccount", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByAccount indicates an expected call of DeleteByAccount.
+func (mr *MockRecoveryCodeInterfaceMockRecorder) DeleteByAccount(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByAccount", reflect.TypeOf((*MockRecoveryCodeInterface)(nil).DeleteByAccount), ctx, accountID)
+}
+
+// Replace mocks base method.
+func (m *MockRecoveryCodeInterface) Replace(ctx *gin.Context, accountID int, data psqlmodel.RecoveryCodeSlice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, accountID, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockRecoveryCodeInterfaceMockRecorder) Replace(ctx, accountID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockRecoveryCodeInterface)(nil).Replace), ctx, accountID, data)
+}
+
+// Use mocks base method.
+func (m *MockRecoveryCodeInterface) Use(ctx *gin.Context, accountID int, codeHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Use", ctx, accountID, codeHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Use indicates an expected call of Use.
+func (mr *MockRecoveryCodeInterfaceMockRecorder) Use(ctx, accountID, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Use", reflect.TypeOf((*MockRecoveryCodeInterface)(nil).Use), ctx, accountID, codeHash)
+}
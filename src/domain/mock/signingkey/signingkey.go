@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/domain/signingkey/signingkey.go
+
+// Package mock_signingkey is a generated GoMock package.
+package mock_signingkey
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/carrent-accountsvc/src/model"
+	psqlmodel "github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSigningKeyInterface is a mock of SigningKeyInterface interface.
+type MockSigningKeyInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSigningKeyInterfaceMockRecorder
+}
+
+// MockSigningKeyInterfaceMockRecorder is the mock recorder for MockSigningKeyInterface.
+type MockSigningKeyInterfaceMockRecorder struct {
+	mock *MockSigningKeyInterface
+}
+
+// NewMockSigningKeyInterface creates a new mock instance.
+func NewMockSigningKeyInterface(ctrl *gomock.Controller) *MockSigningKeyInterface {
+	mock := &MockSigningKeyInterface{ctrl: ctrl}
+	mock.recorder = &MockSigningKeyInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSigningKeyInterface) EXPECT() *MockSigningKeyInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetByParam mocks base method.
+func (m *MockSigningKeyInterface) GetByParam(ctx context.Context, param *model.GetSigningKeysByParam) (psqlmodel.SigningKeySlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, param)
+	ret0, _ := ret[0].(psqlmodel.SigningKeySlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockSigningKeyInterfaceMockRecorder) GetByParam(ctx, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockSigningKeyInterface)(nil).GetByParam), ctx, param)
+}
+
+// Insert mocks base method.
+func (m *MockSigningKeyInterface) Insert(ctx context.Context, data *psqlmodel.SigningKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSigningKeyInterfaceMockRecorder) Insert(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSigningKeyInterface)(nil).Insert), ctx, data)
+}
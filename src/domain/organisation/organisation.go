@@ -0,0 +1,60 @@
+package organisation
+
+import (
+	"database/sql"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/repository"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type OrganisationDep struct {
+	*repository.Repository[psqlmodel.Organisation, *psqlmodel.Organisation, psqlmodel.OrganisationSlice, *model.GetOrganisationByParam, *model.GetOrganisationsByParam]
+}
+
+type Conf = repository.Conf
+
+type OrganisationInterface interface {
+	Insert(ctx *gin.Context, data *psqlmodel.Organisation) error
+	GetSingleByParam(ctx *gin.Context, cacheControl string, param *model.GetOrganisationByParam) (psqlmodel.Organisation, error)
+	Update(ctx *gin.Context, v *psqlmodel.Organisation) error
+	Delete(ctx *gin.Context, v *psqlmodel.Organisation, id int64, isHardDelete bool) error
+	GetByParam(ctx *gin.Context, cacheControl string, param *model.GetOrganisationsByParam) (psqlmodel.OrganisationSlice, model.Pagination, error)
+}
+
+// schema caches an organisation under its id, a hard delete cascades to
+// its members, invitations and account roles.
+var schema = repository.Schema[psqlmodel.Organisation, psqlmodel.OrganisationSlice]{
+	Name: "organisation",
+	Query: func(mods ...qm.QueryMod) repository.Query[psqlmodel.Organisation, psqlmodel.OrganisationSlice] {
+		return psqlmodel.Organisations(mods...)
+	},
+	ID: func(o *psqlmodel.Organisation) int {
+		return o.ID
+	},
+	SetDeletedBy: func(o *psqlmodel.Organisation, id int) {
+		o.DeletedBy = null.NewInt(id, true)
+	},
+	SingleKey: model.GetSingleByParamOrganisationKey,
+	ListKey:   model.GetByParamOrganisationKey,
+	ListPgKey: model.GetByParamOrganisationPgKey,
+	Tag:       model.OrganisationCacheTag,
+	ListTag:   model.OrganisationListCacheTag,
+	Cascade: []string{
+		model.OrganisationMemberListCacheTag,
+		model.InvitationListCacheTag,
+		model.AccountRoleListCacheTag,
+	},
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client) OrganisationInterface {
+	return &OrganisationDep{
+		Repository: repository.New[psqlmodel.Organisation, *psqlmodel.Organisation, psqlmodel.OrganisationSlice, *model.GetOrganisationByParam, *model.GetOrganisationsByParam](conf, log, db, rds, schema),
+	}
+}
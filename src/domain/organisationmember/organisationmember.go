@@ -0,0 +1,62 @@
+package organisationmember
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/repository"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type OrganisationMemberDep struct {
+	*repository.Repository[psqlmodel.OrganisationMember, *psqlmodel.OrganisationMember, psqlmodel.OrganisationMemberSlice, *model.GetOrganisationMemberByParam, *model.GetOrganisationMembersByParam]
+}
+
+type Conf = repository.Conf
+
+type OrganisationMemberInterface interface {
+	Insert(ctx *gin.Context, data *psqlmodel.OrganisationMember) error
+	GetSingleByParam(ctx *gin.Context, cacheControl string, param *model.GetOrganisationMemberByParam) (psqlmodel.OrganisationMember, error)
+	Update(ctx *gin.Context, v *psqlmodel.OrganisationMember) error
+	Delete(ctx *gin.Context, v *psqlmodel.OrganisationMember, id int64, isHardDelete bool) error
+	GetByParam(ctx *gin.Context, cacheControl string, param *model.GetOrganisationMembersByParam) (psqlmodel.OrganisationMemberSlice, model.Pagination, error)
+}
+
+// schema caches a member under its own id and the ids of its organisation
+// and account, so writing either evicts it.
+var schema = repository.Schema[psqlmodel.OrganisationMember, psqlmodel.OrganisationMemberSlice]{
+	Name: "organisation_member",
+	Query: func(mods ...qm.QueryMod) repository.Query[psqlmodel.OrganisationMember, psqlmodel.OrganisationMemberSlice] {
+		return psqlmodel.OrganisationMembers(mods...)
+	},
+	ID: func(m *psqlmodel.OrganisationMember) int {
+		return m.ID
+	},
+	SetDeletedBy: func(m *psqlmodel.OrganisationMember, id int) {
+		m.DeletedBy = null.NewInt(id, true)
+	},
+	SingleKey: model.GetSingleByParamOrganisationMemberKey,
+	ListKey:   model.GetByParamOrganisationMemberKey,
+	ListPgKey: model.GetByParamOrganisationMemberPgKey,
+	Tag:       model.OrganisationMemberCacheTag,
+	ListTag:   model.OrganisationMemberListCacheTag,
+	DependsOn: func(m *psqlmodel.OrganisationMember) []string {
+		return []string{
+			fmt.Sprintf(model.OrganisationCacheTag, m.OrganisationID),
+			fmt.Sprintf(model.AccountCacheTag, m.AccountID),
+		}
+	},
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client) OrganisationMemberInterface {
+	return &OrganisationMemberDep{
+		Repository: repository.New[psqlmodel.OrganisationMember, *psqlmodel.OrganisationMember, psqlmodel.OrganisationMemberSlice, *model.GetOrganisationMemberByParam, *model.GetOrganisationMembersByParam](conf, log, db, rds, schema),
+	}
+}
@@ -0,0 +1,42 @@
+package passwordhistory
+
+import (
+	"database/sql"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type PasswordHistoryDep struct {
+	Log  logger.Logger
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct{}
+
+type PasswordHistoryInterface interface {
+	Insert(ctx *gin.Context, data *psqlmodel.PasswordHistory, keep int) error
+	GetRecent(ctx *gin.Context, accountID int, limit int) (psqlmodel.PasswordHistorySlice, error)
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB) PasswordHistoryInterface {
+	return &PasswordHistoryDep{
+		Log:  *log,
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+// Insert stores a password hash of the account and, in the same
+// transaction, drops all but the keep most recent ones.
+func (p *PasswordHistoryDep) Insert(ctx *gin.Context, data *psqlmodel.PasswordHistory, keep int) error {
+	return p.insertPSQL(ctx, data, keep)
+}
+
+// GetRecent returns up to limit password hashes of the account, newest
+// first.
+func (p *PasswordHistoryDep) GetRecent(ctx *gin.Context, accountID int, limit int) (psqlmodel.PasswordHistorySlice, error) {
+	return p.getRecentPSQL(ctx, accountID, limit)
+}
@@ -0,0 +1,54 @@
+package passwordhistory
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (p *PasswordHistoryDep) insertPSQL(ctx *gin.Context, data *psqlmodel.PasswordHistory, keep int) error {
+	tx, err := p.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = data.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			p.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, err, "error insert")
+	}
+
+	_, err = psqlmodel.PasswordHistories(
+		qm.Where("account_id=?", data.AccountID),
+		qm.Where("id not in (select id from password_histories where account_id=? order by created_at desc, id desc limit ?)", data.AccountID, keep),
+	).DeleteAll(ctx, tx, true)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			p.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorDelete, err, "error delete")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (p *PasswordHistoryDep) getRecentPSQL(ctx *gin.Context, accountID int, limit int) (psqlmodel.PasswordHistorySlice, error) {
+	res, err := psqlmodel.PasswordHistories(
+		qm.Where("account_id=?", accountID),
+		qm.OrderBy("created_at desc, id desc"),
+		qm.Limit(limit),
+	).All(ctx, p.DB)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get password history")
+	}
+	return res, nil
+}
@@ -0,0 +1,52 @@
+package passwordreset
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+type PasswordResetDep struct {
+	Log   logger.Logger
+	Redis *goredislib.Client
+	Conf  Conf
+}
+
+type Conf struct {
+	RedisExpirationTime time.Duration `mapstructure:"expiration_time"`
+}
+
+// PasswordResetInterface stores password reset tokens in redis under the
+// sha256 of the token, so a leaked redis dump holds no usable token.
+type PasswordResetInterface interface {
+	Insert(ctx *gin.Context, tokenHash string, data *model.PasswordReset) error
+	Get(ctx *gin.Context, tokenHash string) (model.PasswordReset, error)
+	Take(ctx *gin.Context, tokenHash string) (model.PasswordReset, error)
+}
+
+func New(conf Conf, log *logger.Logger, rds *goredislib.Client) PasswordResetInterface {
+	return &PasswordResetDep{
+		Log:   *log,
+		Redis: rds,
+		Conf:  conf,
+	}
+}
+
+func (p *PasswordResetDep) Insert(ctx *gin.Context, tokenHash string, data *model.PasswordReset) error {
+	return p.setRedis(ctx, tokenHash, data)
+}
+
+// Get returns the reset request and leaves it in place, so a request that
+// fails its checks does not use the token up.
+func (p *PasswordResetDep) Get(ctx *gin.Context, tokenHash string) (model.PasswordReset, error) {
+	return p.getRedis(ctx, tokenHash)
+}
+
+// Take returns the reset request and deletes it in the same command, which
+// makes every token single-use.
+func (p *PasswordResetDep) Take(ctx *gin.Context, tokenHash string) (model.PasswordReset, error) {
+	return p.getDelRedis(ctx, tokenHash)
+}
@@ -0,0 +1,76 @@
+package passwordreset
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+func newTestPasswordReset(t *testing.T) (PasswordResetInterface, *miniredis.Miniredis, *gin.Context) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rds := goredislib.NewClient(&goredislib.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rds.Close() })
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("POST", "/", nil)
+	return New(Conf{}, &log, rds), mr, ctx
+}
+
+func TestTakeIsSingleUse(t *testing.T) {
+	p, _, ctx := newTestPasswordReset(t)
+	issuedAt := time.Now().Truncate(time.Second)
+	err := p.Insert(ctx, "hash", &model.PasswordReset{AccountID: 7, Email: "a@b.c", IssuedAt: issuedAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// looking the token up does not use it
+	for i := 0; i < 2; i++ {
+		data, err := p.Get(ctx, "hash")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data.AccountID != 7 || data.Email != "a@b.c" || !data.IssuedAt.Equal(issuedAt) {
+			t.Fatalf("got %+v", data)
+		}
+	}
+
+	if _, err = p.Take(ctx, "hash"); err != nil {
+		t.Fatal(err)
+	}
+	for _, get := range []func(*gin.Context, string) (model.PasswordReset, error){p.Get, p.Take} {
+		_, err = get(ctx, "hash")
+		if code := errormsg.GetErrorCode(err); code != svcerr.CodeInvalidResetToken {
+			t.Fatalf("got code %d (%v) after take, want %d", code, err, svcerr.CodeInvalidResetToken)
+		}
+	}
+}
+
+func TestTokenExpires(t *testing.T) {
+	p, mr, ctx := newTestPasswordReset(t)
+	err := p.Insert(ctx, "hash", &model.PasswordReset{AccountID: 7, Email: "a@b.c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mr.FastForward(model.DefaultPasswordResetExpiration - time.Second)
+	if _, err = p.Get(ctx, "hash"); err != nil {
+		t.Fatal(err)
+	}
+	mr.FastForward(time.Second)
+	_, err = p.Take(ctx, "hash")
+	if code := errormsg.GetErrorCode(err); code != svcerr.CodeInvalidResetToken {
+		t.Fatalf("got code %d (%v) after expiry, want %d", code, err, svcerr.CodeInvalidResetToken)
+	}
+}
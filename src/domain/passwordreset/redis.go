@@ -0,0 +1,54 @@
+package passwordreset
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+func (p *PasswordResetDep) setRedis(ctx *gin.Context, tokenHash string, data *model.PasswordReset) error {
+	expTime := p.Conf.RedisExpirationTime
+	if p.Conf.RedisExpirationTime == 0 {
+		expTime = model.DefaultPasswordResetExpiration
+	}
+
+	res, err := json.Marshal(data)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal password reset")
+	}
+
+	_, err = p.Redis.Set(ctx, fmt.Sprintf(model.PasswordResetKey, tokenHash), string(res), expTime).Result()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error set password reset")
+	}
+	return nil
+}
+
+func (p *PasswordResetDep) getRedis(ctx *gin.Context, tokenHash string) (model.PasswordReset, error) {
+	return decodePasswordReset(p.Redis.Get(ctx, fmt.Sprintf(model.PasswordResetKey, tokenHash)).Result())
+}
+
+func (p *PasswordResetDep) getDelRedis(ctx *gin.Context, tokenHash string) (model.PasswordReset, error) {
+	return decodePasswordReset(p.Redis.GetDel(ctx, fmt.Sprintf(model.PasswordResetKey, tokenHash)).Result())
+}
+
+func decodePasswordReset(data string, err error) (model.PasswordReset, error) {
+	var res model.PasswordReset
+	if err == goredislib.Nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCInvalidResetToken, err, "password reset token not found")
+	}
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get password reset")
+	}
+
+	err = json.Unmarshal([]byte(data), &res)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal password reset")
+	}
+	return res, nil
+}
@@ -0,0 +1,57 @@
+package permission
+
+import (
+	"database/sql"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/repository"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type PermissionDep struct {
+	*repository.Repository[psqlmodel.Permission, *psqlmodel.Permission, psqlmodel.PermissionSlice, *model.GetPermissionByParam, *model.GetPermissionsByParam]
+}
+
+type Conf = repository.Conf
+
+type PermissionInterface interface {
+	Insert(ctx *gin.Context, data *psqlmodel.Permission) error
+	GetSingleByParam(ctx *gin.Context, cacheControl string, param *model.GetPermissionByParam) (psqlmodel.Permission, error)
+	Update(ctx *gin.Context, v *psqlmodel.Permission) error
+	Delete(ctx *gin.Context, v *psqlmodel.Permission, id int64, isHardDelete bool) error
+	GetByParam(ctx *gin.Context, cacheControl string, param *model.GetPermissionsByParam) (psqlmodel.PermissionSlice, model.Pagination, error)
+}
+
+// schema caches a permission under its id, a hard delete cascades to the
+// role permissions of the permission. The permission names cached per role
+// carry the list tag, so every write of a permission evicts them.
+var schema = repository.Schema[psqlmodel.Permission, psqlmodel.PermissionSlice]{
+	Name: "permission",
+	Query: func(mods ...qm.QueryMod) repository.Query[psqlmodel.Permission, psqlmodel.PermissionSlice] {
+		return psqlmodel.Permissions(mods...)
+	},
+	ID: func(p *psqlmodel.Permission) int {
+		return p.ID
+	},
+	SetDeletedBy: func(p *psqlmodel.Permission, id int) {
+		p.DeletedBy = null.NewInt(id, true)
+	},
+	SingleKey: model.GetSingleByParamPermissionKey,
+	ListKey:   model.GetByParamPermissionKey,
+	ListPgKey: model.GetByParamPermissionPgKey,
+	Tag:       model.PermissionCacheTag,
+	ListTag:   model.PermissionListCacheTag,
+	Cascade:   []string{model.RolePermissionListCacheTag},
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client) PermissionInterface {
+	return &PermissionDep{
+		Repository: repository.New[psqlmodel.Permission, *psqlmodel.Permission, psqlmodel.PermissionSlice, *model.GetPermissionByParam, *model.GetPermissionsByParam](conf, log, db, rds, schema),
+	}
+}
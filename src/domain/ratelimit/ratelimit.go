@@ -0,0 +1,40 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+type RateLimitDep struct {
+	Log   logger.Logger
+	Redis *goredislib.Client
+	Conf  Conf
+}
+
+type Conf struct{}
+
+// RateLimitInterface counts events per key in fixed redis windows.
+type RateLimitInterface interface {
+	Allow(ctx *gin.Context, key string, limit int64, window time.Duration) (bool, error)
+}
+
+func New(conf Conf, log *logger.Logger, rds *goredislib.Client) RateLimitInterface {
+	return &RateLimitDep{
+		Log:   *log,
+		Redis: rds,
+		Conf:  conf,
+	}
+}
+
+// Allow records one event for key and reports whether it is still within
+// limit events for the current window.
+func (r *RateLimitDep) Allow(ctx *gin.Context, key string, limit int64, window time.Duration) (bool, error) {
+	count, err := r.incrRedis(ctx, key, window)
+	if err != nil {
+		return false, err
+	}
+	return count <= limit, nil
+}
@@ -0,0 +1,29 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+// incrScript counts one event under KEYS[1] and opens a window of ARGV[1]
+// milliseconds when the count has none, in one step so a count is never
+// left without a window to expire with.
+var incrScript = goredislib.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+func (r *RateLimitDep) incrRedis(ctx *gin.Context, key string, window time.Duration) (int64, error) {
+	count, err := incrScript.Run(ctx, r.Redis, []string{key}, window.Milliseconds()).Int64()
+	if err != nil {
+		return 0, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error incr rate limit")
+	}
+	return count, nil
+}
@@ -0,0 +1,73 @@
+package recoverycode
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (r *RecoveryCodeDep) replacePSQL(ctx *gin.Context, accountID int, data psqlmodel.RecoveryCodeSlice) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	_, err = psqlmodel.RecoveryCodes(qm.Where("account_id=?", accountID)).DeleteAll(ctx, tx, true)
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			r.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorDelete, err, "error delete")
+	}
+
+	for _, v := range data {
+		err = v.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				r.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+			}
+			return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, err, "error insert")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (r *RecoveryCodeDep) usePSQL(ctx *gin.Context, accountID int, codeHash string) error {
+	now := time.Now()
+	rows, err := psqlmodel.RecoveryCodes(
+		qm.Where("account_id=?", accountID),
+		qm.Where("code_hash=?", codeHash),
+		qm.Where("used_at is null"),
+		qm.Where("deleted_at is null"),
+	).UpdateAll(ctx, r.DB, psqlmodel.M{
+		psqlmodel.RecoveryCodeColumns.UsedAt:    null.TimeFrom(now),
+		psqlmodel.RecoveryCodeColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorUpdate, err, "error use recovery code")
+	}
+
+	if rows == 0 {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidOTP, nil, "invalid recovery code")
+	}
+	return nil
+}
+
+func (r *RecoveryCodeDep) deleteByAccountPSQL(ctx *gin.Context, accountID int) error {
+	_, err := psqlmodel.RecoveryCodes(qm.Where("account_id=?", accountID)).DeleteAll(ctx, r.DB, true)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorDelete, err, "error delete recovery codes")
+	}
+	return nil
+}
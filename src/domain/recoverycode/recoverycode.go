@@ -0,0 +1,47 @@
+package recoverycode
+
+import (
+	"database/sql"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type RecoveryCodeDep struct {
+	Log  logger.Logger
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct{}
+
+type RecoveryCodeInterface interface {
+	Replace(ctx *gin.Context, accountID int, data psqlmodel.RecoveryCodeSlice) error
+	Use(ctx *gin.Context, accountID int, codeHash string) error
+	DeleteByAccount(ctx *gin.Context, accountID int) error
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB) RecoveryCodeInterface {
+	return &RecoveryCodeDep{
+		Log:  *log,
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+// Replace drops every recovery code of the account and stores data in the
+// same transaction, so a regenerated set fully invalidates the previous one.
+func (r *RecoveryCodeDep) Replace(ctx *gin.Context, accountID int, data psqlmodel.RecoveryCodeSlice) error {
+	return r.replacePSQL(ctx, accountID, data)
+}
+
+// Use marks an unused code as used. It fails with svcerr.AccountSVCInvalidOTP
+// when no unused code of the account matches codeHash.
+func (r *RecoveryCodeDep) Use(ctx *gin.Context, accountID int, codeHash string) error {
+	return r.usePSQL(ctx, accountID, codeHash)
+}
+
+func (r *RecoveryCodeDep) DeleteByAccount(ctx *gin.Context, accountID int) error {
+	return r.deleteByAccountPSQL(ctx, accountID)
+}
@@ -0,0 +1,126 @@
+package refreshtoken
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (r *RefreshTokenDep) insertPSQL(ctx *gin.Context, data *psqlmodel.RefreshToken) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = data.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			r.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, err, "error insert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (r *RefreshTokenDep) getSingleByParamPSQL(ctx *gin.Context, param *model.GetRefreshTokenByParam) (psqlmodel.RefreshToken, error) {
+	var res psqlmodel.RefreshToken
+	qr := param.GetQuery()
+	refreshToken, err := psqlmodel.RefreshTokens(qr...).One(ctx, r.DB)
+	if err == sql.ErrNoRows {
+		return res, errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, err, "error get refresh token")
+	}
+
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get refresh token")
+	}
+
+	return *refreshToken, nil
+}
+
+func (r *RefreshTokenDep) rotatePSQL(ctx *gin.Context, current *psqlmodel.RefreshToken, next *psqlmodel.RefreshToken) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	now := time.Now()
+	rows, err := psqlmodel.RefreshTokens(
+		qm.Where("id=?", current.ID),
+		qm.Where("rotated_at is null"),
+		qm.Where("revoked_at is null"),
+	).UpdateAll(ctx, tx, psqlmodel.M{
+		psqlmodel.RefreshTokenColumns.RotatedAt: null.TimeFrom(now),
+		psqlmodel.RefreshTokenColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			r.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorUpdate, err, "error update")
+	}
+
+	if rows == 0 {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			r.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, errRollback, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, nil, "refresh token already rotated")
+	}
+
+	err = next.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			r.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, err, "error insert")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error commit")
+	}
+	current.RotatedAt = null.TimeFrom(now)
+	return nil
+}
+
+func (r *RefreshTokenDep) revokeFamilyPSQL(ctx *gin.Context, family string) error {
+	now := time.Now()
+	_, err := psqlmodel.RefreshTokens(
+		qm.Where("family=?", family),
+		qm.Where("revoked_at is null"),
+	).UpdateAll(ctx, r.DB, psqlmodel.M{
+		psqlmodel.RefreshTokenColumns.RevokedAt: null.TimeFrom(now),
+		psqlmodel.RefreshTokenColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorUpdate, err, "error revoke refresh token family")
+	}
+	return nil
+}
+
+func (r *RefreshTokenDep) revokeByAccountPSQL(ctx context.Context, accountID int) error {
+	now := time.Now()
+	_, err := psqlmodel.RefreshTokens(
+		qm.Where("account_id=?", accountID),
+		qm.Where("revoked_at is null"),
+	).UpdateAll(ctx, r.DB, psqlmodel.M{
+		psqlmodel.RefreshTokenColumns.RevokedAt: null.TimeFrom(now),
+		psqlmodel.RefreshTokenColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorUpdate, err, "error revoke account refresh tokens")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package refreshtoken
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type RefreshTokenDep struct {
+	Log  logger.Logger
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct{}
+
+type RefreshTokenInterface interface {
+	Insert(ctx *gin.Context, data *psqlmodel.RefreshToken) error
+	GetSingleByParam(ctx *gin.Context, param *model.GetRefreshTokenByParam) (psqlmodel.RefreshToken, error)
+	Rotate(ctx *gin.Context, current *psqlmodel.RefreshToken, next *psqlmodel.RefreshToken) error
+	RevokeFamily(ctx *gin.Context, family string) error
+	RevokeByAccount(ctx context.Context, accountID int) error
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB) RefreshTokenInterface {
+	return &RefreshTokenDep{
+		Log:  *log,
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+func (r *RefreshTokenDep) Insert(ctx *gin.Context, data *psqlmodel.RefreshToken) error {
+	return r.insertPSQL(ctx, data)
+}
+
+func (r *RefreshTokenDep) GetSingleByParam(ctx *gin.Context, param *model.GetRefreshTokenByParam) (psqlmodel.RefreshToken, error) {
+	return r.getSingleByParamPSQL(ctx, param)
+}
+
+// Rotate marks current as used and stores next in the same family. It fails
+// with svcerr.AccountSVCInvalidRefreshToken when current has already been
+// rotated or revoked, so a concurrent replay can be detected by the caller.
+func (r *RefreshTokenDep) Rotate(ctx *gin.Context, current *psqlmodel.RefreshToken, next *psqlmodel.RefreshToken) error {
+	return r.rotatePSQL(ctx, current, next)
+}
+
+func (r *RefreshTokenDep) RevokeFamily(ctx *gin.Context, family string) error {
+	return r.revokeFamilyPSQL(ctx, family)
+}
+
+func (r *RefreshTokenDep) RevokeByAccount(ctx context.Context, accountID int) error {
+	return r.revokeByAccountPSQL(ctx, accountID)
+}
@@ -1,19 +1,18 @@
-package role
+package repository
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/achwanyusuf/carrent-accountsvc/src/model"
-	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
 	"github.com/gin-gonic/gin"
-	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-func (r *RoleDep) insertPSQL(ctx *gin.Context, data *psqlmodel.Role) error {
+func (r *Repository[E, PE, S, P, LP]) insertPSQL(ctx *gin.Context, data PE) error {
 	tx, err := r.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorTransaction, err, "error begin transaction")
@@ -33,28 +32,28 @@ func (r *RoleDep) insertPSQL(ctx *gin.Context, data *psqlmodel.Role) error {
 	return nil
 }
 
-func (r *RoleDep) getSingleByParamPSQL(ctx *gin.Context, param *model.GetRoleByParam) (psqlmodel.Role, error) {
-	var res psqlmodel.Role
+func (r *Repository[E, PE, S, P, LP]) getSingleByParamPSQL(ctx context.Context, param P) (E, error) {
+	var res E
 	qr := param.GetQuery()
-	account, err := psqlmodel.Roles(qr...).One(ctx, r.DB)
+	row, err := r.Schema.Query(qr...).One(ctx, r.DB)
 	if err == sql.ErrNoRows {
-		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get accounts")
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get data")
 	}
 
 	if err != nil {
-		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get accounts")
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get data")
 	}
 
-	return *account, nil
+	return *row, nil
 }
 
-func (r *RoleDep) updatePSQL(ctx *gin.Context, account *psqlmodel.Role) error {
+func (r *Repository[E, PE, S, P, LP]) updatePSQL(ctx *gin.Context, v PE) error {
 	tx, err := r.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorTransaction, err, "error begin transaction")
 	}
 
-	_, err = account.Update(ctx, tx, boil.Infer())
+	_, err = v.Update(ctx, tx, boil.Infer())
 	if err != nil {
 		if errRollback := tx.Rollback(); errRollback != nil {
 			r.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
@@ -68,13 +67,13 @@ func (r *RoleDep) updatePSQL(ctx *gin.Context, account *psqlmodel.Role) error {
 	return nil
 }
 
-func (r *RoleDep) deletePSQL(ctx *gin.Context, account *psqlmodel.Role, id int64, isHardDelete bool) error {
+func (r *Repository[E, PE, S, P, LP]) deletePSQL(ctx *gin.Context, v PE, id int64, isHardDelete bool) error {
 	tx, err := r.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorTransaction, err, "error begin transaction")
 	}
 
-	_, err = account.Delete(ctx, tx, isHardDelete)
+	_, err = v.Delete(ctx, tx, isHardDelete)
 	if err != nil {
 		if errRollback := tx.Rollback(); errRollback != nil {
 			r.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
@@ -83,8 +82,8 @@ func (r *RoleDep) deletePSQL(ctx *gin.Context, account *psqlmodel.Role, id int64
 	}
 
 	if !isHardDelete {
-		account.DeletedBy = null.NewInt(int(id), true)
-		_, err = account.Update(ctx, tx, boil.Infer())
+		r.Schema.SetDeletedBy(v, int(id))
+		_, err = v.Update(ctx, tx, boil.Infer())
 		if err != nil {
 			if errRollback := tx.Rollback(); errRollback != nil {
 				r.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
@@ -99,38 +98,29 @@ func (r *RoleDep) deletePSQL(ctx *gin.Context, account *psqlmodel.Role, id int64
 	return nil
 }
 
-func (r *RoleDep) getByParamPSQL(ctx *gin.Context, param *model.GetRolesByParam) (psqlmodel.RoleSlice, model.Pagination, error) {
+func (r *Repository[E, PE, S, P, LP]) getByParamPSQL(ctx context.Context, param LP) (S, model.Pagination, error) {
 	var totalPages int64 = 1
-	if param.Limit == 0 {
-		param.Limit = int64(r.Conf.DefaultPageLimit)
-	}
-
-	if param.Page == 0 {
-		param.Page = 1
-	}
+	limit, page, orderBy := param.Paging(int64(r.Conf.DefaultPageLimit))
 
 	qr := param.GetQuery()
-	count, err := psqlmodel.Roles(qr...).Count(ctx, r.DB)
+	count, err := r.Schema.Query(qr...).Count(ctx, r.DB)
 	if err != nil {
-		return psqlmodel.RoleSlice{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error count data")
-	}
-	qr = append(qr, qm.Offset(int((param.Page-1)*param.Limit)))
-	qr = append(qr, qm.Limit(int(param.Limit)))
-	accounts, err := psqlmodel.Roles(qr...).All(ctx, r.DB)
-	if err == sql.ErrNoRows {
-		return accounts, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get accounts")
+		return S{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error count data")
 	}
+	qr = append(qr, qm.Offset(int((page-1)*limit)))
+	qr = append(qr, qm.Limit(int(limit)))
+	rows, err := r.Schema.Query(qr...).All(ctx, r.DB)
 	if err != nil {
-		return accounts, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get accounts")
+		return rows, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get data")
 	}
 	if count > 0 {
-		totalPages = (count / param.Limit) + 1
+		totalPages = (count + limit - 1) / limit
 	}
-	return accounts, model.Pagination{
-		CurrentPage:     param.Page,
-		CurrentElements: int64(len(accounts)),
+	return rows, model.Pagination{
+		CurrentPage:     page,
+		CurrentElements: int64(len(rows)),
 		TotalElements:   count,
 		TotalPages:      totalPages,
-		SortBy:          param.OrderBy.String,
+		SortBy:          orderBy,
 	}, nil
 }
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/cache"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+// getRedis reads the entries under keys into vs and returns how long the
+// first one has left to live. The local cache is read first, entries read
+// from redis are cached locally with the tags returned once unmarshalled.
+func (r *Repository[E, PE, S, P, LP]) getRedis(ctx context.Context, keys []string, tags func() []string, vs ...interface{}) (time.Duration, error) {
+	if ok, err := r.getLocal(keys, vs...); ok || err != nil {
+		return 0, err
+	}
+
+	pipe := r.Redis.Pipeline()
+	gets := make([]*goredislib.StringCmd, 0, len(keys))
+	for _, key := range keys {
+		gets = append(gets, pipe.Get(ctx, key))
+	}
+	ttl := pipe.PTTL(ctx, keys[0])
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, get := range gets {
+		if err = json.Unmarshal([]byte(get.Val()), vs[i]); err != nil {
+			return 0, err
+		}
+	}
+	if r.Local != nil {
+		for i, key := range keys {
+			r.Local.Set(key, gets[i].Val(), ttl.Val(), tags()...)
+		}
+	}
+	return ttl.Val(), nil
+}
+
+// getLocal reads the entries under keys into vs if all of them are cached
+// locally.
+func (r *Repository[E, PE, S, P, LP]) getLocal(keys []string, vs ...interface{}) (bool, error) {
+	if r.Local == nil {
+		return false, nil
+	}
+
+	data := make([]string, 0, len(keys))
+	for _, key := range keys {
+		d, ok := r.Local.Get(key)
+		if !ok {
+			return false, nil
+		}
+		data = append(data, d)
+	}
+	for i, d := range data {
+		if err := json.Unmarshal([]byte(d), vs[i]); err != nil {
+			return false, err
+		}
+	}
+	r.Loader.Metrics.LocalHits.Add(1)
+	return true, nil
+}
+
+func (r *Repository[E, PE, S, P, LP]) setRedis(ctx context.Context, key string, v interface{}, tags ...string) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	expTime := r.Conf.RedisExpirationTime
+	if r.Conf.RedisExpirationTime == 0 {
+		expTime = model.DefaultRedisExpiration
+	}
+	err = cache.Set(ctx, r.Redis, key, string(data), expTime, tags...)
+	if err != nil {
+		return err
+	}
+	r.Local.Set(key, string(data), expTime, tags...)
+	return nil
+}
+
+// tags tags a cached e with its id and the rows it depends on.
+func (r *Repository[E, PE, S, P, LP]) tags(e *E) []string {
+	tags := []string{fmt.Sprintf(r.Schema.Tag, r.Schema.ID(e))}
+	if r.Schema.DependsOn != nil {
+		tags = append(tags, r.Schema.DependsOn(e)...)
+	}
+	return tags
+}
+
+// listTags tags the cached lists of param with ListTag and the list tags
+// param asks for.
+func (r *Repository[E, PE, S, P, LP]) listTags(param LP) []string {
+	tags := []string{r.Schema.ListTag}
+	if t, ok := any(param).(ListTagger); ok {
+		tags = append(tags, t.ListTags()...)
+	}
+	return tags
+}
+
+// Evict evicts the cached entries of the rows ids and every cached list,
+// along with the cascaded lists after a hard delete. It runs after the
+// write already happened, so a failed eviction is logged and left to
+// cache.Invalidate to retry.
+func (r *Repository[E, PE, S, P, LP]) Evict(ctx context.Context, isHardDelete bool, ids ...int) {
+	tags := []string{r.Schema.ListTag}
+	for _, id := range ids {
+		tags = append(tags, fmt.Sprintf(r.Schema.Tag, id))
+	}
+	if isHardDelete {
+		tags = append(tags, r.Schema.Cascade...)
+	}
+	if err := cache.Invalidate(ctx, r.Redis, tags...); err != nil {
+		r.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error invalidate cache"))
+	}
+}
@@ -0,0 +1,237 @@
+// Package repository holds the psql repository with a redis read-through
+// cache that the cached domains are instantiated from.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/cache"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type Conf struct {
+	DefaultPageLimit    int           `mapstructure:"page_limit"`
+	RedisExpirationTime time.Duration `mapstructure:"expiration_time"`
+	Lock                bool          `mapstructure:"lock"`
+	LockTimeout         time.Duration `mapstructure:"lock_timeout"`
+	EarlyRefresh        bool          `mapstructure:"early_refresh"`
+	EarlyRefreshBeta    float64       `mapstructure:"early_refresh_beta"`
+	LoadTimeout         time.Duration `mapstructure:"load_timeout"`
+	// LocalCache caches the entries in process too, for at most
+	// LocalCacheTTL and LocalCacheSize entries.
+	LocalCache     bool          `mapstructure:"local_cache"`
+	LocalCacheSize int           `mapstructure:"local_cache_size"`
+	LocalCacheTTL  time.Duration `mapstructure:"local_cache_ttl"`
+}
+
+// Model is the pointer of a sqlboiler model E.
+type Model[E any] interface {
+	*E
+	Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error
+	Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error)
+	Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error)
+}
+
+// Query is a sqlboiler query of the model E with its slice S.
+type Query[E any, S ~[]*E] interface {
+	One(ctx context.Context, exec boil.ContextExecutor) (*E, error)
+	All(ctx context.Context, exec boil.ContextExecutor) (S, error)
+	Count(ctx context.Context, exec boil.ContextExecutor) (int64, error)
+}
+
+// Param looks up a single row.
+type Param interface {
+	GetQuery() []qm.QueryMod
+}
+
+// ListParam looks up a page of rows. Paging defaults the limit and page
+// of the param and returns them with its sort order.
+type ListParam interface {
+	Param
+	Paging(defaultLimit int64) (limit int64, page int64, orderBy string)
+}
+
+// ListTagger is a list param whose query reads other tables. Its cached
+// lists are tagged with the list tags it returns too, so writing those
+// tables evicts them.
+type ListTagger interface {
+	ListTags() []string
+}
+
+// Schema describes the table and cache entries of the model E.
+type Schema[E any, S ~[]*E] struct {
+	// Name names the cache in its metrics.
+	Name string
+	// Query starts a query of the table, like psqlmodel.Roles.
+	Query func(mods ...qm.QueryMod) Query[E, S]
+	// ID returns the id of e.
+	ID func(e *E) int
+	// SetDeletedBy records who soft deletes e.
+	SetDeletedBy func(e *E, id int)
+	// SingleKey, ListKey and ListPgKey format the cache keys of the
+	// marshalled params of GetSingleByParam and GetByParam.
+	SingleKey string
+	ListKey   string
+	ListPgKey string
+	// Tag formats the cache tag of an id, ListTag tags every cached list.
+	Tag     string
+	ListTag string
+	// DependsOn optionally returns the tags of the rows e refers to, so
+	// writing them evicts the cached e too.
+	DependsOn func(e *E) []string
+	// Cascade lists the tags evicted after a hard delete, the lists of
+	// the rows deleted along by foreign keys.
+	Cascade []string
+}
+
+// Repository reads the rows of E through a redis cache and evicts the
+// affected cache entries when it writes them. P and LP are the single and
+// list params, PE and S the pointer and slice of E.
+type Repository[E any, PE Model[E], S ~[]*E, P Param, LP ListParam] struct {
+	Log    logger.Logger
+	DB     *sql.DB
+	Redis  *goredislib.Client
+	Conf   Conf
+	Schema Schema[E, S]
+	Loader *cache.Loader
+	// Local is nil unless Conf.LocalCache is set.
+	Local *cache.Local
+}
+
+// page is a cached page of rows with its pagination.
+type page[S any] struct {
+	rows S
+	pg   model.Pagination
+}
+
+func New[E any, PE Model[E], S ~[]*E, P Param, LP ListParam](conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client, schema Schema[E, S]) *Repository[E, PE, S, P, LP] {
+	r := &Repository[E, PE, S, P, LP]{
+		Log:    *log,
+		DB:     db,
+		Redis:  rds,
+		Conf:   conf,
+		Schema: schema,
+		Loader: cache.NewLoader(schema.Name, cache.LoaderConf{
+			Lock:             conf.Lock,
+			LockTimeout:      conf.LockTimeout,
+			EarlyRefresh:     conf.EarlyRefresh,
+			EarlyRefreshBeta: conf.EarlyRefreshBeta,
+			LoadTimeout:      conf.LoadTimeout,
+		}, log, rds),
+	}
+	if conf.LocalCache {
+		r.Local = cache.NewLocal(conf.LocalCacheSize, conf.LocalCacheTTL)
+	}
+	return r
+}
+
+func (r *Repository[E, PE, S, P, LP]) Insert(ctx *gin.Context, data PE) error {
+	err := r.insertPSQL(ctx, data)
+	if err != nil {
+		return err
+	}
+	r.Evict(ctx, false, r.Schema.ID(data))
+	return nil
+}
+
+func (r *Repository[E, PE, S, P, LP]) GetSingleByParam(ctx *gin.Context, cacheControl string, param P) (E, error) {
+	var res E
+	str, err := json.Marshal(param)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal param")
+	}
+
+	key := fmt.Sprintf(r.Schema.SingleKey, str)
+	load := func(ctx context.Context) (E, error) {
+		res, err := r.getSingleByParamPSQL(ctx, param)
+		if err != nil {
+			return res, err
+		}
+		err = r.setRedis(ctx, key, &res, r.tags(&res)...)
+		if err != nil {
+			cache.Warn(ctx, r.Log, err, "error set redis")
+		}
+		return res, nil
+	}
+	if cacheControl == model.MustRevalidate {
+		return load(ctx)
+	}
+
+	get := func(ctx context.Context) (E, time.Duration, error) {
+		var res E
+		ttl, err := r.getRedis(ctx, []string{key}, func() []string { return r.tags(&res) }, &res)
+		return res, ttl, err
+	}
+	return cache.Fetch(ctx, r.Loader, key, get, load)
+}
+
+func (r *Repository[E, PE, S, P, LP]) Update(ctx *gin.Context, v PE) error {
+	err := r.updatePSQL(ctx, v)
+	if err != nil {
+		return err
+	}
+	r.Evict(ctx, false, r.Schema.ID(v))
+	return nil
+}
+
+func (r *Repository[E, PE, S, P, LP]) Delete(ctx *gin.Context, v PE, id int64, isHardDelete bool) error {
+	err := r.deletePSQL(ctx, v, id, isHardDelete)
+	if err != nil {
+		return err
+	}
+	r.Evict(ctx, isHardDelete, r.Schema.ID(v))
+	return nil
+}
+
+// GetByParam caches a page and its pagination under separate keys, both
+// are read back from psql when either is missing.
+func (r *Repository[E, PE, S, P, LP]) GetByParam(ctx *gin.Context, cacheControl string, param LP) (S, model.Pagination, error) {
+	str, err := json.Marshal(param)
+	if err != nil {
+		return S{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal param")
+	}
+
+	key := fmt.Sprintf(r.Schema.ListKey, str)
+	keyPg := fmt.Sprintf(r.Schema.ListPgKey, str)
+	tags := r.listTags(param)
+	load := func(ctx context.Context) (page[S], error) {
+		res, pg, err := r.getByParamPSQL(ctx, param)
+		if err != nil {
+			return page[S]{res, pg}, err
+		}
+		err = r.setRedis(ctx, key, &res, tags...)
+		if err == nil {
+			err = r.setRedis(ctx, keyPg, &pg, tags...)
+		}
+		if err != nil {
+			cache.Warn(ctx, r.Log, err, "error set redis")
+		}
+		return page[S]{res, pg}, nil
+	}
+
+	var p page[S]
+	if cacheControl == model.MustRevalidate {
+		p, err = load(ctx)
+		return p.rows, p.pg, err
+	}
+
+	get := func(ctx context.Context) (page[S], time.Duration, error) {
+		var p page[S]
+		ttl, err := r.getRedis(ctx, []string{key, keyPg}, func() []string { return tags }, &p.rows, &p.pg)
+		return p, ttl, err
+	}
+	p, err = cache.Fetch(ctx, r.Loader, key, get, load)
+	return p.rows, p.pg, err
+}
@@ -0,0 +1,512 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/cache"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type roleRepository = Repository[psqlmodel.Role, *psqlmodel.Role, psqlmodel.RoleSlice, *model.GetRoleByParam, *model.GetRolesByParam]
+
+var roleColumns = []string{"id", "scope", "cid", "sec", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "redirect_uris", "mfa_required", "password_max_age_days", "public_client"}
+
+var testSchema = Schema[psqlmodel.Role, psqlmodel.RoleSlice]{
+	Name: "test",
+	Query: func(mods ...qm.QueryMod) Query[psqlmodel.Role, psqlmodel.RoleSlice] {
+		return psqlmodel.Roles(mods...)
+	},
+	ID: func(r *psqlmodel.Role) int {
+		return r.ID
+	},
+	SetDeletedBy: func(r *psqlmodel.Role, id int) {
+		r.DeletedBy = null.NewInt(id, true)
+	},
+	SingleKey: "gspTest:%s",
+	ListKey:   "gpTest:%s",
+	ListPgKey: "gppgTest:%s",
+	Tag:       "tagTest:%d",
+	ListTag:   "tagTest:list",
+	DependsOn: func(r *psqlmodel.Role) []string {
+		return []string{"tagTestScope:" + r.Scope}
+	},
+	Cascade: []string{"tagTestChild:list"},
+}
+
+func newTestRepository(t *testing.T) (*roleRepository, sqlmock.Sqlmock, *miniredis.Miniredis, *gin.Context) {
+	t.Helper()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	mr := miniredis.RunT(t)
+	rds := goredislib.NewClient(&goredislib.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rds.Close() })
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	repo := New[psqlmodel.Role, *psqlmodel.Role, psqlmodel.RoleSlice, *model.GetRoleByParam, *model.GetRolesByParam](Conf{
+		DefaultPageLimit:    10,
+		RedisExpirationTime: time.Minute,
+	}, &log, db, rds, testSchema)
+
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("GET", "/", nil)
+	return repo, mock, mr, ctx
+}
+
+func roleRows(ids ...int) *sqlmock.Rows {
+	rows := sqlmock.NewRows(roleColumns)
+	now := time.Now()
+	for _, id := range ids {
+		rows.AddRow(id, "admin", fmt.Sprintf("cid%d", id), "sec", 1, now, 1, now, nil, nil, "", false, 0, false)
+	}
+	return rows
+}
+
+func key(t *testing.T, format string, param interface{}) string {
+	t.Helper()
+	str, err := json.Marshal(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(format, str)
+}
+
+func TestGetSingleByParamCachesRow(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+	param := &model.GetRoleByParam{ID: null.NewInt64(1, true)}
+
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1))
+	res, err := repo.GetSingleByParam(ctx, "", param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ID != 1 {
+		t.Fatalf("got id %d, want 1", res.ID)
+	}
+
+	// served from redis, an unexpected query fails the mock
+	res, err = repo.GetSingleByParam(ctx, "", param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Cid != "cid1" {
+		t.Fatalf("got cid %q, want cid1", res.Cid)
+	}
+
+	k := key(t, testSchema.SingleKey, param)
+	for _, tag := range []string{"tagTest:1", "tagTestScope:admin"} {
+		if ok, _ := mr.SIsMember(tag, k); !ok {
+			t.Errorf("%s not tagged with %s", k, tag)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetSingleByParamMustRevalidate(t *testing.T) {
+	repo, mock, _, ctx := newTestRepository(t)
+	param := &model.GetRoleByParam{ID: null.NewInt64(1, true)}
+
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1))
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1))
+	for i := 0; i < 2; i++ {
+		if _, err := repo.GetSingleByParam(ctx, model.MustRevalidate, param); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetSingleByParamNotFound(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+	param := &model.GetRoleByParam{ID: null.NewInt64(1, true)}
+
+	for _, cacheControl := range []string{"", model.MustRevalidate} {
+		mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(sqlmock.NewRows(roleColumns))
+		if _, err := repo.GetSingleByParam(ctx, cacheControl, param); err == nil {
+			t.Fatalf("cache control %q: want error", cacheControl)
+		}
+	}
+	if mr.Exists(key(t, testSchema.SingleKey, param)) {
+		t.Fatal("missing row cached")
+	}
+}
+
+func TestGetByParamCachesPageAndPagination(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+	param := &model.GetRolesByParam{PageParam: model.PageParam{Limit: 2}}
+	// keys are made of the param as it is passed in, before defaulting
+	k := key(t, testSchema.ListKey, param)
+	kPg := key(t, testSchema.ListPgKey, param)
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM "roles"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles".* LIMIT 2`).WillReturnRows(roleRows(1, 2))
+	res, pg, err := repo.GetByParam(ctx, "", param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || pg.TotalElements != 3 || pg.CurrentPage != 1 || pg.CurrentElements != 2 {
+		t.Fatalf("got %d rows and %+v", len(res), pg)
+	}
+
+	var cachedPg model.Pagination
+	data, err := mr.Get(kPg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal([]byte(data), &cachedPg); err != nil {
+		t.Fatal(err)
+	}
+	if cachedPg != pg {
+		t.Fatalf("cached pagination %+v, want %+v", cachedPg, pg)
+	}
+	var cachedRes psqlmodel.RoleSlice
+	data, err = mr.Get(k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal([]byte(data), &cachedRes); err != nil || len(cachedRes) != 2 {
+		t.Fatalf("cached page %s: %v", data, err)
+	}
+	for _, ck := range []string{k, kPg} {
+		if ok, _ := mr.SIsMember(testSchema.ListTag, ck); !ok {
+			t.Errorf("%s not tagged with %s", ck, testSchema.ListTag)
+		}
+	}
+
+	// served from redis
+	res2, pg2, err := repo.GetByParam(ctx, "", &model.GetRolesByParam{PageParam: model.PageParam{Limit: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res2) != 2 || pg2 != pg {
+		t.Fatalf("got %d rows and %+v from cache", len(res2), pg2)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetByParamTotalPages(t *testing.T) {
+	tests := []struct {
+		count int
+		want  int64
+	}{
+		{count: 0, want: 1},
+		{count: 1, want: 1},
+		{count: 2, want: 1},
+		{count: 3, want: 2},
+		{count: 4, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.count), func(t *testing.T) {
+			repo, mock, _, ctx := newTestRepository(t)
+			mock.ExpectQuery(`SELECT COUNT\(\*\) FROM "roles"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tt.count))
+			mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles".* LIMIT 2`).WillReturnRows(roleRows())
+			_, pg, err := repo.GetByParam(ctx, "", &model.GetRolesByParam{PageParam: model.PageParam{Limit: 2}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pg.TotalPages != tt.want {
+				t.Fatalf("got %d pages, want %d", pg.TotalPages, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetByParamRefetchesMissingPagination(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+	param := &model.GetRolesByParam{}
+
+	for i := 0; i < 2; i++ {
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM "roles"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles".* LIMIT 10`).WillReturnRows(roleRows(1))
+	}
+	if _, _, err := repo.GetByParam(ctx, "", &model.GetRolesByParam{}); err != nil {
+		t.Fatal(err)
+	}
+	mr.Del(key(t, testSchema.ListPgKey, param))
+	if _, _, err := repo.GetByParam(ctx, "", &model.GetRolesByParam{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateEvictsRowAndLists(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+	single := &model.GetRoleByParam{ID: null.NewInt64(1, true)}
+	other := &model.GetRoleByParam{ID: null.NewInt64(2, true)}
+
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1))
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(2))
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM "roles"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1, 2))
+	role, err := repo.GetSingleByParam(ctx, "", single)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = repo.GetSingleByParam(ctx, "", other); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = repo.GetByParam(ctx, "", &model.GetRolesByParam{}); err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "roles"`)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	role.Scope = "staff"
+	if err = repo.Update(ctx, &role); err != nil {
+		t.Fatal(err)
+	}
+
+	if mr.Exists(key(t, testSchema.SingleKey, single)) {
+		t.Error("updated row still cached")
+	}
+	if !mr.Exists(key(t, testSchema.SingleKey, other)) {
+		t.Error("other row evicted")
+	}
+	listParam := &model.GetRolesByParam{}
+	if mr.Exists(key(t, testSchema.ListKey, listParam)) || mr.Exists(key(t, testSchema.ListPgKey, listParam)) {
+		t.Error("list still cached")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateFailureKeepsCache(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+	single := &model.GetRoleByParam{ID: null.NewInt64(1, true)}
+
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1))
+	role, err := repo.GetSingleByParam(ctx, "", single)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "roles"`)).WillReturnError(fmt.Errorf("update failed"))
+	mock.ExpectRollback()
+	if err = repo.Update(ctx, &role); err == nil {
+		t.Fatal("want error")
+	}
+	if !mr.Exists(key(t, testSchema.SingleKey, single)) {
+		t.Error("row evicted after failed write")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDependencyEvictsRow(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+	single := &model.GetRoleByParam{ID: null.NewInt64(1, true)}
+
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1))
+	if _, err := repo.GetSingleByParam(ctx, "", single); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Invalidate(ctx, repo.Redis, "tagTestScope:admin"); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(key(t, testSchema.SingleKey, single)) {
+		t.Error("row still cached after its dependency was evicted")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	for _, isHardDelete := range []bool{false, true} {
+		t.Run(fmt.Sprintf("hard=%v", isHardDelete), func(t *testing.T) {
+			repo, mock, mr, ctx := newTestRepository(t)
+			single := &model.GetRoleByParam{ID: null.NewInt64(1, true)}
+			mr.SAdd("tagTestChild:list", "gpTestChild:{}")
+			mr.Set("gpTestChild:{}", "[]")
+
+			mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1))
+			role, err := repo.GetSingleByParam(ctx, "", single)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mock.ExpectBegin()
+			if isHardDelete {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "roles"`)).WillReturnResult(sqlmock.NewResult(0, 1))
+			} else {
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "roles" SET "deleted_at"`)).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "roles"`)).WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			mock.ExpectCommit()
+			if err = repo.Delete(ctx, &role, 7, isHardDelete); err != nil {
+				t.Fatal(err)
+			}
+
+			if !isHardDelete && role.DeletedBy.Int != 7 {
+				t.Errorf("deleted by %v, want 7", role.DeletedBy)
+			}
+			if mr.Exists(key(t, testSchema.SingleKey, single)) {
+				t.Error("deleted row still cached")
+			}
+			if mr.Exists("gpTestChild:{}") != !isHardDelete {
+				t.Errorf("cascaded list cached %v after hard delete %v", mr.Exists("gpTestChild:{}"), isHardDelete)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestInsertEvictsLists(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM "roles"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(sqlmock.NewRows(roleColumns))
+	if _, _, err := repo.GetByParam(ctx, "", &model.GetRolesByParam{}); err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "roles"`)).WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_by", "deleted_at"}).AddRow(3, nil, nil))
+	mock.ExpectCommit()
+	// zero columns with a default are returned by the insert, only id and
+	// the deletion are left zero here
+	role := &psqlmodel.Role{Scope: "admin", Cid: "cid3", Sec: "sec", CreatedBy: 1, UpdatedBy: 1, RedirectUris: "-", MfaRequired: true, PasswordMaxAgeDays: 90, PublicClient: true}
+	if err := repo.Insert(ctx, role); err != nil {
+		t.Fatal(err)
+	}
+	if role.ID != 3 {
+		t.Fatalf("got id %d, want 3", role.ID)
+	}
+	if mr.Exists(key(t, testSchema.ListKey, &model.GetRolesByParam{})) {
+		t.Error("list still cached after insert")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// taggedRolesParam is a role list param whose query reads another table.
+type taggedRolesParam struct {
+	model.GetRolesByParam
+}
+
+func (p *taggedRolesParam) ListTags() []string {
+	return []string{"tagTestOther:list"}
+}
+
+func TestListTaggerEvictsLists(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+	tagged := New[psqlmodel.Role, *psqlmodel.Role, psqlmodel.RoleSlice, *model.GetRoleByParam, *taggedRolesParam](repo.Conf, &repo.Log, repo.DB, repo.Redis, testSchema)
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM "roles"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1))
+	param := &taggedRolesParam{}
+	k := key(t, testSchema.ListKey, param)
+	kPg := key(t, testSchema.ListPgKey, param)
+	if _, _, err := tagged.GetByParam(ctx, "", param); err != nil {
+		t.Fatal(err)
+	}
+	if !mr.Exists(k) || !mr.Exists(kPg) {
+		t.Fatal("list not cached")
+	}
+
+	if err := cache.Invalidate(ctx, repo.Redis, "tagTestOther:list"); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(k) || mr.Exists(kPg) {
+		t.Error("list still cached after its other table changed")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetSingleByParamLocalCache(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+	repo.Local = cache.NewLocal(0, time.Minute)
+	repo.Loader.Metrics = &cache.Metrics{}
+	param := &model.GetRoleByParam{ID: null.NewInt64(1, true)}
+
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1))
+	if _, err := repo.GetSingleByParam(ctx, "", param); err != nil {
+		t.Fatal(err)
+	}
+
+	// served in process even once redis lost it
+	mr.FlushAll()
+	res, err := repo.GetSingleByParam(ctx, "", param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Cid != "cid1" || repo.Loader.Metrics.LocalHits.Load() != 1 {
+		t.Fatalf("got cid %q, metrics %s", res.Cid, repo.Loader.Metrics)
+	}
+
+	// writing the row evicts it locally too
+	repo.Evict(ctx, false, 1)
+	if repo.Local.Len() != 0 {
+		t.Fatalf("%d local entries left", repo.Local.Len())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadsThroughWhileRedisDown(t *testing.T) {
+	repo, mock, mr, ctx := newTestRepository(t)
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	breaker := cache.NewBreaker(cache.BreakerConf{Threshold: 1, Cooldown: time.Minute}, &log)
+	repo.Redis.AddHook(breaker)
+	mr.Close()
+
+	// the first read opens the breaker, the next ones skip redis
+	for i := 0; i < 2; i++ {
+		mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1))
+		res, err := repo.GetSingleByParam(ctx, "", &model.GetRoleByParam{ID: null.NewInt64(1, true)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.ID != 1 {
+			t.Fatalf("got id %d, want 1", res.ID)
+		}
+	}
+	if breaker.State() != model.CacheBreakerOpen {
+		t.Fatalf("breaker %s, want open", breaker.State())
+	}
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM "roles"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(`SELECT "roles"\.\* FROM "roles"`).WillReturnRows(roleRows(1, 2))
+	res, pg, err := repo.GetByParam(ctx, "", &model.GetRolesByParam{PageParam: model.PageParam{Limit: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || pg.TotalElements != 2 {
+		t.Fatalf("got %d rows, pagination %+v", len(res), pg)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
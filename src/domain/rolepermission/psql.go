@@ -0,0 +1,28 @@
+package rolepermission
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (r *RolePermissionDep) getPermissionNamesPSQL(ctx context.Context, roleID int64) ([]string, error) {
+	permissions, err := psqlmodel.Permissions(
+		qm.InnerJoin("role_permissions rp on rp.permission_id = permissions.id"),
+		qm.Where("rp.role_id=?", roleID),
+		qm.Where("rp.deleted_at is null"),
+		qm.OrderBy("permissions.name"),
+	).All(ctx, r.DB)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get permission names")
+	}
+
+	res := make([]string, 0, len(permissions))
+	for _, v := range permissions {
+		res = append(res, v.Name)
+	}
+	return res, nil
+}
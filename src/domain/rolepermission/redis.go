@@ -0,0 +1,39 @@
+package rolepermission
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/cache"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+)
+
+func (r *RolePermissionDep) getPermissionNamesRedis(ctx context.Context, key string) ([]string, time.Duration, error) {
+	var res []string
+	pipe := r.Redis.Pipeline()
+	get := pipe.Get(ctx, key)
+	ttl := pipe.PTTL(ctx, key)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return res, 0, err
+	}
+	err = json.Unmarshal([]byte(get.Val()), &res)
+	if err != nil {
+		return res, 0, err
+	}
+	return res, ttl.Val(), nil
+}
+
+func (r *RolePermissionDep) setPermissionNamesRedis(ctx context.Context, key string, names []string, tags ...string) error {
+	data, err := json.Marshal(&names)
+	if err != nil {
+		return err
+	}
+
+	expTime := r.Conf.RedisExpirationTime
+	if r.Conf.RedisExpirationTime == 0 {
+		expTime = model.DefaultRedisExpiration
+	}
+	return cache.Set(ctx, r.Redis, key, string(data), expTime, tags...)
+}
@@ -0,0 +1,96 @@
+package rolepermission
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/cache"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/repository"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	goredislib "github.com/redis/go-redis/v9"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type RolePermissionDep struct {
+	*repository.Repository[psqlmodel.RolePermission, *psqlmodel.RolePermission, psqlmodel.RolePermissionSlice, *model.GetRolePermissionByParam, *model.GetRolePermissionsByParam]
+}
+
+type Conf = repository.Conf
+
+type RolePermissionInterface interface {
+	Insert(ctx *gin.Context, data *psqlmodel.RolePermission) error
+	GetSingleByParam(ctx *gin.Context, cacheControl string, param *model.GetRolePermissionByParam) (psqlmodel.RolePermission, error)
+	Delete(ctx *gin.Context, v *psqlmodel.RolePermission, id int64, isHardDelete bool) error
+	GetByParam(ctx *gin.Context, cacheControl string, param *model.GetRolePermissionsByParam) (psqlmodel.RolePermissionSlice, model.Pagination, error)
+	GetPermissionNames(ctx *gin.Context, cacheControl string, roleID int64) ([]string, error)
+}
+
+// schema caches a role permission under its own id and the ids of its role
+// and permission, so writing either evicts it.
+var schema = repository.Schema[psqlmodel.RolePermission, psqlmodel.RolePermissionSlice]{
+	Name: "role_permission",
+	Query: func(mods ...qm.QueryMod) repository.Query[psqlmodel.RolePermission, psqlmodel.RolePermissionSlice] {
+		return psqlmodel.RolePermissions(mods...)
+	},
+	ID: func(r *psqlmodel.RolePermission) int {
+		return r.ID
+	},
+	SetDeletedBy: func(r *psqlmodel.RolePermission, id int) {
+		r.DeletedBy = null.NewInt(id, true)
+	},
+	SingleKey: model.GetSingleByParamRolePermissionKey,
+	ListKey:   model.GetByParamRolePermissionKey,
+	ListPgKey: model.GetByParamRolePermissionPgKey,
+	Tag:       model.RolePermissionCacheTag,
+	ListTag:   model.RolePermissionListCacheTag,
+	DependsOn: func(r *psqlmodel.RolePermission) []string {
+		return []string{
+			fmt.Sprintf(model.RoleCacheTag, r.RoleID),
+			fmt.Sprintf(model.PermissionCacheTag, r.PermissionID),
+		}
+	},
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB, rds *goredislib.Client) RolePermissionInterface {
+	return &RolePermissionDep{
+		Repository: repository.New[psqlmodel.RolePermission, *psqlmodel.RolePermission, psqlmodel.RolePermissionSlice, *model.GetRolePermissionByParam, *model.GetRolePermissionsByParam](conf, log, db, rds, schema),
+	}
+}
+
+// GetPermissionNames returns the names of the permissions granted to a
+// role, sorted. This is what goes into access tokens, so it is cached per
+// role. The names carry the role permission and permission list tags, so
+// any grant or permission written evicts them, as does the role.
+func (r *RolePermissionDep) GetPermissionNames(ctx *gin.Context, cacheControl string, roleID int64) ([]string, error) {
+	key := fmt.Sprintf(model.PermissionNamesByRoleKey, roleID)
+	load := func(ctx context.Context) ([]string, error) {
+		res, err := r.getPermissionNamesPSQL(ctx, roleID)
+		if err != nil {
+			return res, err
+		}
+		err = r.setPermissionNamesRedis(ctx, key, res,
+			fmt.Sprintf(model.RoleCacheTag, roleID),
+			model.RolePermissionListCacheTag,
+			model.PermissionListCacheTag,
+		)
+		if err != nil {
+			cache.Warn(ctx, r.Log, err, "error set redis")
+		}
+		return res, nil
+	}
+	if cacheControl == model.MustRevalidate {
+		return load(ctx)
+	}
+
+	get := func(ctx context.Context) ([]string, time.Duration, error) {
+		return r.getPermissionNamesRedis(ctx, key)
+	}
+	return cache.Fetch(ctx, r.Loader, key, get, load)
+}
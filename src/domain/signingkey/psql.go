@@ -0,0 +1,40 @@
+package signingkey
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (s *SigningKeyDep) insertPSQL(ctx context.Context, data *psqlmodel.SigningKey) error {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorTransaction, err, "error begin transaction")
+	}
+
+	err = data.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			s.Log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorRollback, err, "error rollback"))
+		}
+		return errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, err, "error insert")
+	}
+	err = tx.Commit()
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error commit")
+	}
+	return nil
+}
+
+func (s *SigningKeyDep) getByParamPSQL(ctx context.Context, param *model.GetSigningKeysByParam) (psqlmodel.SigningKeySlice, error) {
+	qr := param.GetQuery()
+	keys, err := psqlmodel.SigningKeys(qr...).All(ctx, s.DB)
+	if err != nil {
+		return keys, errormsg.WrapErr(svcerr.AccountSVCPSQLErrorGet, err, "error get signing keys")
+	}
+	return keys, nil
+}
@@ -0,0 +1,41 @@
+package signingkey
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+)
+
+type SigningKeyDep struct {
+	Log  logger.Logger
+	DB   *sql.DB
+	Conf Conf
+}
+
+type Conf struct{}
+
+// SigningKeyInterface takes a plain context because keys are loaded and
+// rotated by a background job outside of any request.
+type SigningKeyInterface interface {
+	Insert(ctx context.Context, data *psqlmodel.SigningKey) error
+	GetByParam(ctx context.Context, param *model.GetSigningKeysByParam) (psqlmodel.SigningKeySlice, error)
+}
+
+func New(conf Conf, log *logger.Logger, db *sql.DB) SigningKeyInterface {
+	return &SigningKeyDep{
+		Log:  *log,
+		DB:   db,
+		Conf: conf,
+	}
+}
+
+func (s *SigningKeyDep) Insert(ctx context.Context, data *psqlmodel.SigningKey) error {
+	return s.insertPSQL(ctx, data)
+}
+
+func (s *SigningKeyDep) GetByParam(ctx context.Context, param *model.GetSigningKeysByParam) (psqlmodel.SigningKeySlice, error) {
+	return s.getByParamPSQL(ctx, param)
+}
@@ -0,0 +1,101 @@
+package account
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	mock_account "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/account"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestAccountByIDAuthorization(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		permissions []string
+		orgID       int64
+		orgRole     string
+		// members is the number of accounts of the organisation matching
+		// the target, nil when the lookup is not expected
+		members *int64
+		allowed bool
+	}{
+		{name: "read permission", method: http.MethodGet, permissions: []string{model.PermissionAccountRead}, allowed: true},
+		{name: "write permission does not read", method: http.MethodGet, permissions: []string{model.PermissionAccountWrite}},
+		{name: "write permission", method: http.MethodPut, permissions: []string{model.PermissionAccountWrite}, allowed: true},
+		{name: "no permission", method: http.MethodGet},
+		{name: "no permission update", method: http.MethodPut},
+		{name: "organisation admin of a member", method: http.MethodGet, orgID: 3, orgRole: model.OrganisationRoleAdmin, members: count(1), allowed: true},
+		{name: "organisation admin of a stranger", method: http.MethodPut, orgID: 3, orgRole: model.OrganisationRoleAdmin, members: count(0)},
+		{name: "organisation member", method: http.MethodGet, orgID: 3, orgRole: "member"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			uc := mock_account.NewMockAccountInterface(ctrl)
+			if tt.members != nil {
+				uc.EXPECT().GetByParam(gomock.Any(), model.MustRevalidate, gomock.Any()).DoAndReturn(func(_ *gin.Context, _ string, v model.GetAccountsByParam) ([]model.Account, model.Pagination, error) {
+					if v.ID.Int64 != 5 || v.OrganisationID.Int64 != tt.orgID {
+						t.Errorf("looked up %+v", v)
+					}
+					return nil, model.Pagination{TotalElements: *tt.members}, nil
+				})
+			}
+			if tt.allowed {
+				uc.EXPECT().GetByID(gomock.Any(), gomock.Any(), int64(5)).Return(model.Account{ID: 5}, nil).AnyTimes()
+				uc.EXPECT().UpdateByID(gomock.Any(), int64(5), gomock.Any()).Return(model.Account{ID: 5}, nil).AnyTimes()
+			}
+
+			log := logger.New(&logger.Config{Level: logger.LevelError})
+			a := New(Conf{}, &log, uc)
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.Use(func(ctx *gin.Context) {
+				ctx.Set("id", int64(1))
+				ctx.Set("permissions", tt.permissions)
+				if tt.orgID != 0 {
+					ctx.Set("org_id", tt.orgID)
+					ctx.Set("org_role", tt.orgRole)
+				}
+			})
+			r.GET("/account/:id", a.GetByID)
+			r.PUT("/account/:id", a.UpdateByID)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(tt.method, "/account/5", strings.NewReader(`{"name":"name"}`)))
+			if allowed := w.Code == http.StatusOK; allowed != tt.allowed {
+				t.Fatalf("got status %d, allowed %v", w.Code, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestAccountByIDOwnAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	uc := mock_account.NewMockAccountInterface(ctrl)
+	uc.EXPECT().GetByID(gomock.Any(), gomock.Any(), int64(1)).Return(model.Account{ID: 1}, nil)
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	a := New(Conf{}, &log, uc)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(ctx *gin.Context) {
+		ctx.Set("id", int64(1))
+	})
+	r.GET("/account/:id", a.GetByID)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/account/1", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d", w.Code)
+	}
+}
+
+func count(n int64) *int64 {
+	return &n
+}
@@ -0,0 +1,155 @@
+package account
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+)
+
+// Enroll MFA godoc
+// @Summary Start MFA enrolment
+// @Description Generate a TOTP secret for the current account. The otpauth uri is the QR code payload for authenticator apps. MFA is enabled once confirmed
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Success 200 {object} model.MFAEnrollmentResponse
+// @Success 400 {object} model.MFAEnrollmentResponse
+// @Success 401 {object} model.MFAEnrollmentResponse
+// @Success 500 {object} model.MFAEnrollmentResponse
+// @Router /me/mfa [post]
+func (a *AccountDep) EnrollMFA(ctx *gin.Context) {
+	var response model.MFAEnrollmentResponse
+	result, err := a.account.EnrollMFA(ctx, ctx.GetInt64("id"))
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, a.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Confirm MFA godoc
+// @Summary Confirm MFA enrolment
+// @Description Enable MFA with a code from the authenticator app. Returns recovery codes, they are only shown once
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.MFACode true "TOTP Code"
+// @Success 200 {object} model.RecoveryCodesResponse
+// @Success 400 {object} model.RecoveryCodesResponse
+// @Success 401 {object} model.RecoveryCodesResponse
+// @Success 500 {object} model.RecoveryCodesResponse
+// @Router /me/mfa/confirm [post]
+func (a *AccountDep) ConfirmMFA(ctx *gin.Context) {
+	var response model.RecoveryCodesResponse
+	code, err := a.decodeMFACode(ctx)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	result, err := a.account.ConfirmMFA(ctx, ctx.GetInt64("id"), code)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, a.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Disable MFA godoc
+// @Summary Disable MFA
+// @Description Disable MFA with a TOTP or recovery code
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.MFACode true "TOTP or Recovery Code"
+// @Success 200 {object} model.EmptyResponse
+// @Success 400 {object} model.EmptyResponse
+// @Success 401 {object} model.EmptyResponse
+// @Success 500 {object} model.EmptyResponse
+// @Router /me/mfa [delete]
+func (a *AccountDep) DisableMFA(ctx *gin.Context) {
+	var response model.EmptyResponse
+	code, err := a.decodeMFACode(ctx)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	err = a.account.DisableMFA(ctx, ctx.GetInt64("id"), code)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	statusCode := response.Transform(ctx, a.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Regenerate Recovery Codes godoc
+// @Summary Regenerate recovery codes
+// @Description Replace all recovery codes with a new set. Requires a TOTP code
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.MFACode true "TOTP Code"
+// @Success 200 {object} model.RecoveryCodesResponse
+// @Success 400 {object} model.RecoveryCodesResponse
+// @Success 401 {object} model.RecoveryCodesResponse
+// @Success 500 {object} model.RecoveryCodesResponse
+// @Router /me/mfa/recovery-codes [post]
+func (a *AccountDep) RegenerateRecoveryCodes(ctx *gin.Context) {
+	var response model.RecoveryCodesResponse
+	code, err := a.decodeMFACode(ctx)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	result, err := a.account.RegenerateRecoveryCodes(ctx, ctx.GetInt64("id"), code)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, a.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+func (a *AccountDep) decodeMFACode(ctx *gin.Context) (model.MFACode, error) {
+	var code model.MFACode
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		return code, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body")
+	}
+
+	if err = json.Unmarshal(body, &code); err != nil {
+		return code, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body")
+	}
+	return code, nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/middleware"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
 	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/accountrole"
@@ -44,7 +45,7 @@ func New(conf Conf, log *logger.Logger, accountrole accountrole.AccountRoleInter
 
 // Create AccountRole godoc
 // @Summary Create AccountRole
-// @Description Create account role data
+// @Description Create account role data. valid_from and valid_until bound the grant in time, it is permanent without them
 // @Tags account-role
 // @Accept json
 // @Produce json
@@ -52,6 +53,7 @@ func New(conf Conf, log *logger.Logger, accountrole accountrole.AccountRoleInter
 // @Param data body model.CreateAccountRole true "AccountRole Data"
 // @Success 200 {object} model.SingleAccountRoleResponse
 // @Success 400 {object} model.SingleAccountRoleResponse
+// @Success 403 {object} model.SingleAccountRoleResponse
 // @Success 500 {object} model.SingleAccountRoleResponse
 // @Router /account-role [post]
 func (a *AccountRoleDep) Create(ctx *gin.Context) {
@@ -74,7 +76,20 @@ func (a *AccountRoleDep) Create(ctx *gin.Context) {
 		return
 	}
 
-	roleData.CreatedBy = ctx.Value("id").(int64)
+	// admins of an organisation may grant roles within it, global grants
+	// take the global permission
+	if roleData.OrganisationID == 0 && !middleware.HasPermission(ctx, model.PermissionAccountRoleWrite) {
+		statusCode := response.Transform(ctx, a.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "missing permission "+model.PermissionAccountRoleWrite))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	if roleData.OrganisationID != 0 && !middleware.HasPermission(ctx, model.PermissionOrganisationWrite) && !middleware.OrganisationAdmin(ctx, roleData.OrganisationID) {
+		statusCode := response.Transform(ctx, a.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "not an admin of the organisation"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	roleData.CreatedBy = ctx.GetInt64("id")
 	result, err = a.accountrole.Create(ctx, roleData)
 	if err != nil {
 		statusCode := response.Transform(ctx, a.log, http.StatusCreated, err)
@@ -98,6 +113,7 @@ func (a *AccountRoleDep) Create(ctx *gin.Context) {
 // @Param id query string false "search by id"
 // @Param account_id query int false "search by account id"
 // @Param role_id query int false "search by role id"
+// @Param active_only query bool false "only grants whose validity window holds"
 // @Param sort_by query string false "sort result by attributes"
 // @Param page query int false " "
 // @Param limit query int false " "
@@ -190,11 +206,10 @@ func (a *AccountRoleDep) DeleteByID(ctx *gin.Context) {
 		ctx.JSON(statusCode, response)
 		return
 	}
-	scope := ctx.Value("scope").(string)
-	if scope != model.SuperAdminScope {
-		id = ctx.Value("id").(int64)
+	if !middleware.HasPermission(ctx, model.PermissionAccountRoleDelete) {
+		id = ctx.GetInt64("id")
 	}
-	err = a.accountrole.DeleteByID(ctx, ctx.Value("id").(int64), false, id)
+	err = a.accountrole.DeleteByID(ctx, ctx.GetInt64("id"), false, id)
 	if err != nil {
 		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
 		ctx.JSON(statusCode, response)
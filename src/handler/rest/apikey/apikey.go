@@ -0,0 +1,164 @@
+package apikey
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/apikey"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type APIKeyDep struct {
+	log    logger.Logger
+	apiKey apikey.APIKeyInterface
+	conf   Conf
+}
+
+type Conf struct{}
+
+// APIKeyInterface serves the API keys of the current account.
+type APIKeyInterface interface {
+	Create(ctx *gin.Context)
+	Read(ctx *gin.Context)
+	RevokeByID(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, apiKey apikey.APIKeyInterface) APIKeyInterface {
+	return &APIKeyDep{
+		conf:   conf,
+		log:    *log,
+		apiKey: apiKey,
+	}
+}
+
+// Create API Key godoc
+// @Summary Create API key
+// @Description Create a named API key of the current account. Scope narrows the key to some of the role scopes of the account, all of them when empty. The key is only shown in this response
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateAPIKey true "API Key Data"
+// @Success 200 {object} model.CreatedAPIKeyResponse
+// @Success 400 {object} model.CreatedAPIKeyResponse
+// @Success 401 {object} model.CreatedAPIKeyResponse
+// @Success 403 {object} model.CreatedAPIKeyResponse
+// @Success 500 {object} model.CreatedAPIKeyResponse
+// @Router /me/tokens [post]
+func (a *APIKeyDep) Create(ctx *gin.Context) {
+	var (
+		apiKeyData model.CreateAPIKey
+		response   model.CreatedAPIKeyResponse
+	)
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &apiKeyData); err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	apiKeyData.AccountID = ctx.GetInt64("id")
+	result, err := a.apiKey.Create(ctx, apiKeyData)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusCreated, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, a.log, http.StatusCreated, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get API Keys godoc
+// @Summary Get API keys
+// @Description Get the API keys of the current account, revoked keys are left out
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} model.APIKeysResponse
+// @Success 400 {object} model.APIKeysResponse
+// @Success 401 {object} model.APIKeysResponse
+// @Success 403 {object} model.APIKeysResponse
+// @Success 500 {object} model.APIKeysResponse
+// @Router /me/tokens [get]
+func (a *APIKeyDep) Read(ctx *gin.Context) {
+	var (
+		param    model.GetAPIKeysByParam
+		response model.APIKeysResponse
+	)
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	apiKeys, pagination, err := a.apiKey.GetByParam(ctx, ctx.GetInt64("id"), param)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = apiKeys
+	response.Pagination = pagination
+
+	statusCode := response.Transform(ctx, a.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Revoke API Key godoc
+// @Summary Revoke API key
+// @Description Revoke an API key of the current account, it stops working right away
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "revoke by id"
+// @Success 200 {object} model.EmptyResponse
+// @Success 400 {object} model.EmptyResponse
+// @Success 401 {object} model.EmptyResponse
+// @Success 403 {object} model.EmptyResponse
+// @Success 404 {object} model.EmptyResponse
+// @Success 500 {object} model.EmptyResponse
+// @Router /me/tokens/{id} [delete]
+func (a *APIKeyDep) RevokeByID(ctx *gin.Context) {
+	var response model.EmptyResponse
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	err = a.apiKey.RevokeByID(ctx, ctx.GetInt64("id"), id)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	statusCode := response.Transform(ctx, a.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
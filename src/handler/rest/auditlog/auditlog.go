@@ -0,0 +1,77 @@
+package auditlog
+
+import (
+	"net/http"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/auditlog"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type AuditLogDep struct {
+	log      logger.Logger
+	auditLog auditlog.AuditLogInterface
+	conf     Conf
+}
+
+type Conf struct{}
+
+type AuditLogInterface interface {
+	Read(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, auditLog auditlog.AuditLogInterface) AuditLogInterface {
+	return &AuditLogDep{
+		conf:     conf,
+		log:      *log,
+		auditLog: auditLog,
+	}
+}
+
+// Get Audit Logs Data godoc
+// @Summary Get audit logs data
+// @Description Get audit log entries, newest first unless sorted otherwise
+// @Tags audit-log
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param action query string false "search by action" Enums(impersonation.start, impersonation.stop)
+// @Param actor_id query int false "search by the account performing the action"
+// @Param subject_id query int false "search by the account the action was performed on"
+// @Param token_id query string false "search by the jti of the token involved"
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Success 200 {object} model.AuditLogsResponse
+// @Success 400 {object} model.AuditLogsResponse
+// @Success 403 {object} model.AuditLogsResponse
+// @Success 500 {object} model.AuditLogsResponse
+// @Router /audit-log [get]
+func (a *AuditLogDep) Read(ctx *gin.Context) {
+	var (
+		param    model.GetAuditLogsByParam
+		response model.AuditLogsResponse
+	)
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	auditLogs, pagination, err := a.auditLog.GetByParam(ctx, param)
+	if err != nil {
+		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = auditLogs
+	response.Pagination = pagination
+
+	statusCode := response.Transform(ctx, a.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
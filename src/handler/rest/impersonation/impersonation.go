@@ -0,0 +1,120 @@
+package impersonation
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/impersonation"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type ImpersonationDep struct {
+	log           logger.Logger
+	impersonation impersonation.ImpersonationInterface
+	conf          Conf
+}
+
+type Conf struct{}
+
+// ImpersonationInterface lets admins start and stop acting as another
+// account.
+type ImpersonationInterface interface {
+	Impersonate(ctx *gin.Context)
+	StopImpersonation(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, impersonation impersonation.ImpersonationInterface) ImpersonationInterface {
+	return &ImpersonationDep{
+		conf:          conf,
+		log:           *log,
+		impersonation: impersonation,
+	}
+}
+
+// Impersonate Account godoc
+// @Summary Impersonate account
+// @Description Issue a short-lived token of another account to reproduce its issues, requires the account:impersonate permission. The token names the admin in its act claim, cannot change the password or delete the account, and every start and stop is audited
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "impersonate by id"
+// @Param data body model.Impersonate true "Impersonation Data"
+// @Success 200 {object} model.LoginResponse
+// @Success 400 {object} model.LoginResponse
+// @Success 401 {object} model.LoginResponse
+// @Success 403 {object} model.LoginResponse
+// @Success 404 {object} model.LoginResponse
+// @Success 500 {object} model.LoginResponse
+// @Router /account/{id}/impersonate [post]
+func (i *ImpersonationDep) Impersonate(ctx *gin.Context) {
+	var (
+		impersonateData model.Impersonate
+		response        model.LoginResponse
+	)
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &impersonateData); err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	impersonateData.ActorID = ctx.GetInt64("id")
+	result, err := i.impersonation.Impersonate(ctx, id, impersonateData)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Auth = result
+
+	statusCode := response.Transform(ctx, i.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Stop Impersonation godoc
+// @Summary Stop impersonation
+// @Description Revoke the impersonation token of the request before it expires
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Success 200 {object} model.EmptyResponse
+// @Success 400 {object} model.EmptyResponse
+// @Success 401 {object} model.EmptyResponse
+// @Success 500 {object} model.EmptyResponse
+// @Router /me/impersonation [delete]
+func (i *ImpersonationDep) StopImpersonation(ctx *gin.Context) {
+	var response model.EmptyResponse
+	token := strings.TrimPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+	err := i.impersonation.StopImpersonation(ctx, token)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	statusCode := response.Transform(ctx, i.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
@@ -0,0 +1,296 @@
+package invitation
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/middleware"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/invitation"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+	"github.com/volatiletech/null/v8"
+)
+
+type InvitationDep struct {
+	log        logger.Logger
+	invitation invitation.InvitationInterface
+	conf       Conf
+}
+
+type Conf struct{}
+
+// InvitationInterface serves staff invitations. Besides callers holding the
+// invitation permissions, the admins of an organisation invite staff into
+// their organisation.
+type InvitationInterface interface {
+	Create(ctx *gin.Context)
+	Read(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	RevokeByID(ctx *gin.Context)
+	Accept(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, invitation invitation.InvitationInterface) InvitationInterface {
+	return &InvitationDep{
+		conf:       conf,
+		log:        *log,
+		invitation: invitation,
+	}
+}
+
+// Create Invitation godoc
+// @Summary Create Invitation
+// @Description Invite staff to a role, optionally within an organisation. The invitee gets a mail to set up their account
+// @Tags invitation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateInvitation true "Invitation Data"
+// @Success 200 {object} model.SingleInvitationResponse
+// @Success 400 {object} model.SingleInvitationResponse
+// @Success 403 {object} model.SingleInvitationResponse
+// @Success 500 {object} model.SingleInvitationResponse
+// @Router /invitation [post]
+func (i *InvitationDep) Create(ctx *gin.Context) {
+	var (
+		invitationData model.CreateInvitation
+		result         model.Invitation
+		response       model.SingleInvitationResponse
+	)
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &invitationData); err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if !canManage(ctx, model.PermissionInvitationWrite, invitationData.OrganisationID) {
+		statusCode := response.Transform(ctx, i.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "missing permission "+model.PermissionInvitationWrite))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	invitationData.CreatedBy = ctx.GetInt64("id")
+	result, err = i.invitation.Create(ctx, invitationData)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusCreated, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, i.log, http.StatusCreated, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get Invitations Data godoc
+// @Summary Get invitations data
+// @Description Get invitations data, admins of an organisation only see the invitations into it
+// @Tags invitation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id query string false "search by id"
+// @Param email query string false "search by email"
+// @Param role_id query int false "search by role id"
+// @Param organisation_id query int false "search by organisation id"
+// @Param status query string false "search by status" Enums(pending, accepted, revoked, expired)
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.InvitationsResponse
+// @Success 400 {object} model.InvitationsResponse
+// @Success 403 {object} model.InvitationsResponse
+// @Success 500 {object} model.InvitationsResponse
+// @Router /invitation [get]
+func (i *InvitationDep) Read(ctx *gin.Context) {
+	var (
+		param    model.GetInvitationsByParam
+		response model.InvitationsResponse
+	)
+	cacheControl := ctx.GetHeader("Cache-Control")
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if !middleware.HasPermission(ctx, model.PermissionInvitationRead) {
+		organisationID, _ := middleware.Organisation(ctx)
+		if !middleware.OrganisationAdmin(ctx, organisationID) {
+			statusCode := response.Transform(ctx, i.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "missing permission "+model.PermissionInvitationRead))
+			ctx.JSON(statusCode, response)
+			return
+		}
+		param.OrganisationID = null.NewInt64(organisationID, true)
+	}
+
+	invitations, pagination, err := i.invitation.GetByParam(ctx, cacheControl, param)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = invitations
+	response.Pagination = pagination
+
+	statusCode := response.Transform(ctx, i.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get Invitation Data godoc
+// @Summary Get invitation data
+// @Description Get invitation data
+// @Tags invitation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "get by id"
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.SingleInvitationResponse
+// @Success 400 {object} model.SingleInvitationResponse
+// @Success 403 {object} model.SingleInvitationResponse
+// @Success 500 {object} model.SingleInvitationResponse
+// @Router /invitation/{id} [get]
+func (i *InvitationDep) GetByID(ctx *gin.Context) {
+	var response model.SingleInvitationResponse
+	cacheControl := ctx.GetHeader("Cache-Control")
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	result, err := i.invitation.GetByID(ctx, cacheControl, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if !canManage(ctx, model.PermissionInvitationRead, result.OrganisationID) {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "missing permission "+model.PermissionInvitationRead))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, i.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Revoke Invitation godoc
+// @Summary Revoke invitation
+// @Description Revoke a pending invitation, its link stops working
+// @Tags invitation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "revoke by id"
+// @Success 200 {object} model.SingleInvitationResponse
+// @Success 400 {object} model.SingleInvitationResponse
+// @Success 403 {object} model.SingleInvitationResponse
+// @Success 500 {object} model.SingleInvitationResponse
+// @Router /invitation/{id} [delete]
+func (i *InvitationDep) RevokeByID(ctx *gin.Context) {
+	var response model.SingleInvitationResponse
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	invitation, err := i.invitation.GetByID(ctx, model.MustRevalidate, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if !canManage(ctx, model.PermissionInvitationWrite, invitation.OrganisationID) {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "missing permission "+model.PermissionInvitationWrite))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	result, err := i.invitation.RevokeByID(ctx, id, ctx.GetInt64("id"))
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, i.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// canManage reports whether the caller holds permission or is an admin of
+// the organisation.
+func canManage(ctx *gin.Context, permission string, organisationID int64) bool {
+	return middleware.HasPermission(ctx, permission) || middleware.OrganisationAdmin(ctx, organisationID)
+}
+
+// Accept Invitation godoc
+// @Summary Accept invitation
+// @Description Create the account of an invited staff member with the token from the invitation mail
+// @Tags account
+// @Accept json
+// @Produce json
+// @Param data body model.AcceptInvitation true "Accept Invitation Data"
+// @Success 200 {object} model.RegisterResponse
+// @Success 400 {object} model.RegisterResponse
+// @Success 500 {object} model.RegisterResponse
+// @Router /invitation/accept [post]
+func (i *InvitationDep) Accept(ctx *gin.Context) {
+	var (
+		acceptData model.AcceptInvitation
+		result     model.Account
+		response   model.RegisterResponse
+	)
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &acceptData); err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	result, err = i.invitation.Accept(ctx, acceptData)
+	if err != nil {
+		statusCode := response.Transform(ctx, i.log, http.StatusCreated, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, i.log, http.StatusCreated, nil)
+	ctx.JSON(statusCode, response)
+}
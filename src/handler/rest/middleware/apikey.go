@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// APIKey returns the id of the API key the request was made with, requests
+// made with a JWT have none.
+func APIKey(ctx *gin.Context) (int64, bool) {
+	id := ctx.GetInt64("api_key_id")
+	return id, id != 0
+}
+
+// NotAPIKey rejects requests made with an API key on routes that need the
+// account holder, such as managing the keys themselves, so a leaked key
+// cannot be used to mint more keys or take over the account.
+func NotAPIKey(log logger.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var response model.EmptyResponse
+		if _, ok := APIKey(ctx); ok {
+			statusCode := response.Transform(ctx, log, http.StatusForbidden, errormsg.WrapErr(svcerr.AccountSVCAPIKeyForbidden, nil, "route not allowed with an api key"))
+			ctx.AbortWithStatusJSON(statusCode, response)
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// Subject returns the subject of the token of the request, the account id
+// for tokens issued to an account and the client id otherwise.
+func Subject(ctx *gin.Context) string {
+	return ctx.GetString("sub")
+}
+
+// Actor returns the subject of the admin impersonating the subject of the
+// token, tokens that are not impersonating have none.
+func Actor(ctx *gin.Context) (string, bool) {
+	actor := ctx.GetString("act")
+	return actor, actor != ""
+}
+
+// NotImpersonating rejects impersonation tokens on routes an admin must not
+// use on behalf of the account, such as changing its password.
+func NotImpersonating(log logger.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var response model.EmptyResponse
+		if _, ok := Actor(ctx); ok {
+			statusCode := response.Transform(ctx, log, http.StatusForbidden, errormsg.WrapErr(svcerr.AccountSVCImpersonationForbidden, nil, "route not allowed while impersonating"))
+			ctx.AbortWithStatusJSON(statusCode, response)
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+)
+
+type TokenParser interface {
+	Parse(ctx context.Context, token string) (jwt.MapClaims, error)
+	ParseRestricted(ctx context.Context, token string) (jwt.MapClaims, error)
+}
+
+type APIKeyParser interface {
+	ParseAPIKey(ctx *gin.Context, key string) (jwt.MapClaims, error)
+}
+
+// JWT validates the bearer token and stores its claims in the gin context.
+// Tokens issued through the client_credentials grant carry no account, so
+// "id" and "username" are only set when the token belongs to an account.
+// Restricted tokens are only parsed on, and let through to, the routes
+// listed for their restriction in model.RestrictedRoutes. The permissions
+// of the token are stored for Permission, see permissionsFromClaims. Tokens
+// issued within an organisation also set "org_id" and "org_role", see
+// Organisation. The subject is stored as "sub" and, for impersonation
+// tokens, the admin acting as the subject as "act", see Actor. An API key
+// is accepted in place of the JWT, requests made with one also set
+// "api_key_id", see APIKey.
+func JWT(log logger.Logger, parser TokenParser, apiKeyParser APIKeyParser) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var response model.EmptyResponse
+		tokenStr := strings.Split(ctx.GetHeader("Authorization"), "Bearer ")
+		if len(tokenStr) < 2 {
+			statusCode := response.Transform(ctx, log, http.StatusUnauthorized, errormsg.WrapErr(errormsg.Error401, nil, "authorization header should be with prefix Bearer"))
+			ctx.AbortWithStatusJSON(statusCode, response)
+			return
+		}
+
+		var (
+			claims jwt.MapClaims
+			err    error
+		)
+		route := ctx.Request.Method + " " + ctx.FullPath()
+		if model.IsAPIKey(tokenStr[1]) {
+			claims, err = apiKeyParser.ParseAPIKey(ctx, tokenStr[1])
+		} else {
+			claims, err = parser.Parse(ctx, tokenStr[1])
+			if err != nil && model.AcceptsRestrictedToken(route) {
+				claims, err = parser.ParseRestricted(ctx, tokenStr[1])
+			}
+		}
+		if err != nil {
+			statusCode := response.Transform(ctx, log, http.StatusUnauthorized, errormsg.WrapErr(errormsg.Error401, err, "invalid token"))
+			ctx.AbortWithStatusJSON(statusCode, response)
+			return
+		}
+
+		if restriction, ok := claims["restriction"].(string); ok && !common.FindStrInSlice(route, model.RestrictedRoutes[restriction]) {
+			statusCode := response.Transform(ctx, log, http.StatusForbidden, errormsg.WrapErr(svcerr.AccountSVCRestrictedToken, nil, "restricted token"))
+			ctx.AbortWithStatusJSON(statusCode, response)
+			return
+		}
+
+		if id, ok := claims["id"].(float64); ok {
+			ctx.Set("id", int64(id))
+		}
+		if username, ok := claims["username"].(string); ok {
+			ctx.Set("username", username)
+		}
+		if clientID, ok := claims["client_id"].(string); ok {
+			ctx.Set("client_id", clientID)
+		}
+		if orgID, ok := claims["org_id"].(float64); ok {
+			ctx.Set("org_id", int64(orgID))
+		}
+		if orgRole, ok := claims["org_role"].(string); ok {
+			ctx.Set("org_role", orgRole)
+		}
+		ctx.Set("permissions", permissionsFromClaims(claims))
+
+		scope, _ := claims["scope"].(string)
+		sub, _ := claims["sub"].(string)
+		ctx.Set("scope", scope)
+		ctx.Set("sub", sub)
+		if act, ok := claims["act"].(map[string]interface{}); ok {
+			actor, _ := act["sub"].(string)
+			ctx.Set("act", actor)
+		}
+		if apiKeyID, ok := claims["api_key_id"].(float64); ok {
+			ctx.Set("api_key_id", int64(apiKeyID))
+		}
+
+		ctx.Next()
+	}
+}
+
+// AccountOnly rejects tokens that were not issued to an account, such as
+// tokens from the client_credentials grant.
+func AccountOnly(log logger.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var response model.EmptyResponse
+		if _, ok := ctx.Get("id"); !ok {
+			statusCode := response.Transform(ctx, log, http.StatusUnauthorized, errormsg.WrapErr(errormsg.Error401, nil, "token is not issued to an account"))
+			ctx.AbortWithStatusJSON(statusCode, response)
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock_apikey "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/apikey"
+	mock_token "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/token"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+)
+
+func TestAccountOnly(t *testing.T) {
+	tests := []struct {
+		name        string
+		claims      jwt.MapClaims
+		accountOnly bool
+		status      int
+	}{
+		{
+			name:        "account token",
+			claims:      jwt.MapClaims{"id": float64(7), "username": "user@example.com", "client_id": "cid"},
+			accountOnly: true,
+			status:      http.StatusOK,
+		},
+		{
+			// client_credentials tokens carry no id or username
+			name:        "client token",
+			claims:      jwt.MapClaims{"sub": "cid", "client_id": "cid"},
+			accountOnly: true,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			name:   "client token on a client route",
+			claims: jwt.MapClaims{"sub": "cid", "client_id": "cid"},
+			status: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			parser := mock_token.NewMockTokenInterface(ctrl)
+			parser.EXPECT().Parse(gomock.Any(), "access-token").Return(tt.claims, nil)
+
+			log := logger.New(&logger.Config{Level: logger.LevelError})
+			handlers := []gin.HandlerFunc{JWT(log, parser, mock_apikey.NewMockAPIKeyInterface(ctrl))}
+			if tt.accountOnly {
+				handlers = append(handlers, AccountOnly(log))
+			}
+			handlers = append(handlers, func(ctx *gin.Context) {
+				_, hasID := ctx.Get("id")
+				_, hasUsername := ctx.Get("username")
+				if _, isAccount := tt.claims["id"]; hasID != isAccount || hasUsername != isAccount {
+					t.Errorf("id set %t, username set %t", hasID, hasUsername)
+				}
+				if ctx.GetString("client_id") != "cid" {
+					t.Errorf("client_id %q", ctx.GetString("client_id"))
+				}
+				ctx.Status(http.StatusOK)
+			})
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.GET("/me", handlers...)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			req.Header.Set("Authorization", "Bearer access-token")
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/gin-gonic/gin"
+)
+
+// Organisation returns the organisation the token of the request was issued
+// for, tokens issued through a grant that is not scoped to an organisation
+// have none.
+func Organisation(ctx *gin.Context) (int64, bool) {
+	organisationID := ctx.GetInt64("org_id")
+	return organisationID, organisationID != 0
+}
+
+// OrganisationAdmin reports whether the token of the request was issued to
+// an admin of organisationID, who manages that organisation without holding
+// the organisation permissions.
+func OrganisationAdmin(ctx *gin.Context, organisationID int64) bool {
+	id, ok := Organisation(ctx)
+	return ok && id == organisationID && ctx.GetString("org_role") == model.OrganisationRoleAdmin
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+)
+
+// Permission only lets requests through when the token grants permission.
+// It must run after JWT, which puts the permissions in the gin context.
+func Permission(log logger.Logger, permission string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var response model.EmptyResponse
+		if !HasPermission(ctx, permission) {
+			statusCode := response.Transform(ctx, log, http.StatusForbidden, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "missing permission "+permission))
+			ctx.AbortWithStatusJSON(statusCode, response)
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// PermissionOrOrganisationAdmin lets requests through when the token grants
+// permission or was issued to an organisation admin, for routes whose
+// handler narrows what an admin may do to their organisation.
+func PermissionOrOrganisationAdmin(log logger.Logger, permission string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var response model.EmptyResponse
+		organisationID, _ := Organisation(ctx)
+		if !HasPermission(ctx, permission) && !OrganisationAdmin(ctx, organisationID) {
+			statusCode := response.Transform(ctx, log, http.StatusForbidden, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "missing permission "+permission))
+			ctx.AbortWithStatusJSON(statusCode, response)
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// HasPermission reports whether the token of the request grants permission,
+// for handlers that narrow what a caller without it may do.
+func HasPermission(ctx *gin.Context, permission string) bool {
+	return common.FindStrInSlice(permission, ctx.GetStringSlice("permissions"))
+}
+
+// permissionsFromClaims reads the permissions embedded in the token. A
+// token without them has none.
+func permissionsFromClaims(claims jwt.MapClaims) []string {
+	raw, _ := claims["permissions"].([]interface{})
+	permissions := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			permissions = append(permissions, s)
+		}
+	}
+	return permissions
+}
@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	mock_apikey "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/apikey"
+	mock_token "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/token"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+)
+
+func TestPermission(t *testing.T) {
+	const apiKey = "crk_key"
+	permission := model.PermissionAccountWrite
+	tests := []struct {
+		name          string
+		bearer        string
+		claims        jwt.MapClaims
+		orgAdminRoute bool
+		status        int
+	}{
+		{
+			name:   "granted",
+			claims: jwt.MapClaims{"client_id": "cid", "permissions": []interface{}{model.PermissionAccountRead, permission}},
+			status: http.StatusOK,
+		},
+		{
+			name:   "not granted",
+			claims: jwt.MapClaims{"client_id": "cid", "permissions": []interface{}{model.PermissionAccountRead}},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "no permissions",
+			claims: jwt.MapClaims{"client_id": "cid", "permissions": []interface{}{}},
+			status: http.StatusForbidden,
+		},
+		{
+			// the client's own permissions are not looked up
+			name:   "no permissions claim",
+			claims: jwt.MapClaims{"client_id": "cid"},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "no client",
+			claims: jwt.MapClaims{},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "api key granted",
+			bearer: apiKey,
+			claims: jwt.MapClaims{"client_id": "cid", "permissions": []interface{}{permission}, "api_key_id": float64(1)},
+			status: http.StatusOK,
+		},
+		{
+			name:   "api key not granted",
+			bearer: apiKey,
+			claims: jwt.MapClaims{"client_id": "cid", "permissions": []interface{}{}, "api_key_id": float64(1)},
+			status: http.StatusForbidden,
+		},
+		{
+			name:          "organisation admin",
+			claims:        jwt.MapClaims{"client_id": "cid", "permissions": []interface{}{}, "org_id": float64(3), "org_role": model.OrganisationRoleAdmin},
+			orgAdminRoute: true,
+			status:        http.StatusOK,
+		},
+		{
+			name:          "organisation staff",
+			claims:        jwt.MapClaims{"client_id": "cid", "permissions": []interface{}{}, "org_id": float64(3), "org_role": model.OrganisationRoleStaff},
+			orgAdminRoute: true,
+			status:        http.StatusForbidden,
+		},
+		{
+			name:   "organisation admin on a permission route",
+			claims: jwt.MapClaims{"client_id": "cid", "permissions": []interface{}{}, "org_id": float64(3), "org_role": model.OrganisationRoleAdmin},
+			status: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			parser := mock_token.NewMockTokenInterface(ctrl)
+			apiKeyParser := mock_apikey.NewMockAPIKeyInterface(ctrl)
+
+			bearer := tt.bearer
+			if bearer == apiKey {
+				apiKeyParser.EXPECT().ParseAPIKey(gomock.Any(), apiKey).Return(tt.claims, nil)
+			} else {
+				bearer = "access-token"
+				parser.EXPECT().Parse(gomock.Any(), bearer).Return(tt.claims, nil)
+			}
+
+			log := logger.New(&logger.Config{Level: logger.LevelError})
+			guard := Permission(log, permission)
+			if tt.orgAdminRoute {
+				guard = PermissionOrOrganisationAdmin(log, permission)
+			}
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.POST("/account", JWT(log, parser, apiKeyParser), guard, func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/account", nil)
+			req.Header.Set("Authorization", "Bearer "+bearer)
+			r.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// Scope only lets requests through when the token carries one of scopes. It
+// replaces httpserver.ValidateScope, which compares the whole scope claim
+// and so rejects tokens carrying several space-delimited scopes.
+func Scope(log logger.Logger, scopes ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var response model.EmptyResponse
+		for _, scope := range scopes {
+			if HasScope(ctx, scope) {
+				ctx.Next()
+				return
+			}
+		}
+		statusCode := response.Transform(ctx, log, http.StatusUnauthorized, errormsg.WrapErr(errormsg.Error401, nil, "missing scope "+strings.Join(scopes, " or ")))
+		ctx.AbortWithStatusJSON(statusCode, response)
+	}
+}
+
+// HasScope reports whether the token of the request carries scope.
+func HasScope(ctx *gin.Context, scope string) bool {
+	return model.HasScope(ctx.GetString("scope"), scope)
+}
@@ -0,0 +1,235 @@
+package oauth2
+
+import (
+	"crypto/subtle"
+	_ "embed"
+	"encoding/base64"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+var (
+	//go:embed authorize.html
+	authorizeHTML     string
+	authorizeTemplate = template.Must(template.New("authorize").Parse(authorizeHTML))
+)
+
+type authorizePage struct {
+	Action    string
+	Client    model.Role
+	Request   model.AuthorizeRequest
+	Scopes    []string
+	Email     string
+	MFAToken  string
+	CSRFToken string
+	Error     string
+}
+
+// Authorize renders the hosted login and consent page for the
+// authorization code flow. Errors about the client or redirect uri are
+// shown on the page; anything else is sent back to the client's redirect
+// uri as described in RFC 6749 section 4.1.2.1.
+func (o *Oauth2Dep) Authorize(ctx *gin.Context) {
+	var req model.AuthorizeRequest
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	if err := decoder.Decode(&req, ctx.Request.URL.Query()); err != nil {
+		o.renderAuthorize(ctx, http.StatusBadRequest, authorizePage{Error: "Invalid authorization request."})
+		return
+	}
+
+	client, err := o.account.ValidateAuthorize(ctx, req)
+	if err != nil {
+		o.authorizeError(ctx, req, err)
+		return
+	}
+
+	o.renderAuthorize(ctx, http.StatusOK, authorizePage{
+		Client:  client,
+		Request: req,
+		Scopes:  strings.Fields(req.Scope),
+	})
+}
+
+// AuthorizeLogin handles the login form posted from Authorize and
+// redirects back to the client with an authorization code. The form must
+// carry the CSRF token of the browser session, so another site cannot
+// post it to sign a user in or grant it consent.
+func (o *Oauth2Dep) AuthorizeLogin(ctx *gin.Context) {
+	var req model.Authorize
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	if err := ctx.Request.ParseForm(); err != nil {
+		o.renderAuthorize(ctx, http.StatusBadRequest, authorizePage{Error: "Invalid authorization request."})
+		return
+	}
+	if err := decoder.Decode(&req, ctx.Request.PostForm); err != nil {
+		o.renderAuthorize(ctx, http.StatusBadRequest, authorizePage{Error: "Invalid authorization request."})
+		return
+	}
+
+	client, err := o.account.ValidateAuthorize(ctx, req.AuthorizeRequest)
+	if err != nil {
+		o.authorizeError(ctx, req.AuthorizeRequest, err)
+		return
+	}
+
+	if !validCSRFToken(ctx, req.CSRFToken) {
+		o.log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "invalid csrf token"))
+		o.renderAuthorize(ctx, http.StatusForbidden, authorizePage{
+			Client:  client,
+			Request: req.AuthorizeRequest,
+			Scopes:  strings.Fields(req.Scope),
+			Error:   "Your sign in has expired, please sign in again.",
+		})
+		return
+	}
+
+	if req.Consent != "allow" {
+		o.redirect(ctx, req.RedirectURI, url.Values{
+			"error": {model.OAuth2ErrorAccessDenied},
+			"state": {req.State},
+		})
+		return
+	}
+
+	page := authorizePage{
+		Client:  client,
+		Request: req.AuthorizeRequest,
+		Scopes:  strings.Fields(req.Scope),
+		Email:   req.Email,
+	}
+	result, err := o.account.Authorize(ctx, req)
+	if err != nil {
+		switch errormsg.GetErrorCode(err) {
+		case svcerr.CodeNotAuthorized, svcerr.CodeInvalidPasswordNotMatch:
+			page.Error = "Wrong email or password."
+		case svcerr.CodeInvalidOTP:
+			page.MFAToken = req.MFAToken
+			page.Error = "Wrong verification code."
+		case svcerr.CodeInvalidMFAToken:
+			page.Error = "Your sign in has expired, please sign in again."
+		case svcerr.CodeMFAEnrollmentRequired:
+			page.Error = "Two-factor authentication must be set up before you can sign in here."
+		case svcerr.CodePasswordExpired:
+			page.Error = "Your password has expired, please change it before signing in here."
+		case svcerr.CodeAccountLocked:
+			page.Error = "Too many failed attempts, your account is temporarily locked."
+		case svcerr.CodeTooManyRequests:
+			page.MFAToken = req.MFAToken
+			page.Error = "Too many failed attempts, please wait a moment and try again."
+		default:
+			o.authorizeError(ctx, req.AuthorizeRequest, err)
+			return
+		}
+		o.log.Warn(ctx, err)
+		o.renderAuthorize(ctx, http.StatusUnauthorized, page)
+		return
+	}
+
+	if result.MFAToken != "" {
+		page.MFAToken = result.MFAToken
+		o.renderAuthorize(ctx, http.StatusOK, page)
+		return
+	}
+
+	o.redirect(ctx, req.RedirectURI, url.Values{
+		"code":  {result.Code},
+		"state": {req.State},
+	})
+}
+
+func (o *Oauth2Dep) authorizeError(ctx *gin.Context, req model.AuthorizeRequest, err error) {
+	o.log.Warn(ctx, err)
+	var oauthErr string
+	switch errormsg.GetErrorCode(err) {
+	case svcerr.CodeInvalidClient, svcerr.CodeInvalidRedirectURI:
+		o.renderAuthorize(ctx, http.StatusBadRequest, authorizePage{Error: errormsg.GetErrorData(err).WrappedMessage.Translation.EN})
+		return
+	case svcerr.CodeInvalidResponseType:
+		oauthErr = model.OAuth2ErrorUnsupportedResponseType
+	case svcerr.CodeInvalidCodeChallenge:
+		oauthErr = model.OAuth2ErrorInvalidRequest
+	default:
+		oauthErr = model.OAuth2ErrorServerError
+	}
+
+	o.redirect(ctx, req.RedirectURI, url.Values{
+		"error": {oauthErr},
+		"state": {req.State},
+	})
+}
+
+func (o *Oauth2Dep) redirect(ctx *gin.Context, redirectURI string, params url.Values) {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		o.renderAuthorize(ctx, http.StatusBadRequest, authorizePage{Error: "Invalid redirect uri."})
+		return
+	}
+
+	query := u.Query()
+	for k, v := range params {
+		if len(v) > 0 && v[0] != "" {
+			query.Set(k, v[0])
+		}
+	}
+	u.RawQuery = query.Encode()
+	ctx.Redirect(http.StatusFound, u.String())
+}
+
+func (o *Oauth2Dep) renderAuthorize(ctx *gin.Context, code int, page authorizePage) {
+	page.Action = model.AuthorizeEndpointPath
+	page.CSRFToken = o.csrfToken(ctx)
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("X-Frame-Options", "DENY")
+	ctx.Header("Content-Security-Policy", "frame-ancestors 'none'")
+	ctx.Status(code)
+	ctx.Header("Content-Type", "text/html; charset=utf-8")
+	if err := authorizeTemplate.Execute(ctx.Writer, page); err != nil {
+		o.log.Error(ctx, err)
+	}
+}
+
+// csrfToken returns the CSRF token of the browser session, the one of its
+// cookie or a new one set as a session cookie. A cross-site form cannot
+// read the cookie, so it cannot post the matching token.
+func (o *Oauth2Dep) csrfToken(ctx *gin.Context) string {
+	token, err := ctx.Cookie(model.AuthorizeCSRFCookie)
+	if err == nil && len(token) == base64.RawURLEncoding.EncodedLen(model.AuthorizeCSRFTokenSize) {
+		return token
+	}
+
+	token, err = common.GenerateRandomToken(model.AuthorizeCSRFTokenSize)
+	if err != nil {
+		o.log.Error(ctx, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate csrf token"))
+		return ""
+	}
+	http.SetCookie(ctx.Writer, &http.Cookie{
+		Name:     model.AuthorizeCSRFCookie,
+		Value:    token,
+		Path:     model.AuthorizeEndpointPath,
+		HttpOnly: true,
+		Secure:   ctx.Request.TLS != nil || ctx.GetHeader("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+	return token
+}
+
+// validCSRFToken tells whether the posted token matches the one of the
+// session cookie.
+func validCSRFToken(ctx *gin.Context, token string) bool {
+	cookie, err := ctx.Cookie(model.AuthorizeCSRFCookie)
+	if err != nil || cookie == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookie), []byte(token)) == 1
+}
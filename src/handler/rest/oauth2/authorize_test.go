@@ -0,0 +1,103 @@
+package oauth2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	mock_account "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/account"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func newTestAuthorize(t *testing.T) *gin.Engine {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	account := mock_account.NewMockAccountInterface(ctrl)
+	account.EXPECT().ValidateAuthorize(gomock.Any(), gomock.Any()).Return(model.Role{Cid: "cid"}, nil).AnyTimes()
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	o := New(Conf{}, &log, nil, account)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET(model.AuthorizeEndpointPath, o.Authorize)
+	r.POST(model.AuthorizeEndpointPath, o.AuthorizeLogin)
+	return r
+}
+
+func csrfCookie(t *testing.T, r *gin.Engine) *http.Cookie {
+	t.Helper()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, model.AuthorizeEndpointPath+"?client_id=cid", nil))
+	for _, c := range w.Result().Cookies() {
+		if c.Name == model.AuthorizeCSRFCookie {
+			if !strings.Contains(w.Body.String(), `name="csrf_token" value="`+c.Value+`"`) {
+				t.Fatal("csrf token missing from the form")
+			}
+			return c
+		}
+	}
+	t.Fatal("no csrf cookie set")
+	return nil
+}
+
+func TestAuthorizeLoginCSRF(t *testing.T) {
+	r := newTestAuthorize(t)
+	cookie := csrfCookie(t, r)
+	if !cookie.HttpOnly || cookie.SameSite != http.SameSiteLaxMode {
+		t.Fatalf("cookie %+v", cookie)
+	}
+
+	tests := []struct {
+		name   string
+		cookie *http.Cookie
+		token  string
+		code   int
+	}{
+		{"no cookie", nil, cookie.Value, http.StatusForbidden},
+		{"no token", cookie, "", http.StatusForbidden},
+		{"other token", cookie, strings.Repeat("a", len(cookie.Value)), http.StatusForbidden},
+		// a denied consent goes back to the client once the token matches
+		{"matching token", cookie, cookie.Value, http.StatusFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := url.Values{
+				"client_id":    {"cid"},
+				"redirect_uri": {"https://client.example/cb"},
+				"consent":      {"deny"},
+				"csrf_token":   {tt.token},
+			}
+			req := httptest.NewRequest(http.MethodPost, model.AuthorizeEndpointPath, strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.cookie != nil {
+				req.AddCookie(tt.cookie)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != tt.code {
+				t.Fatalf("status %d, want %d", w.Code, tt.code)
+			}
+		})
+	}
+}
+
+func TestAuthorizeKeepsSessionCSRFToken(t *testing.T) {
+	r := newTestAuthorize(t)
+	cookie := csrfCookie(t, r)
+
+	req := httptest.NewRequest(http.MethodGet, model.AuthorizeEndpointPath+"?client_id=cid", nil)
+	req.AddCookie(cookie)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if len(w.Result().Cookies()) != 0 {
+		t.Fatal("csrf cookie replaced within the session")
+	}
+	if !strings.Contains(w.Body.String(), `name="csrf_token" value="`+cookie.Value+`"`) {
+		t.Fatal("session csrf token missing from the form")
+	}
+}
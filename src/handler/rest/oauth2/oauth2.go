@@ -0,0 +1,181 @@
+package oauth2
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/account"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/token"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type Oauth2Dep struct {
+	log     logger.Logger
+	token   token.TokenInterface
+	account account.AccountInterface
+	conf    Conf
+}
+
+type Conf struct {
+	JWKSMaxAge time.Duration `mapstructure:"jwks_max_age"`
+}
+
+type Oauth2Interface interface {
+	JWKS(ctx *gin.Context)
+	OpenIDConfiguration(ctx *gin.Context)
+	UserInfo(ctx *gin.Context)
+	Token(ctx *gin.Context)
+	Authorize(ctx *gin.Context)
+	AuthorizeLogin(ctx *gin.Context)
+	Revoke(ctx *gin.Context)
+	Introspect(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, token token.TokenInterface, account account.AccountInterface) Oauth2Interface {
+	return &Oauth2Dep{
+		conf:    conf,
+		log:     *log,
+		token:   token,
+		account: account,
+	}
+}
+
+// JWKS publishes the public keys used to sign access tokens so other
+// services can verify them without holding any secret. It is served at
+// /.well-known/jwks.json and answers with a plain RFC 7517 document.
+func (o *Oauth2Dep) JWKS(ctx *gin.Context) {
+	jwks, err := o.token.JWKS(ctx)
+	if err != nil {
+		var response model.EmptyResponse
+		statusCode := response.Transform(ctx, o.log, http.StatusInternalServerError, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if o.conf.JWKSMaxAge > 0 {
+		ctx.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(o.conf.JWKSMaxAge.Seconds())))
+	}
+	ctx.JSON(http.StatusOK, jwks)
+}
+
+// OpenIDConfiguration serves the OpenID Connect discovery document at
+// /.well-known/openid-configuration so standard OIDC client libraries can
+// find the token, userinfo and JWKS endpoints on their own.
+func (o *Oauth2Dep) OpenIDConfiguration(ctx *gin.Context) {
+	issuer := o.token.Issuer()
+	ctx.JSON(http.StatusOK, model.OpenIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + model.AuthorizeEndpointPath,
+		TokenEndpoint:                     issuer + model.TokenEndpointPath,
+		UserInfoEndpoint:                  issuer + model.UserInfoEndpointPath,
+		RevocationEndpoint:                issuer + model.RevocationEndpointPath,
+		IntrospectionEndpoint:             issuer + model.IntrospectionEndpointPath,
+		JWKSURI:                           issuer + model.JWKSPath,
+		ScopesSupported:                   model.OpenIDSupportedScopes,
+		ResponseTypesSupported:            model.OpenIDSupportedResponseTypes,
+		GrantTypesSupported:               model.OpenIDSupportedGrantTypes,
+		SubjectTypesSupported:             []string{model.SubjectTypePublic},
+		IDTokenSigningAlgValuesSupported:  []string{o.token.Algorithm()},
+		TokenEndpointAuthMethodsSupported: []string{model.ClientAuthSecretBasic, model.ClientAuthNone},
+		ClaimsSupported:                   model.OpenIDSupportedClaims,
+		CodeChallengeMethodsSupported:     []string{model.CodeChallengeMethodS256},
+	})
+}
+
+// UserInfo godoc
+// @Summary OpenID Connect userinfo
+// @Description Returns the standard claims of the account the access token was issued to
+// @Tags oauth2
+// @Produce json
+// @Security OAuth2Password
+// @Success 200 {object} model.UserInfo
+// @Success 401 {object} model.OAuth2Error
+// @Router /userinfo [get]
+func (o *Oauth2Dep) UserInfo(ctx *gin.Context) {
+	result, err := o.account.GetByID(ctx, ctx.GetHeader("Cache-Control"), ctx.GetInt64("id"))
+	if err != nil {
+		o.log.Warn(ctx, err)
+		ctx.Header("WWW-Authenticate", fmt.Sprintf(`Bearer error="%s"`, model.OAuth2ErrorInvalidToken))
+		ctx.JSON(http.StatusUnauthorized, model.OAuth2Error{
+			Error:            model.OAuth2ErrorInvalidToken,
+			ErrorDescription: "account not found",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.TransformUserInfo(result))
+}
+
+// Revoke implements the RFC 7009 revocation endpoint. It answers 200 for
+// unknown tokens too, so callers cannot probe which tokens exist.
+func (o *Oauth2Dep) Revoke(ctx *gin.Context) {
+	err := o.account.Revoke(ctx, o.tokenRequest(ctx))
+	if err != nil {
+		o.oauth2Error(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+// Introspect implements the RFC 7662 introspection endpoint for resource
+// servers that would rather ask than verify tokens themselves.
+func (o *Oauth2Dep) Introspect(ctx *gin.Context) {
+	result, err := o.account.Introspect(ctx, o.tokenRequest(ctx))
+	if err != nil {
+		o.oauth2Error(ctx, err)
+		return
+	}
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, result)
+}
+
+func (o *Oauth2Dep) tokenRequest(ctx *gin.Context) model.TokenRequest {
+	clientID, clientSecret := o.clientCredentials(ctx)
+	return model.TokenRequest{
+		Token:         ctx.Request.FormValue("token"),
+		TokenTypeHint: ctx.Request.FormValue("token_type_hint"),
+		ClientID:      clientID,
+		ClientSecret:  clientSecret,
+	}
+}
+
+// clientCredentials reads client credentials from basic auth, falling back
+// to the client_id/client_secret headers used by /api/oauth2 and then to
+// the form body.
+func (o *Oauth2Dep) clientCredentials(ctx *gin.Context) (string, string) {
+	clientID, clientSecret, ok := ctx.Request.BasicAuth()
+	if !ok {
+		clientID = ctx.GetHeader("client_id")
+		clientSecret = ctx.GetHeader("client_secret")
+	}
+	if clientID == "" {
+		clientID = ctx.Request.FormValue("client_id")
+		clientSecret = ctx.Request.FormValue("client_secret")
+	}
+	return clientID, clientSecret
+}
+
+func (o *Oauth2Dep) oauth2Error(ctx *gin.Context, err error) {
+	o.log.Warn(ctx, err)
+	switch errormsg.GetErrorCode(err) {
+	case svcerr.CodeNotAuthorized, svcerr.CodeInvalidClient, svcerr.CodeInvalidClientIDClientSecret:
+		ctx.Header("WWW-Authenticate", "Basic")
+		ctx.JSON(http.StatusUnauthorized, model.OAuth2Error{
+			Error:            model.OAuth2ErrorInvalidClient,
+			ErrorDescription: errormsg.GetErrorData(err).WrappedMessage.Translation.EN,
+		})
+		return
+	case svcerr.CodeBadRequest:
+		ctx.JSON(http.StatusBadRequest, model.OAuth2Error{
+			Error:            model.OAuth2ErrorInvalidRequest,
+			ErrorDescription: errormsg.GetErrorData(err).WrappedMessage.Translation.EN,
+		})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, model.OAuth2Error{Error: model.OAuth2ErrorServerError})
+}
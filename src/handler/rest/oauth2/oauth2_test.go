@@ -0,0 +1,120 @@
+package oauth2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	mock_account "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/account"
+	mock_token "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/token"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestOpenIDConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	token := mock_token.NewMockTokenInterface(ctrl)
+	token.EXPECT().Issuer().Return("https://account.example")
+	token.EXPECT().Algorithm().Return(model.AlgorithmEdDSA)
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	o := New(Conf{}, &log, token, nil)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET(model.OpenIDConfigurationPath, o.OpenIDConfiguration)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, model.OpenIDConfigurationPath, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d, want %d", w.Code, http.StatusOK)
+	}
+	var got model.OpenIDConfiguration
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	endpoints := [][2]string{
+		{got.AuthorizationEndpoint, "https://account.example/authorize"},
+		{got.TokenEndpoint, "https://account.example/api/oauth2/token"},
+		{got.UserInfoEndpoint, "https://account.example/api/userinfo"},
+		{got.RevocationEndpoint, "https://account.example/oauth2/revoke"},
+		{got.IntrospectionEndpoint, "https://account.example/oauth2/introspect"},
+		{got.JWKSURI, "https://account.example/.well-known/jwks.json"},
+	}
+	for _, e := range endpoints {
+		if e[0] != e[1] {
+			t.Errorf("got endpoint %q, want %q", e[0], e[1])
+		}
+	}
+	if got.Issuer != "https://account.example" {
+		t.Errorf("got issuer %q", got.Issuer)
+	}
+	if !reflect.DeepEqual(got.IDTokenSigningAlgValuesSupported, []string{model.AlgorithmEdDSA}) {
+		t.Errorf("got signing algorithms %v", got.IDTokenSigningAlgValuesSupported)
+	}
+	if !reflect.DeepEqual(got.GrantTypesSupported, model.OpenIDSupportedGrantTypes) || !reflect.DeepEqual(got.ScopesSupported, model.OpenIDSupportedScopes) {
+		t.Errorf("got grant types %v, scopes %v", got.GrantTypesSupported, got.ScopesSupported)
+	}
+}
+
+func TestUserInfo(t *testing.T) {
+	updatedAt := time.Now().Truncate(time.Second)
+	tests := []struct {
+		name    string
+		account model.Account
+		err     error
+		status  int
+		want    string
+	}{
+		{
+			name:    "verified email",
+			account: model.Account{ID: 7, Name: "user", Email: "user@example.com", EmailVerifiedAt: updatedAt, BaseInformation: model.BaseInformation{UpdatedAt: updatedAt}},
+			status:  http.StatusOK,
+			want:    `{"sub":"7","name":"user","email":"user@example.com","email_verified":true,"updated_at":` + strconv.FormatInt(updatedAt.Unix(), 10) + `}`,
+		},
+		{
+			name:    "unverified email",
+			account: model.Account{ID: 7, Email: "user@example.com", BaseInformation: model.BaseInformation{UpdatedAt: updatedAt}},
+			status:  http.StatusOK,
+			want:    `{"sub":"7","email":"user@example.com","email_verified":false,"updated_at":` + strconv.FormatInt(updatedAt.Unix(), 10) + `}`,
+		},
+		{
+			name:   "account gone",
+			err:    errors.New("not found"),
+			status: http.StatusUnauthorized,
+			want:   `{"error":"invalid_token","error_description":"account not found"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := mock_account.NewMockAccountInterface(gomock.NewController(t))
+			account.EXPECT().GetByID(gomock.Any(), "", int64(7)).Return(tt.account, tt.err)
+			log := logger.New(&logger.Config{Level: logger.LevelError})
+			o := New(Conf{}, &log, nil, account)
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.GET(model.UserInfoEndpointPath, func(ctx *gin.Context) {
+				// set by the JWT middleware from the sub of the access token
+				ctx.Set("id", int64(7))
+				o.UserInfo(ctx)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, model.UserInfoEndpointPath, nil))
+			if w.Code != tt.status {
+				t.Fatalf("status %d, want %d", w.Code, tt.status)
+			}
+			if w.Body.String() != tt.want {
+				t.Fatalf("got %s, want %s", w.Body, tt.want)
+			}
+			if tt.err != nil && w.Header().Get("WWW-Authenticate") != `Bearer error="invalid_token"` {
+				t.Fatalf("WWW-Authenticate %q", w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package oauth2
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+)
+
+// tokenErrors maps the errors of the grants to the error codes of RFC 6749
+// section 5.2, errors left out are answered with server_error.
+var tokenErrors = map[int64]string{
+	svcerr.CodeInvalidClient:               model.OAuth2ErrorInvalidClient,
+	svcerr.CodeInvalidClientIDClientSecret: model.OAuth2ErrorInvalidClient,
+	svcerr.CodeInvalidGrantType:            model.OAuth2ErrorUnsupportedGrantType,
+	svcerr.CodeInvalidScope:                model.OAuth2ErrorInvalidScope,
+	svcerr.CodeBadRequest:                  model.OAuth2ErrorInvalidRequest,
+	svcerr.CodeInvalidEmptyEmail:           model.OAuth2ErrorInvalidRequest,
+	svcerr.CodeInvalidEmailFormat:          model.OAuth2ErrorInvalidRequest,
+	svcerr.CodeInvalidEmptyPassword:        model.OAuth2ErrorInvalidRequest,
+	svcerr.CodeInvalidOrganisation:         model.OAuth2ErrorInvalidRequest,
+	svcerr.CodeTooManyRequests:             model.OAuth2ErrorInvalidRequest,
+	svcerr.CodeNotAuthorized:               model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeInvalidPasswordNotMatch:     model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeInvalidRefreshToken:         model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeInvalidRedirectURI:          model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeInvalidAuthorizationCode:    model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeInvalidCodeChallenge:        model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeInvalidMFAToken:             model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeInvalidOTP:                  model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeEmailNotVerified:            model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeMFAEnrollmentRequired:       model.OAuth2ErrorInvalidGrant,
+	svcerr.CodePasswordExpired:             model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeAccountLocked:               model.OAuth2ErrorInvalidGrant,
+	svcerr.CodeNotOrganisationMember:       model.OAuth2ErrorInvalidGrant,
+}
+
+// Token godoc
+// @Summary OAuth2 token endpoint
+// @Description Issues tokens as described in RFC 6749, this is the token_endpoint of the discovery document. An account with MFA enabled gets an mfa_required error carrying the mfa_token to answer with the mfa_otp grant.
+// @Tags oauth2
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param Authorization header string false "Basic auth of the client id and client secret"
+// @Param grant_type formData string true "Grant Type" Enums(password, refresh_token, client_credentials, authorization_code, mfa_otp)
+// @Param client_id formData string false "Client ID, for clients that do not use basic auth"
+// @Param client_secret formData string false "Client Secret, for clients that do not use basic auth"
+// @Param username formData string false "Account Email"
+// @Param password formData string false "Account Password"
+// @Param refresh_token formData string false "Refresh Token"
+// @Param scope formData string false "Requested scopes, include openid to receive an id_token, role scopes narrow the scopes of the token"
+// @Param code formData string false "Authorization Code"
+// @Param redirect_uri formData string false "Redirect URI used to get the authorization code"
+// @Param code_verifier formData string false "PKCE Code Verifier"
+// @Param mfa_token formData string false "MFA challenge token returned with the mfa_required error"
+// @Param otp formData string false "TOTP or recovery code answering the MFA challenge"
+// @Param org_id formData int false "Organisation to log in for when the role is granted within several"
+// @Success 200 {object} model.TokenResponse
+// @Success 400 {object} model.OAuth2Error
+// @Success 401 {object} model.OAuth2Error
+// @Success 429 {object} model.OAuth2Error
+// @Success 500 {object} model.OAuth2Error
+// @Router /oauth2/token [post]
+func (o *Oauth2Dep) Token(ctx *gin.Context) {
+	// tokens must not be stored by caches, RFC 6749 section 5.1
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Pragma", "no-cache")
+
+	grantType := ctx.Request.FormValue("grant_type")
+	if grantType == "" {
+		ctx.JSON(http.StatusBadRequest, model.OAuth2Error{
+			Error:            model.OAuth2ErrorInvalidRequest,
+			ErrorDescription: "missing grant_type",
+		})
+		return
+	}
+
+	clientID, clientSecret := o.clientCredentials(ctx)
+	organisationID, _ := strconv.ParseInt(ctx.Request.FormValue("org_id"), 10, 64)
+	auth, err := o.account.Oauth2(ctx, model.Login{
+		GrantType:      grantType,
+		Email:          ctx.Request.FormValue("username"),
+		Password:       ctx.Request.FormValue("password"),
+		RefreshToken:   ctx.Request.FormValue("refresh_token"),
+		Scope:          ctx.Request.FormValue("scope"),
+		Code:           ctx.Request.FormValue("code"),
+		RedirectURI:    ctx.Request.FormValue("redirect_uri"),
+		CodeVerifier:   ctx.Request.FormValue("code_verifier"),
+		MFAToken:       ctx.Request.FormValue("mfa_token"),
+		OTP:            ctx.Request.FormValue("otp"),
+		OrganisationID: organisationID,
+		ClientID:       clientID,
+		ClientSecret:   clientSecret,
+	})
+	if err != nil {
+		o.tokenError(ctx, err)
+		return
+	}
+
+	// the password grant stops at the MFA challenge, there is no access
+	// token to answer with until the mfa_otp grant answers it
+	if auth.MFARequired {
+		ctx.JSON(http.StatusBadRequest, model.OAuth2Error{
+			Error:            model.OAuth2ErrorMFARequired,
+			ErrorDescription: "multi-factor authentication required",
+			MFAToken:         auth.MFAToken,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.TransformTokenResponse(auth))
+}
+
+// tokenError answers err in the format of RFC 6749 section 5.2. Failed
+// client authentication is answered with 401, throttled requests with 429
+// and the other client errors with 400.
+func (o *Oauth2Dep) tokenError(ctx *gin.Context, err error) {
+	o.log.Warn(ctx, err)
+	code := errormsg.GetErrorCode(err)
+	oauthErr, ok := tokenErrors[code]
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, model.OAuth2Error{Error: model.OAuth2ErrorServerError})
+		return
+	}
+
+	status := http.StatusBadRequest
+	switch {
+	case oauthErr == model.OAuth2ErrorInvalidClient:
+		ctx.Header("WWW-Authenticate", "Basic")
+		status = http.StatusUnauthorized
+	case code == svcerr.CodeTooManyRequests:
+		status = http.StatusTooManyRequests
+	}
+	ctx.JSON(status, model.OAuth2Error{
+		Error:            oauthErr,
+		ErrorDescription: errormsg.GetErrorData(err).WrappedMessage.Translation.EN,
+	})
+}
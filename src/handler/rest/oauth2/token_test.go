@@ -0,0 +1,206 @@
+package oauth2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	mock_account "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/account"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func newTestToken(t *testing.T) (*gin.Engine, *mock_account.MockAccountInterface) {
+	t.Helper()
+	account := mock_account.NewMockAccountInterface(gomock.NewController(t))
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	o := New(Conf{}, &log, nil, account)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST(model.TokenEndpointPath, o.Token)
+	return r, account
+}
+
+func postToken(r *gin.Engine, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, model.TokenEndpointPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("cid", "sec")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestToken(t *testing.T) {
+	r, account := newTestToken(t)
+	exp := time.Now().Add(time.Hour)
+	account.EXPECT().Oauth2(gomock.Any(), model.Login{
+		GrantType:      model.GrantTypePassword,
+		Email:          "user@example.com",
+		Password:       "secret",
+		Scope:          "openid",
+		OrganisationID: 3,
+		ClientID:       "cid",
+		ClientSecret:   "sec",
+	}).Return(model.Auth{
+		AccessToken:  "access",
+		TokenType:    model.TokenTypeBearer,
+		Exp:          &exp,
+		Scope:        "openid cus",
+		RefreshToken: "refresh",
+		IDToken:      "id",
+	}, nil)
+
+	w := postToken(r, url.Values{
+		"grant_type": {model.GrantTypePassword},
+		"username":   {"user@example.com"},
+		"password":   {"secret"},
+		"scope":      {"openid"},
+		"org_id":     {"3"},
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	if w.Header().Get("Cache-Control") != "no-store" || w.Header().Get("Pragma") != "no-cache" {
+		t.Fatalf("token response cacheable, headers %v", w.Header())
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"access_token":  "access",
+		"token_type":    model.TokenTypeBearer,
+		"expires_in":    float64(3600),
+		"refresh_token": "refresh",
+		"scope":         "openid cus",
+		"id_token":      "id",
+	}
+	for k, v := range want {
+		if body[k] != v {
+			t.Errorf("%s is %v, want %v", k, body[k], v)
+		}
+	}
+	if len(body) != len(want) {
+		t.Errorf("got %v, want only %v", body, want)
+	}
+}
+
+func TestTokenErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		error  string
+	}{
+		{
+			name:   "wrong client secret",
+			err:    errormsg.WrapErr(svcerr.AccountSVCInvalidClient, nil, "invalid client id/client secret"),
+			status: http.StatusUnauthorized,
+			error:  model.OAuth2ErrorInvalidClient,
+		},
+		{
+			name:   "wrong password",
+			err:    errormsg.WrapErr(svcerr.AccountSVCInvalidPasswordNotMatch, nil, "password not match"),
+			status: http.StatusBadRequest,
+			error:  model.OAuth2ErrorInvalidGrant,
+		},
+		{
+			name:   "unknown account",
+			err:    errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "account not found"),
+			status: http.StatusBadRequest,
+			error:  model.OAuth2ErrorInvalidGrant,
+		},
+		{
+			name:   "used refresh token",
+			err:    errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, nil, "refresh token reused"),
+			status: http.StatusBadRequest,
+			error:  model.OAuth2ErrorInvalidGrant,
+		},
+		{
+			name:   "scope not granted",
+			err:    errormsg.WrapErr(svcerr.AccountSVCInvalidScope, nil, "scope not granted"),
+			status: http.StatusBadRequest,
+			error:  model.OAuth2ErrorInvalidScope,
+		},
+		{
+			name:   "unsupported grant type",
+			err:    errormsg.WrapErr(svcerr.AccountSVCInvalidGrantType, nil, "unsupported grant type"),
+			status: http.StatusBadRequest,
+			error:  model.OAuth2ErrorUnsupportedGrantType,
+		},
+		{
+			name:   "throttled",
+			err:    errormsg.WrapErr(svcerr.AccountSVCTooManyRequests, nil, "too many login attempts"),
+			status: http.StatusTooManyRequests,
+			error:  model.OAuth2ErrorInvalidRequest,
+		},
+		{
+			name:   "unexpected failure",
+			err:    errormsg.WrapErr(svcerr.AccountSVCPSQLErrorInsert, errors.New("db down"), "error insert refresh token"),
+			status: http.StatusInternalServerError,
+			error:  model.OAuth2ErrorServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, account := newTestToken(t)
+			account.EXPECT().Oauth2(gomock.Any(), gomock.Any()).Return(model.Auth{}, tt.err)
+
+			w := postToken(r, url.Values{"grant_type": {model.GrantTypeClientCredentials}})
+			if w.Code != tt.status {
+				t.Fatalf("status %d, want %d", w.Code, tt.status)
+			}
+			var body model.OAuth2Error
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+			if body.Error != tt.error {
+				t.Fatalf("error %q, want %q", body.Error, tt.error)
+			}
+			if (w.Header().Get("WWW-Authenticate") != "") != (tt.status == http.StatusUnauthorized) {
+				t.Fatalf("WWW-Authenticate %q on status %d", w.Header().Get("WWW-Authenticate"), w.Code)
+			}
+		})
+	}
+}
+
+func TestTokenMissingGrantType(t *testing.T) {
+	r, _ := newTestToken(t)
+
+	// an empty grant_type is not taken for the password grant here
+	w := postToken(r, url.Values{"username": {"user@example.com"}, "password": {"secret"}})
+	var body model.OAuth2Error
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusBadRequest || body.Error != model.OAuth2ErrorInvalidRequest {
+		t.Fatalf("status %d, error %q", w.Code, body.Error)
+	}
+}
+
+func TestTokenMFAChallenge(t *testing.T) {
+	r, account := newTestToken(t)
+	account.EXPECT().Oauth2(gomock.Any(), gomock.Any()).Return(model.Auth{MFAToken: "mfa", MFARequired: true}, nil)
+
+	w := postToken(r, url.Values{
+		"grant_type": {model.GrantTypePassword},
+		"username":   {"user@example.com"},
+		"password":   {"secret"},
+	})
+	var body model.OAuth2Error
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusBadRequest || body.Error != model.OAuth2ErrorMFARequired || body.MFAToken != "mfa" {
+		t.Fatalf("status %d, body %+v", w.Code, body)
+	}
+}
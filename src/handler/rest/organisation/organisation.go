@@ -0,0 +1,259 @@
+package organisation
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/middleware"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/organisation"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type OrganisationDep struct {
+	log          logger.Logger
+	organisation organisation.OrganisationInterface
+	conf         Conf
+}
+
+type Conf struct{}
+
+type OrganisationInterface interface {
+	Create(ctx *gin.Context)
+	Read(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	UpdateByID(ctx *gin.Context)
+	DeleteByID(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, organisation organisation.OrganisationInterface) OrganisationInterface {
+	return &OrganisationDep{
+		conf:         conf,
+		log:          *log,
+		organisation: organisation,
+	}
+}
+
+// Create Organisation godoc
+// @Summary Create Organisation
+// @Description Create organisation data
+// @Tags organisation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateOrganisation true "Organisation Data"
+// @Success 200 {object} model.SingleOrganisationResponse
+// @Success 400 {object} model.SingleOrganisationResponse
+// @Success 500 {object} model.SingleOrganisationResponse
+// @Router /organisation [post]
+func (o *OrganisationDep) Create(ctx *gin.Context) {
+	var (
+		organisationData model.CreateOrganisation
+		result           model.Organisation
+		response         model.SingleOrganisationResponse
+	)
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &organisationData); err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	organisationData.CreatedBy = ctx.GetInt64("id")
+	result, err = o.organisation.Create(ctx, organisationData)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusCreated, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, o.log, http.StatusCreated, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get Organisations Data godoc
+// @Summary Get organisations data
+// @Description Get organisations data
+// @Tags organisation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id query string false "search by id"
+// @Param code query string false "search by code"
+// @Param name query string false "search by name prefix"
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.OrganisationsResponse
+// @Success 400 {object} model.OrganisationsResponse
+// @Success 500 {object} model.OrganisationsResponse
+// @Router /organisation [get]
+func (o *OrganisationDep) Read(ctx *gin.Context) {
+	var (
+		param    model.GetOrganisationsByParam
+		response model.OrganisationsResponse
+	)
+	cacheControl := ctx.GetHeader("Cache-Control")
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+	organisations, pagination, err := o.organisation.GetByParam(ctx, cacheControl, param)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = organisations
+	response.Pagination = pagination
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get Organisations Data godoc
+// @Summary Get organisation data
+// @Description Get organisation data, members may read their own organisation
+// @Tags organisation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "get by id"
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.SingleOrganisationResponse
+// @Success 400 {object} model.SingleOrganisationResponse
+// @Success 403 {object} model.SingleOrganisationResponse
+// @Success 500 {object} model.SingleOrganisationResponse
+// @Router /organisation/{id} [get]
+func (o *OrganisationDep) GetByID(ctx *gin.Context) {
+	var response model.SingleOrganisationResponse
+	cacheControl := ctx.GetHeader("Cache-Control")
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if organisationID, _ := middleware.Organisation(ctx); organisationID != id && !middleware.HasPermission(ctx, model.PermissionOrganisationRead) {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "missing permission "+model.PermissionOrganisationRead))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	result, err := o.organisation.GetByID(ctx, cacheControl, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Update Organisation Data godoc
+// @Summary Update organisation data
+// @Description Update organisation data
+// @Tags organisation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "update by id"
+// @Param data body model.UpdateOrganisation true "Organisation Data"
+// @Success 200 {object} model.SingleOrganisationResponse
+// @Success 400 {object} model.SingleOrganisationResponse
+// @Success 500 {object} model.SingleOrganisationResponse
+// @Router /organisation/{id} [put]
+func (o *OrganisationDep) UpdateByID(ctx *gin.Context) {
+	var (
+		updateData model.UpdateOrganisation
+		response   model.SingleOrganisationResponse
+	)
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &updateData); err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	updateData.UpdatedBy = ctx.GetInt64("id")
+	result, err := o.organisation.UpdateByID(ctx, id, updateData)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Delete Organisation Data godoc
+// @Summary Delete organisation data
+// @Description Delete organisation data
+// @Tags organisation
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "delete by id"
+// @Success 200 {object} model.EmptyResponse
+// @Success 400 {object} model.EmptyResponse
+// @Success 500 {object} model.EmptyResponse
+// @Router /organisation/{id} [delete]
+func (o *OrganisationDep) DeleteByID(ctx *gin.Context) {
+	var (
+		response model.EmptyResponse
+	)
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	err = o.organisation.DeleteByID(ctx, ctx.GetInt64("id"), false, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
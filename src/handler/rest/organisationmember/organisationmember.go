@@ -0,0 +1,319 @@
+package organisationmember
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/middleware"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/organisationmember"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+	"github.com/volatiletech/null/v8"
+)
+
+type OrganisationMemberDep struct {
+	log                logger.Logger
+	organisationmember organisationmember.OrganisationMemberInterface
+	conf               Conf
+}
+
+type Conf struct{}
+
+// OrganisationMemberInterface serves the members of organisations. Besides
+// callers holding the organisation permissions, the admins of an
+// organisation manage its members.
+type OrganisationMemberInterface interface {
+	Create(ctx *gin.Context)
+	Read(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	UpdateByID(ctx *gin.Context)
+	DeleteByID(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, organisationmember organisationmember.OrganisationMemberInterface) OrganisationMemberInterface {
+	return &OrganisationMemberDep{
+		conf:               conf,
+		log:                *log,
+		organisationmember: organisationmember,
+	}
+}
+
+// Create OrganisationMember godoc
+// @Summary Create OrganisationMember
+// @Description Add an account to an organisation as admin or staff
+// @Tags organisation-member
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateOrganisationMember true "OrganisationMember Data"
+// @Success 200 {object} model.SingleOrganisationMemberResponse
+// @Success 400 {object} model.SingleOrganisationMemberResponse
+// @Success 403 {object} model.SingleOrganisationMemberResponse
+// @Success 500 {object} model.SingleOrganisationMemberResponse
+// @Router /organisation-member [post]
+func (o *OrganisationMemberDep) Create(ctx *gin.Context) {
+	var (
+		memberData model.CreateOrganisationMember
+		result     model.OrganisationMember
+		response   model.SingleOrganisationMemberResponse
+	)
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &memberData); err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if !canManage(ctx, model.PermissionOrganisationWrite, memberData.OrganisationID) {
+		statusCode := response.Transform(ctx, o.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "not an admin of the organisation"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	memberData.CreatedBy = ctx.GetInt64("id")
+	result, err = o.organisationmember.Create(ctx, memberData)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusCreated, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, o.log, http.StatusCreated, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get OrganisationMembers Data godoc
+// @Summary Get organisation members data
+// @Description Get organisation members data, admins of an organisation only see its members
+// @Tags organisation-member
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id query string false "search by id"
+// @Param organisation_id query int false "search by organisation id"
+// @Param account_id query int false "search by account id"
+// @Param role query string false "search by organisation role" Enums(admin, staff)
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.OrganisationMembersResponse
+// @Success 400 {object} model.OrganisationMembersResponse
+// @Success 403 {object} model.OrganisationMembersResponse
+// @Success 500 {object} model.OrganisationMembersResponse
+// @Router /organisation-member [get]
+func (o *OrganisationMemberDep) Read(ctx *gin.Context) {
+	var (
+		param    model.GetOrganisationMembersByParam
+		response model.OrganisationMembersResponse
+	)
+	cacheControl := ctx.GetHeader("Cache-Control")
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if !middleware.HasPermission(ctx, model.PermissionOrganisationRead) {
+		organisationID, _ := middleware.Organisation(ctx)
+		if !middleware.OrganisationAdmin(ctx, organisationID) {
+			statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "missing permission "+model.PermissionOrganisationRead))
+			ctx.JSON(statusCode, response)
+			return
+		}
+		param.OrganisationID = null.NewInt64(organisationID, true)
+	}
+
+	members, pagination, err := o.organisationmember.GetByParam(ctx, cacheControl, param)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = members
+	response.Pagination = pagination
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get OrganisationMembers Data godoc
+// @Summary Get organisation member data
+// @Description Get organisation member data
+// @Tags organisation-member
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "get by id"
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.SingleOrganisationMemberResponse
+// @Success 400 {object} model.SingleOrganisationMemberResponse
+// @Success 403 {object} model.SingleOrganisationMemberResponse
+// @Success 500 {object} model.SingleOrganisationMemberResponse
+// @Router /organisation-member/{id} [get]
+func (o *OrganisationMemberDep) GetByID(ctx *gin.Context) {
+	var response model.SingleOrganisationMemberResponse
+	cacheControl := ctx.GetHeader("Cache-Control")
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	result, err := o.organisationmember.GetByID(ctx, cacheControl, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if !canManage(ctx, model.PermissionOrganisationRead, result.OrganisationID) {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "not an admin of the organisation"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Update OrganisationMember Data godoc
+// @Summary Update organisation member data
+// @Description Change the organisation role of a member
+// @Tags organisation-member
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "update by id"
+// @Param data body model.UpdateOrganisationMember true "OrganisationMember Data"
+// @Success 200 {object} model.SingleOrganisationMemberResponse
+// @Success 400 {object} model.SingleOrganisationMemberResponse
+// @Success 403 {object} model.SingleOrganisationMemberResponse
+// @Success 500 {object} model.SingleOrganisationMemberResponse
+// @Router /organisation-member/{id} [put]
+func (o *OrganisationMemberDep) UpdateByID(ctx *gin.Context) {
+	var (
+		updateData model.UpdateOrganisationMember
+		response   model.SingleOrganisationMemberResponse
+	)
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &updateData); err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	member, err := o.organisationmember.GetByID(ctx, model.MustRevalidate, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if !canManage(ctx, model.PermissionOrganisationWrite, member.OrganisationID) {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "not an admin of the organisation"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	updateData.UpdatedBy = ctx.GetInt64("id")
+	result, err := o.organisationmember.UpdateByID(ctx, id, updateData)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Delete OrganisationMember Data godoc
+// @Summary Delete organisation member data
+// @Description Remove an account from its organisation with the roles granted within it
+// @Tags organisation-member
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "delete by id"
+// @Success 200 {object} model.EmptyResponse
+// @Success 400 {object} model.EmptyResponse
+// @Success 403 {object} model.EmptyResponse
+// @Success 500 {object} model.EmptyResponse
+// @Router /organisation-member/{id} [delete]
+func (o *OrganisationMemberDep) DeleteByID(ctx *gin.Context) {
+	var (
+		response model.EmptyResponse
+	)
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	member, err := o.organisationmember.GetByID(ctx, model.MustRevalidate, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if !canManage(ctx, model.PermissionOrganisationWrite, member.OrganisationID) {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "not an admin of the organisation"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	err = o.organisationmember.DeleteByID(ctx, ctx.GetInt64("id"), false, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, o.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	statusCode := response.Transform(ctx, o.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// canManage reports whether the caller holds permission or is an admin of
+// the organisation.
+func canManage(ctx *gin.Context, permission string, organisationID int64) bool {
+	return middleware.HasPermission(ctx, permission) || middleware.OrganisationAdmin(ctx, organisationID)
+}
@@ -0,0 +1,250 @@
+package permission
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/permission"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type PermissionDep struct {
+	log        logger.Logger
+	permission permission.PermissionInterface
+	conf       Conf
+}
+
+type Conf struct{}
+
+type PermissionInterface interface {
+	Create(ctx *gin.Context)
+	Read(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	UpdateByID(ctx *gin.Context)
+	DeleteByID(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, permission permission.PermissionInterface) PermissionInterface {
+	return &PermissionDep{
+		conf:       conf,
+		log:        *log,
+		permission: permission,
+	}
+}
+
+// Create Permission godoc
+// @Summary Create Permission
+// @Description Create permission data
+// @Tags permission
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreatePermission true "Permission Data"
+// @Success 200 {object} model.SinglePermissionResponse
+// @Success 400 {object} model.SinglePermissionResponse
+// @Success 500 {object} model.SinglePermissionResponse
+// @Router /permission [post]
+func (p *PermissionDep) Create(ctx *gin.Context) {
+	var (
+		permissionData model.CreatePermission
+		result         model.Permission
+		response       model.SinglePermissionResponse
+	)
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &permissionData); err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	permissionData.CreatedBy = ctx.GetInt64("id")
+	result, err = p.permission.Create(ctx, permissionData)
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusCreated, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, p.log, http.StatusCreated, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get Permissions Data godoc
+// @Summary Get permissions data
+// @Description Get permissions data
+// @Tags permission
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id query string false "search by id"
+// @Param name query string false "search by name prefix"
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.PermissionsResponse
+// @Success 400 {object} model.PermissionsResponse
+// @Success 500 {object} model.PermissionsResponse
+// @Router /permission [get]
+func (p *PermissionDep) Read(ctx *gin.Context) {
+	var (
+		param    model.GetPermissionsByParam
+		response model.PermissionsResponse
+	)
+	cacheControl := ctx.GetHeader("Cache-Control")
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+	permissions, pagination, err := p.permission.GetByParam(ctx, cacheControl, param)
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = permissions
+	response.Pagination = pagination
+
+	statusCode := response.Transform(ctx, p.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get Permissions Data godoc
+// @Summary Get permissions data
+// @Description Get permissions data
+// @Tags permission
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "get by id"
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.SinglePermissionResponse
+// @Success 400 {object} model.SinglePermissionResponse
+// @Success 500 {object} model.SinglePermissionResponse
+// @Router /permission/{id} [get]
+func (p *PermissionDep) GetByID(ctx *gin.Context) {
+	var response model.SinglePermissionResponse
+	cacheControl := ctx.GetHeader("Cache-Control")
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	result, err := p.permission.GetByID(ctx, cacheControl, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, p.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Update Permission Data godoc
+// @Summary Update permission data
+// @Description Update permission data
+// @Tags permission
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "update by id"
+// @Param data body model.UpdatePermission true "Permission Data"
+// @Success 200 {object} model.SinglePermissionResponse
+// @Success 400 {object} model.SinglePermissionResponse
+// @Success 500 {object} model.SinglePermissionResponse
+// @Router /permission/{id} [put]
+func (p *PermissionDep) UpdateByID(ctx *gin.Context) {
+	var (
+		updateData model.UpdatePermission
+		response   model.SinglePermissionResponse
+	)
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &updateData); err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusOK, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	updateData.UpdatedBy = ctx.GetInt64("id")
+	result, err := p.permission.UpdateByID(ctx, id, updateData)
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, p.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Delete Permission Data godoc
+// @Summary Delete permission data
+// @Description Delete permission data
+// @Tags permission
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "delete by id"
+// @Success 200 {object} model.EmptyResponse
+// @Success 400 {object} model.EmptyResponse
+// @Success 500 {object} model.EmptyResponse
+// @Router /permission/{id} [delete]
+func (p *PermissionDep) DeleteByID(ctx *gin.Context) {
+	var (
+		response model.EmptyResponse
+	)
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	err = p.permission.DeleteByID(ctx, ctx.GetInt64("id"), false, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, p.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	statusCode := response.Transform(ctx, p.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
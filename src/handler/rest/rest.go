@@ -1,13 +1,24 @@
 package rest
 
 import (
+	"expvar"
+	"net/http"
+
 	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/account"
 	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/accountrole"
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/apikey"
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/auditlog"
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/impersonation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/invitation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/middleware"
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/oauth2"
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/organisation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/organisationmember"
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/permission"
 	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/role"
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/rolepermission"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model"
 	"github.com/achwanyusuf/carrent-accountsvc/src/usecase"
-	"github.com/achwanyusuf/carrent-lib/pkg/httpserver"
-	"github.com/achwanyusuf/carrent-lib/pkg/jwt"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
 	"github.com/gin-gonic/gin"
 )
@@ -20,15 +31,33 @@ type RestDep struct {
 }
 
 type Config struct {
-	Account     account.Conf     `mapstructure:"account"`
-	Role        role.Conf        `mapstructure:"role"`
-	AccountRole accountrole.Conf `mapstructure:"account_role"`
+	Account            account.Conf            `mapstructure:"account"`
+	Role               role.Conf               `mapstructure:"role"`
+	AccountRole        accountrole.Conf        `mapstructure:"account_role"`
+	Oauth2             oauth2.Conf             `mapstructure:"oauth2"`
+	Permission         permission.Conf         `mapstructure:"permission"`
+	RolePermission     rolepermission.Conf     `mapstructure:"role_permission"`
+	Organisation       organisation.Conf       `mapstructure:"organisation"`
+	OrganisationMember organisationmember.Conf `mapstructure:"organisation_member"`
+	Invitation         invitation.Conf         `mapstructure:"invitation"`
+	AuditLog           auditlog.Conf           `mapstructure:"audit_log"`
+	Impersonation      impersonation.Conf      `mapstructure:"impersonation"`
+	APIKey             apikey.Conf             `mapstructure:"api_key"`
 }
 
 type RestInterface struct {
-	Account     account.AccountInterface
-	Role        role.RoleInterface
-	AccountRole accountrole.AccountRoleInterface
+	Account            account.AccountInterface
+	Role               role.RoleInterface
+	AccountRole        accountrole.AccountRoleInterface
+	Oauth2             oauth2.Oauth2Interface
+	Permission         permission.PermissionInterface
+	RolePermission     rolepermission.RolePermissionInterface
+	Organisation       organisation.OrganisationInterface
+	OrganisationMember organisationmember.OrganisationMemberInterface
+	Invitation         invitation.InvitationInterface
+	AuditLog           auditlog.AuditLogInterface
+	Impersonation      impersonation.ImpersonationInterface
+	APIKey             apikey.APIKeyInterface
 }
 
 func New(r *RestDep) *RestInterface {
@@ -36,34 +65,118 @@ func New(r *RestDep) *RestInterface {
 		account.New(r.Conf.Account, r.Log, r.Usecase.Account),
 		role.New(r.Conf.Role, r.Log, r.Usecase.Role),
 		accountrole.New(r.Conf.AccountRole, r.Log, r.Usecase.AccountRole),
+		oauth2.New(r.Conf.Oauth2, r.Log, r.Usecase.Token, r.Usecase.Account),
+		permission.New(r.Conf.Permission, r.Log, r.Usecase.Permission),
+		rolepermission.New(r.Conf.RolePermission, r.Log, r.Usecase.RolePermission),
+		organisation.New(r.Conf.Organisation, r.Log, r.Usecase.Organisation),
+		organisationmember.New(r.Conf.OrganisationMember, r.Log, r.Usecase.OrganisationMember),
+		invitation.New(r.Conf.Invitation, r.Log, r.Usecase.Invitation),
+		auditlog.New(r.Conf.AuditLog, r.Log, r.Usecase.AuditLog),
+		impersonation.New(r.Conf.Impersonation, r.Log, r.Usecase.Impersonation),
+		apikey.New(r.Conf.APIKey, r.Log, r.Usecase.APIKey),
 	}
 }
 
 func (r *RestDep) Serve(handler *RestInterface) {
+	r.Gin.GET(model.JWKSPath, handler.Oauth2.JWKS)
+	r.Gin.GET(model.OpenIDConfigurationPath, handler.Oauth2.OpenIDConfiguration)
+	r.Gin.GET(model.AuthorizeEndpointPath, handler.Oauth2.Authorize)
+	r.Gin.POST(model.AuthorizeEndpointPath, handler.Oauth2.AuthorizeLogin)
+	r.Gin.POST(model.RevocationEndpointPath, handler.Oauth2.Revoke)
+	r.Gin.POST(model.IntrospectionEndpointPath, handler.Oauth2.Introspect)
+	// the hit, miss and coalesced counts of the domain caches
+	r.Gin.GET(model.CacheMetricsPath, func(ctx *gin.Context) {
+		ctx.Data(http.StatusOK, "application/json; charset=utf-8", []byte(expvar.Get("cache").String()))
+	})
+	// whether the caches are degraded to psql while redis is unreachable,
+	// the service itself stays up so /health, /live and /ready do not tell
+	r.Gin.GET(model.CacheHealthPath, func(ctx *gin.Context) {
+		health := model.CacheHealth{Status: model.CacheStatusOK, Breaker: model.CacheBreakerClosed}
+		if f, ok := expvar.Get(model.CacheHealthVar).(expvar.Func); ok {
+			health, _ = f().(model.CacheHealth)
+		}
+		status := http.StatusOK
+		if health.Status == model.CacheStatusDegraded {
+			status = http.StatusServiceUnavailable
+		}
+		ctx.JSON(status, health)
+	})
+
 	api := r.Gin.Group("/api")
 	api.POST("/oauth2", handler.Account.Oauth2)
+	api.POST("/oauth2/token", handler.Oauth2.Token)
 	api.POST("/register", handler.Account.Register)
+	api.POST("/register/verify", handler.Account.VerifyEmail)
+	api.POST("/register/resend", handler.Account.ResendVerification)
+	api.POST("/password/forgot", handler.Account.ForgotPassword)
+	api.POST("/password/reset", handler.Account.ResetPassword)
+	api.POST("/invitation/accept", handler.Invitation.Accept)
 
-	api.Use(jwt.JWT(*r.Log, []byte(r.Conf.Account.TokenSecret)))
+	api.Use(middleware.JWT(*r.Log, r.Usecase.Token, r.Usecase.APIKey))
 	{
-		api.GET("/me", handler.Account.CurrentAccount)
-		api.PUT("/me", handler.Account.UpdateCurrentAccount)
-		api.PUT("/me/password", handler.Account.UpdatePasswordAccount)
-		api.POST("/account", handler.Account.Create)
-		api.GET("/account", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope}), handler.Account.Read)
+		me := api.Group("/me", middleware.AccountOnly(*r.Log))
+		me.GET("", handler.Account.CurrentAccount)
+		me.PUT("", handler.Account.UpdateCurrentAccount)
+		me.PUT("/password", middleware.NotImpersonating(*r.Log), middleware.NotAPIKey(*r.Log), handler.Account.UpdatePasswordAccount)
+		me.POST("/mfa", middleware.NotImpersonating(*r.Log), middleware.NotAPIKey(*r.Log), handler.Account.EnrollMFA)
+		me.POST("/mfa/confirm", middleware.NotImpersonating(*r.Log), middleware.NotAPIKey(*r.Log), handler.Account.ConfirmMFA)
+		me.DELETE("/mfa", middleware.NotImpersonating(*r.Log), middleware.NotAPIKey(*r.Log), handler.Account.DisableMFA)
+		me.POST("/mfa/recovery-codes", middleware.NotImpersonating(*r.Log), middleware.NotAPIKey(*r.Log), handler.Account.RegenerateRecoveryCodes)
+		me.DELETE("/impersonation", handler.Impersonation.StopImpersonation)
+		me.POST("/tokens", middleware.NotImpersonating(*r.Log), middleware.NotAPIKey(*r.Log), handler.APIKey.Create)
+		me.GET("/tokens", middleware.NotImpersonating(*r.Log), middleware.NotAPIKey(*r.Log), handler.APIKey.Read)
+		me.DELETE("/tokens/:id", middleware.NotImpersonating(*r.Log), middleware.NotAPIKey(*r.Log), handler.APIKey.RevokeByID)
+
+		api.GET("/userinfo", middleware.AccountOnly(*r.Log), handler.Oauth2.UserInfo)
+		api.POST("/userinfo", middleware.AccountOnly(*r.Log), handler.Oauth2.UserInfo)
+
+		api.POST("/account", middleware.Permission(*r.Log, model.PermissionAccountWrite), handler.Account.Create)
+		api.GET("/account", handler.Account.Read)
 		api.GET("/account/:id", handler.Account.GetByID)
 		api.PUT("/account/:id", handler.Account.UpdateByID)
-		api.DELETE("/account/:id", handler.Account.DeleteByID)
-
-		api.POST("/role", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope}), handler.Role.Create)
-		api.GET("/role", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope}), handler.Role.Read)
-		api.GET("/role/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope}), handler.Role.GetByID)
-		api.PUT("/role/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope}), handler.Role.UpdateByID)
-		api.DELETE("/role/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope}), handler.Role.DeleteByID)
-
-		api.POST("/account-role", handler.AccountRole.Create)
-		api.GET("/account-role", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope}), handler.AccountRole.Read)
-		api.GET("/account-role/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope}), handler.AccountRole.GetByID)
-		api.DELETE("/account-role/:id", httpserver.ValidateScope(*r.Log, []string{model.SuperAdminScope}), handler.AccountRole.DeleteByID)
+		api.DELETE("/account/:id", middleware.NotImpersonating(*r.Log), handler.Account.DeleteByID)
+		api.POST("/account/:id/unlock", middleware.Permission(*r.Log, model.PermissionAccountUnlock), handler.Account.Unlock)
+		api.POST("/account/:id/impersonate", middleware.AccountOnly(*r.Log), middleware.NotAPIKey(*r.Log), middleware.Permission(*r.Log, model.PermissionAccountImpersonate), handler.Impersonation.Impersonate)
+
+		api.POST("/role", middleware.Permission(*r.Log, model.PermissionRoleWrite), handler.Role.Create)
+		api.GET("/role", middleware.Permission(*r.Log, model.PermissionRoleRead), handler.Role.Read)
+		api.GET("/role/:id", middleware.Permission(*r.Log, model.PermissionRoleRead), handler.Role.GetByID)
+		api.PUT("/role/:id", middleware.Permission(*r.Log, model.PermissionRoleWrite), handler.Role.UpdateByID)
+		api.DELETE("/role/:id", middleware.Permission(*r.Log, model.PermissionRoleDelete), handler.Role.DeleteByID)
+
+		api.POST("/account-role", middleware.PermissionOrOrganisationAdmin(*r.Log, model.PermissionAccountRoleWrite), handler.AccountRole.Create)
+		api.GET("/account-role", middleware.Permission(*r.Log, model.PermissionAccountRoleRead), handler.AccountRole.Read)
+		api.GET("/account-role/:id", middleware.Permission(*r.Log, model.PermissionAccountRoleRead), handler.AccountRole.GetByID)
+		api.DELETE("/account-role/:id", middleware.Permission(*r.Log, model.PermissionAccountRoleDelete), handler.AccountRole.DeleteByID)
+
+		api.POST("/permission", middleware.Permission(*r.Log, model.PermissionPermissionWrite), handler.Permission.Create)
+		api.GET("/permission", middleware.Permission(*r.Log, model.PermissionPermissionRead), handler.Permission.Read)
+		api.GET("/permission/:id", middleware.Permission(*r.Log, model.PermissionPermissionRead), handler.Permission.GetByID)
+		api.PUT("/permission/:id", middleware.Permission(*r.Log, model.PermissionPermissionWrite), handler.Permission.UpdateByID)
+		api.DELETE("/permission/:id", middleware.Permission(*r.Log, model.PermissionPermissionDelete), handler.Permission.DeleteByID)
+
+		api.POST("/role-permission", middleware.Permission(*r.Log, model.PermissionRoleWrite), handler.RolePermission.Create)
+		api.GET("/role-permission", middleware.Permission(*r.Log, model.PermissionRoleRead), handler.RolePermission.Read)
+		api.GET("/role-permission/:id", middleware.Permission(*r.Log, model.PermissionRoleRead), handler.RolePermission.GetByID)
+		api.DELETE("/role-permission/:id", middleware.Permission(*r.Log, model.PermissionRoleWrite), handler.RolePermission.DeleteByID)
+
+		api.POST("/organisation", middleware.Permission(*r.Log, model.PermissionOrganisationWrite), handler.Organisation.Create)
+		api.GET("/organisation", middleware.Permission(*r.Log, model.PermissionOrganisationRead), handler.Organisation.Read)
+		api.GET("/organisation/:id", handler.Organisation.GetByID)
+		api.PUT("/organisation/:id", middleware.Permission(*r.Log, model.PermissionOrganisationWrite), handler.Organisation.UpdateByID)
+		api.DELETE("/organisation/:id", middleware.Permission(*r.Log, model.PermissionOrganisationDelete), handler.Organisation.DeleteByID)
+
+		api.POST("/organisation-member", handler.OrganisationMember.Create)
+		api.GET("/organisation-member", handler.OrganisationMember.Read)
+		api.GET("/organisation-member/:id", handler.OrganisationMember.GetByID)
+		api.PUT("/organisation-member/:id", handler.OrganisationMember.UpdateByID)
+		api.DELETE("/organisation-member/:id", handler.OrganisationMember.DeleteByID)
+
+		api.POST("/invitation", handler.Invitation.Create)
+		api.GET("/invitation", handler.Invitation.Read)
+		api.GET("/invitation/:id", handler.Invitation.GetByID)
+		api.DELETE("/invitation/:id", handler.Invitation.RevokeByID)
+
+		api.GET("/audit-log", middleware.Permission(*r.Log, model.PermissionAuditLogRead), handler.AuditLog.Read)
 	}
 }
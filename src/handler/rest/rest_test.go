@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// TestDiscoveryEndpointsServed checks every endpoint named in the OpenID
+// Connect discovery document is routed to the handler implementing it.
+func TestDiscoveryEndpointsServed(t *testing.T) {
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	gin.SetMode(gin.TestMode)
+	r := &RestDep{Log: &log, Usecase: &usecase.UsecaseInterface{}, Gin: gin.New()}
+	r.Serve(New(r))
+
+	handlers := map[string]string{}
+	for _, route := range r.Gin.Routes() {
+		handlers[route.Method+" "+route.Path] = route.Handler
+	}
+	tests := []struct {
+		method  string
+		path    string
+		handler string
+	}{
+		{http.MethodGet, model.AuthorizeEndpointPath, ".Authorize-fm"},
+		{http.MethodPost, model.TokenEndpointPath, ".Token-fm"},
+		{http.MethodGet, model.UserInfoEndpointPath, ".UserInfo-fm"},
+		{http.MethodPost, model.RevocationEndpointPath, ".Revoke-fm"},
+		{http.MethodPost, model.IntrospectionEndpointPath, ".Introspect-fm"},
+		{http.MethodGet, model.JWKSPath, ".JWKS-fm"},
+	}
+	for _, tt := range tests {
+		handler, ok := handlers[tt.method+" "+tt.path]
+		if !ok {
+			t.Errorf("%s %s not routed", tt.method, tt.path)
+			continue
+		}
+		if !strings.HasSuffix(handler, tt.handler) {
+			t.Errorf("%s %s routed to %s", tt.method, tt.path, handler)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/achwanyusuf/carrent-accountsvc/src/handler/rest/middleware"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
 	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/role"
@@ -75,7 +76,7 @@ func (a *RoleDep) Create(ctx *gin.Context) {
 		return
 	}
 
-	roleData.CreatedBy = ctx.Value("id").(int64)
+	roleData.CreatedBy = ctx.GetInt64("id")
 	result, err = a.role.Create(ctx, roleData)
 	if err != nil {
 		statusCode := response.Transform(ctx, a.log, http.StatusCreated, err)
@@ -207,10 +208,9 @@ func (a *RoleDep) UpdateByID(ctx *gin.Context) {
 		ctx.JSON(statusCode, response)
 		return
 	}
-	updateData.UpdatedBy = ctx.Value("id").(int64)
-	scope := ctx.Value("scope").(string)
-	if scope != model.SuperAdminScope {
-		id = ctx.Value("id").(int64)
+	updateData.UpdatedBy = ctx.GetInt64("id")
+	if !middleware.HasPermission(ctx, model.PermissionRoleWrite) {
+		id = ctx.GetInt64("id")
 	}
 	result, err := a.role.UpdateByID(ctx, id, updateData)
 	if err != nil {
@@ -247,11 +247,10 @@ func (a *RoleDep) DeleteByID(ctx *gin.Context) {
 		ctx.JSON(statusCode, response)
 		return
 	}
-	scope := ctx.Value("scope").(string)
-	if scope != model.SuperAdminScope {
-		id = ctx.Value("id").(int64)
+	if !middleware.HasPermission(ctx, model.PermissionRoleDelete) {
+		id = ctx.GetInt64("id")
 	}
-	err = a.role.DeleteByID(ctx, ctx.Value("id").(int64), false, id)
+	err = a.role.DeleteByID(ctx, ctx.GetInt64("id"), false, id)
 	if err != nil {
 		statusCode := response.Transform(ctx, a.log, http.StatusOK, err)
 		ctx.JSON(statusCode, response)
@@ -0,0 +1,198 @@
+package rolepermission
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/rolepermission"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type RolePermissionDep struct {
+	log            logger.Logger
+	rolepermission rolepermission.RolePermissionInterface
+	conf           Conf
+}
+
+type Conf struct{}
+
+type RolePermissionInterface interface {
+	Create(ctx *gin.Context)
+	Read(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	DeleteByID(ctx *gin.Context)
+}
+
+func New(conf Conf, log *logger.Logger, rolepermission rolepermission.RolePermissionInterface) RolePermissionInterface {
+	return &RolePermissionDep{
+		conf:           conf,
+		log:            *log,
+		rolepermission: rolepermission,
+	}
+}
+
+// Create RolePermission godoc
+// @Summary Create RolePermission
+// @Description Grant a permission to a role, tokens issued afterwards carry it
+// @Tags role-permission
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param data body model.CreateRolePermission true "RolePermission Data"
+// @Success 200 {object} model.SingleRolePermissionResponse
+// @Success 400 {object} model.SingleRolePermissionResponse
+// @Success 500 {object} model.SingleRolePermissionResponse
+// @Router /role-permission [post]
+func (r *RolePermissionDep) Create(ctx *gin.Context) {
+	var (
+		rolePermissionData model.CreateRolePermission
+		result             model.RolePermission
+		response           model.SingleRolePermissionResponse
+	)
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		statusCode := response.Transform(ctx, r.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	if err = json.Unmarshal(body, &rolePermissionData); err != nil {
+		statusCode := response.Transform(ctx, r.log, http.StatusCreated, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error unmarshal body"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	rolePermissionData.CreatedBy = ctx.GetInt64("id")
+	result, err = r.rolepermission.Create(ctx, rolePermissionData)
+	if err != nil {
+		statusCode := response.Transform(ctx, r.log, http.StatusCreated, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, r.log, http.StatusCreated, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get RolePermissions Data godoc
+// @Summary Get role permissions data
+// @Description Get role permissions data
+// @Tags role-permission
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id query string false "search by id"
+// @Param role_id query int false "search by role id"
+// @Param permission_id query int false "search by permission id"
+// @Param sort_by query string false "sort result by attributes"
+// @Param page query int false " "
+// @Param limit query int false " "
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.RolePermissionsResponse
+// @Success 400 {object} model.RolePermissionsResponse
+// @Success 500 {object} model.RolePermissionsResponse
+// @Router /role-permission [get]
+func (r *RolePermissionDep) Read(ctx *gin.Context) {
+	var (
+		param    model.GetRolePermissionsByParam
+		response model.RolePermissionsResponse
+	)
+	cacheControl := ctx.GetHeader("Cache-Control")
+	var decoder = schema.NewDecoder()
+	err := decoder.Decode(&param, ctx.Request.URL.Query())
+	if err != nil {
+		statusCode := response.Transform(ctx, r.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+	roles, pagination, err := r.rolepermission.GetByParam(ctx, cacheControl, param)
+	if err != nil {
+		statusCode := response.Transform(ctx, r.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = roles
+	response.Pagination = pagination
+
+	statusCode := response.Transform(ctx, r.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Get RolePermissions Data godoc
+// @Summary Get role permission by id data
+// @Description Get role permission by id data
+// @Tags role-permission
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "get by id"
+// @Param Cache-Control header string false "Request Cache Control" Enums(must-revalidate, none)
+// @Success 200 {object} model.SingleRolePermissionResponse
+// @Success 400 {object} model.SingleRolePermissionResponse
+// @Success 500 {object} model.SingleRolePermissionResponse
+// @Router /role-permission/{id} [get]
+func (r *RolePermissionDep) GetByID(ctx *gin.Context) {
+	var response model.SingleRolePermissionResponse
+	cacheControl := ctx.GetHeader("Cache-Control")
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, r.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	result, err := r.rolepermission.GetByID(ctx, cacheControl, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, r.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	response.Data = result
+
+	statusCode := response.Transform(ctx, r.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
+
+// Delete RolePermission Data godoc
+// @Summary Delete role permission data
+// @Description Delete role permission data
+// @Tags role-permission
+// @Accept json
+// @Produce json
+// @Security OAuth2Password
+// @Param id path string true "delete by id"
+// @Success 200 {object} model.EmptyResponse
+// @Success 400 {object} model.EmptyResponse
+// @Success 500 {object} model.EmptyResponse
+// @Router /role-permission/{id} [delete]
+func (r *RolePermissionDep) DeleteByID(ctx *gin.Context) {
+	var (
+		response model.EmptyResponse
+	)
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		statusCode := response.Transform(ctx, r.log, http.StatusOK, errormsg.WrapErr(errormsg.Error400, err, "error get id"))
+		ctx.JSON(statusCode, response)
+		return
+	}
+	err = r.rolepermission.DeleteByID(ctx, ctx.GetInt64("id"), false, id)
+	if err != nil {
+		statusCode := response.Transform(ctx, r.log, http.StatusOK, err)
+		ctx.JSON(statusCode, response)
+		return
+	}
+
+	statusCode := response.Transform(ctx, r.log, http.StatusOK, nil)
+	ctx.JSON(statusCode, response)
+}
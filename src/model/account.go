@@ -2,7 +2,6 @@ package model
 
 import (
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
@@ -16,32 +15,97 @@ var (
 	GetSingleByParamAccountKey string = "gspAccount:%s"
 	GetByParamAccountKey       string = "gpAccount:%s"
 	GetByParamAccountPgKey     string = "gppgAccount:%s"
+	// cache tags, entries cached with a tag are evicted when it is
+	// invalidated
+	AccountCacheTag            string = "tagAccount:%d"
+	AccountListCacheTag        string = "tagAccount:list"
 	MustRevalidate             string = "must-revalidate"
+	GrantTypePassword          string = "password"
+	GrantTypeRefreshToken      string = "refresh_token"
+	GrantTypeClientCredentials string = "client_credentials"
+	GrantTypeAuthorizationCode string = "authorization_code"
+	GrantTypeMFAOTP            string = "mfa_otp"
 	RegExpEmail                string = `^[a-zA-Z0-9._+\-]+@[a-zA-Z0-9]+[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,10}$`
 )
 
 type Account struct {
-	ID    int64  `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID                int64     `json:"id"`
+	Name              string    `json:"name"`
+	Email             string    `json:"email"`
+	EmailVerifiedAt   time.Time `json:"email_verified_at"`
+	MFAEnabledAt      time.Time `json:"mfa_enabled_at"`
+	PasswordChangedAt time.Time `json:"password_changed_at"`
 	BaseInformation
 }
 
 type Login struct {
-	Email        string `json:"username"`
-	Password     string `json:"password"`
-	ClientID     string `json:"-"`
-	ClientSecret string `json:"-"`
+	GrantType      string `json:"grant_type"`
+	Email          string `json:"username"`
+	Password       string `json:"password"`
+	RefreshToken   string `json:"refresh_token"`
+	Scope          string `json:"scope"`
+	Code           string `json:"code"`
+	RedirectURI    string `json:"redirect_uri"`
+	CodeVerifier   string `json:"code_verifier"`
+	MFAToken       string `json:"mfa_token"`
+	OTP            string `json:"otp"`
+	OrganisationID int64  `json:"org_id"`
+	ClientID       string `json:"-"`
+	ClientSecret   string `json:"-"`
 }
 
 type Auth struct {
-	AccessToken string     `json:"access_token,omitempty"`
-	TokenType   string     `json:"token_type,omitempty"`
-	Exp         *time.Time `json:"exp,omitempty"`
-	Scope       string     `json:"scope,omitempty"`
+	AccessToken     string     `json:"access_token,omitempty"`
+	TokenType       string     `json:"token_type,omitempty"`
+	Exp             *time.Time `json:"exp,omitempty"`
+	Scope           string     `json:"scope,omitempty"`
+	OrganisationID  int64      `json:"org_id,omitempty"`
+	RefreshToken    string     `json:"refresh_token,omitempty"`
+	IDToken         string     `json:"id_token,omitempty"`
+	MFAToken        string     `json:"mfa_token,omitempty"`
+	MFARequired     bool       `json:"mfa_required,omitempty"`
+	Restriction     string     `json:"restriction,omitempty"`
+	PasswordExpired bool       `json:"password_expired,omitempty"`
 }
 
 func (l *Login) Validate() error {
+	switch l.GrantType {
+	case "", GrantTypePassword:
+		return l.validatePassword()
+	case GrantTypeRefreshToken:
+		if l.RefreshToken == "" {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, nil, "invalid empty refresh token")
+		}
+		return nil
+	case GrantTypeClientCredentials:
+		if l.ClientID == "" || l.ClientSecret == "" {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidClientIDClientSecret, nil, "invalid empty client id/client secret")
+		}
+		return nil
+	case GrantTypeAuthorizationCode:
+		if l.ClientID == "" {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidClientIDClientSecret, nil, "invalid empty client id")
+		}
+		if l.Code == "" || l.RedirectURI == "" {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidAuthorizationCode, nil, "invalid empty code/redirect uri")
+		}
+		if l.CodeVerifier == "" {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidCodeChallenge, nil, "invalid empty code verifier")
+		}
+		return nil
+	case GrantTypeMFAOTP:
+		if l.MFAToken == "" {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidMFAToken, nil, "invalid empty mfa token")
+		}
+		if l.OTP == "" {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidOTP, nil, "invalid empty otp")
+		}
+		return nil
+	}
+	return errormsg.WrapErr(svcerr.AccountSVCInvalidGrantType, nil, "unsupported grant type")
+}
+
+func (l *Login) validatePassword() error {
 	if l.Email == "" {
 		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmptyEmail, nil, "invalid empty name")
 	}
@@ -51,17 +115,11 @@ func (l *Login) Validate() error {
 		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmailFormat, nil, "invalid email format")
 	}
 
+	// the password policy only applies when a password is set, so older
+	// passwords keep working after the policy changes
 	if l.Password == "" {
 		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmptyPassword, nil, "invalid empty password")
 	}
-
-	if len(l.Password) < 8 {
-		return errormsg.WrapErr(svcerr.AccountSVCInvalidMinimumPassword, nil, "invalid minimum password")
-	}
-
-	if len(l.Password) > 20 {
-		return errormsg.WrapErr(svcerr.AccountSVCInvalidMaximumPassword, nil, "invalid maximum password")
-	}
 	return nil
 }
 
@@ -82,13 +140,6 @@ func (r *Register) Validate() error {
 		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmptyPassword, nil, "invalid empty password")
 	}
 
-	if len(r.Password) < 5 {
-		return errormsg.WrapErr(svcerr.AccountSVCInvalidMinimumPassword, nil, "invalid minimum password")
-	}
-
-	if len(r.Password) > 8 {
-		return errormsg.WrapErr(svcerr.AccountSVCInvalidMaximumPassword, nil, "invalid maximum password")
-	}
 	if r.Password != r.ConfirmPassword {
 		return errormsg.WrapErr(svcerr.AccountSVCInvalidPasswordConfirmation, nil, "invalid password confirmation")
 	}
@@ -117,13 +168,18 @@ func (g *GetAccountByParam) GetQuery() []qm.QueryMod {
 	return res
 }
 
+// GetAccountsByParam searches accounts. OrganisationID is not read from the
+// query, it is set from the token to keep store admins to their own store.
 type GetAccountsByParam struct {
 	GetAccountByParam
-	OrderBy null.String `schema:"order_by" json:"order_by"`
-	Limit   int64       `schema:"limit" json:"limit"`
-	Page    int64       `schema:"page" json:"page"`
+	OrganisationID null.Int64 `schema:"-" json:"organisation_id"`
+	PageParam
 }
 
+// accountOrderColumns leave out the password and mfa secret, the order of
+// the rows would tell about them.
+var accountOrderColumns = []string{"id", "email", "name", "created_at", "updated_at", "email_verified_at", "password_changed_at"}
+
 func (g *GetAccountsByParam) GetQuery() []qm.QueryMod {
 	var res []qm.QueryMod
 	if g.ID.Valid {
@@ -138,16 +194,26 @@ func (g *GetAccountsByParam) GetQuery() []qm.QueryMod {
 		res = append(res, qm.Where("name like ?", g.Name.String+"%"))
 	}
 
+	if g.OrganisationID.Valid {
+		res = append(res, qm.Where("id IN (SELECT account_id FROM organisation_members WHERE organisation_id=? AND deleted_at IS NULL)", g.OrganisationID.Int64))
+	}
+
 	if g.OrderBy.Valid {
-		order := strings.Split(g.OrderBy.String, ",")
-		for _, o := range order {
-			res = append(res, qm.OrderBy(o))
-		}
+		res = append(res, OrderBy(g.OrderBy.String, accountOrderColumns...)...)
 	}
 
 	return res
 }
 
+// ListTags tags the lists filtered by organisation with the organisation
+// member lists, since joining or leaving changes them.
+func (g *GetAccountsByParam) ListTags() []string {
+	if g.OrganisationID.Valid {
+		return []string{OrganisationMemberListCacheTag}
+	}
+	return nil
+}
+
 type GetAccounts struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
@@ -176,13 +242,6 @@ func (u *UpdatePasswordData) IsValid() error {
 		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmptyPassword, nil, "invalid empty password")
 	}
 
-	if len(u.Password) < 5 {
-		return errormsg.WrapErr(svcerr.AccountSVCInvalidMinimumPassword, nil, "invalid minimum password")
-	}
-
-	if len(u.Password) > 8 {
-		return errormsg.WrapErr(svcerr.AccountSVCInvalidMaximumPassword, nil, "invalid maximum password")
-	}
 	if u.Password != u.ConfirmPassword {
 		return errormsg.WrapErr(svcerr.AccountSVCInvalidPasswordConfirmation, nil, "invalid password confirmation")
 	}
@@ -200,10 +259,13 @@ func TransformPSQLSingleAccount(account *psqlmodel.Account) Account {
 	}
 
 	return Account{
-		ID:              int64(account.ID),
-		Name:            account.Name,
-		Email:           account.Email,
-		BaseInformation: creationInfo,
+		ID:                int64(account.ID),
+		Name:              account.Name,
+		Email:             account.Email,
+		EmailVerifiedAt:   account.EmailVerifiedAt.Time,
+		MFAEnabledAt:      account.MfaEnabledAt.Time,
+		PasswordChangedAt: account.PasswordChangedAt,
+		BaseInformation:   creationInfo,
 	}
 }
 
@@ -220,10 +282,13 @@ func TransformPSQLAccount(account *psqlmodel.AccountSlice) []Account {
 		}
 
 		res = append(res, Account{
-			ID:              int64(v.ID),
-			Name:            v.Name,
-			Email:           v.Email,
-			BaseInformation: creationInfo,
+			ID:                int64(v.ID),
+			Name:              v.Name,
+			Email:             v.Email,
+			EmailVerifiedAt:   v.EmailVerifiedAt.Time,
+			MFAEnabledAt:      v.MfaEnabledAt.Time,
+			PasswordChangedAt: v.PasswordChangedAt,
+			BaseInformation:   creationInfo,
 		})
 	}
 
@@ -1,7 +1,7 @@
 package model
 
 import (
-	"strings"
+	"time"
 
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
@@ -14,16 +14,48 @@ var (
 	GetSingleByParamAccountRoleKey string = "gspAccountRole:%s"
 	GetByParamAccountRoleKey       string = "gpAccountRole:%s"
 	GetByParamAccountRolePgKey     string = "gppgAccountRole:%s"
+	AccountRoleCacheTag            string = "tagAccountRole:%d"
+	AccountRoleListCacheTag        string = "tagAccountRole:list"
+	// accountRoleActiveQuery keeps the grants whose validity window holds
+	// now, grants without a bound are open on that side. Results filtered
+	// with it go stale as time passes, so they are checked again or read
+	// past the cache.
+	accountRoleActiveQuery string = "(valid_from is null or valid_from <= now()) and (valid_until is null or valid_until > now())"
+	// how often and in which batches grants past their validity window
+	// are expired
+	DefaultGrantExpiryInterval time.Duration = time.Minute
+	GrantExpiryBatchSize       int           = 100
 )
 
+// AccountRoleActive reports whether the validity window of accountRole
+// holds at.
+func AccountRoleActive(accountRole *psqlmodel.AccountRole, at time.Time) bool {
+	if accountRole.ValidFrom.Valid && at.Before(accountRole.ValidFrom.Time) {
+		return false
+	}
+	return !accountRole.ValidUntil.Valid || at.Before(accountRole.ValidUntil.Time)
+}
+
+// GetAccountRoleByParam looks up a single grant. Only grants whose validity
+// window holds are found, unless IncludeInactive is set for managing them.
 type GetAccountRoleByParam struct {
-	ID        null.Int64 `schema:"id" json:"id"`
-	AccountID null.Int64 `schema:"account_id" json:"account_id"`
-	RoleID    null.Int64 `schema:"role_id" json:"role_id"`
+	ID              null.Int64 `schema:"id" json:"id"`
+	AccountID       null.Int64 `schema:"account_id" json:"account_id"`
+	RoleID          null.Int64 `schema:"role_id" json:"role_id"`
+	OrganisationID  null.Int64 `schema:"organisation_id" json:"organisation_id"`
+	IncludeInactive bool       `schema:"-" json:"include_inactive"`
 }
 
 func (g *GetAccountRoleByParam) GetQuery() []qm.QueryMod {
 	var res []qm.QueryMod
+	if !g.IncludeInactive {
+		res = append(res, qm.Where(accountRoleActiveQuery))
+	}
+
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
 	if g.AccountID.Valid {
 		res = append(res, qm.Where("account_id=?", g.AccountID.Int64))
 	}
@@ -31,18 +63,31 @@ func (g *GetAccountRoleByParam) GetQuery() []qm.QueryMod {
 	if g.RoleID.Valid {
 		res = append(res, qm.Where("role_id=?", g.RoleID.Int64))
 	}
+
+	if g.OrganisationID.Valid {
+		res = append(res, qm.Where("organisation_id=?", g.OrganisationID.Int64))
+	}
 	return res
 }
 
+// GetAccountRolesByParam lists grants, including those outside their
+// validity window unless ActiveOnly is set. Within narrows them to the
+// global grants and those of the organisation it is set to, 0 for none.
 type GetAccountRolesByParam struct {
 	GetAccountRoleByParam
-	OrderBy null.String `schema:"order_by" json:"order_by"`
-	Limit   int64       `schema:"limit" json:"limit"`
-	Page    int64       `schema:"page" json:"page"`
+	ActiveOnly bool       `schema:"active_only" json:"active_only"`
+	Within     null.Int64 `schema:"-" json:"within"`
+	PageParam
 }
 
+var accountRoleOrderColumns = []string{"id", "account_id", "role_id", "organisation_id", "valid_from", "valid_until", "created_at", "updated_at"}
+
 func (g *GetAccountRolesByParam) GetQuery() []qm.QueryMod {
 	var res []qm.QueryMod
+	if g.ActiveOnly {
+		res = append(res, qm.Where(accountRoleActiveQuery))
+	}
+
 	if g.AccountID.Valid {
 		res = append(res, qm.Where("account_id=?", g.AccountID.Int64))
 	}
@@ -51,20 +96,36 @@ func (g *GetAccountRolesByParam) GetQuery() []qm.QueryMod {
 		res = append(res, qm.Where("role_id=?", g.RoleID.Int64))
 	}
 
-	if g.OrderBy.Valid {
-		order := strings.Split(g.OrderBy.String, ",")
-		for _, o := range order {
-			res = append(res, qm.OrderBy(o))
+	if g.OrganisationID.Valid {
+		res = append(res, qm.Where("organisation_id=?", g.OrganisationID.Int64))
+	}
+
+	if g.Within.Valid {
+		if g.Within.Int64 == 0 {
+			res = append(res, qm.Where("organisation_id IS NULL"))
+		} else {
+			res = append(res, qm.Where("(organisation_id IS NULL OR organisation_id=?)", g.Within.Int64))
 		}
 	}
 
+	if g.OrderBy.Valid {
+		res = append(res, OrderBy(g.OrderBy.String, accountRoleOrderColumns...)...)
+	}
+
 	return res
 }
 
+// CreateAccountRole grants a role to an account. With OrganisationID the
+// grant only applies within that organisation, which the account must be a
+// member of, and tokens issued through it carry the organisation. ValidFrom
+// and ValidUntil bound the grant in time, it is permanent without them.
 type CreateAccountRole struct {
-	AccountID int64 `json:"account_id"`
-	RoleID    int64 `json:"role_id"`
-	CreatedBy int64 `json:"-"`
+	AccountID      int64     `json:"account_id"`
+	RoleID         int64     `json:"role_id"`
+	OrganisationID int64     `json:"organisation_id"`
+	ValidFrom      null.Time `json:"valid_from"`
+	ValidUntil     null.Time `json:"valid_until"`
+	CreatedBy      int64     `json:"-"`
 }
 
 func (v *CreateAccountRole) Validate() error {
@@ -75,6 +136,16 @@ func (v *CreateAccountRole) Validate() error {
 	if v.RoleID == 0 {
 		return errormsg.WrapErr(svcerr.AccountSVCInvalidClientIDClientSecret, nil, "invalid role id")
 	}
+
+	if v.ValidUntil.Valid {
+		if !v.ValidUntil.Time.After(time.Now()) {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidGrantWindow, nil, "valid until in the past")
+		}
+
+		if v.ValidFrom.Valid && !v.ValidUntil.Time.After(v.ValidFrom.Time) {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidGrantWindow, nil, "valid until not after valid from")
+		}
+	}
 	return nil
 }
 
@@ -95,9 +166,12 @@ func (v *UpdateAccountRole) FillEntity(accountRole *psqlmodel.AccountRole) {
 }
 
 type AccountRole struct {
-	ID        int64 `json:"id"`
-	AccountID int64 `json:"account_id"`
-	RoleID    int64 `json:"role_id"`
+	ID             int64      `json:"id"`
+	AccountID      int64      `json:"account_id"`
+	RoleID         int64      `json:"role_id"`
+	OrganisationID int64      `json:"organisation_id,omitempty"`
+	ValidFrom      *time.Time `json:"valid_from,omitempty"`
+	ValidUntil     *time.Time `json:"valid_until,omitempty"`
 	BaseInformation
 }
 
@@ -115,6 +189,9 @@ func TransformPSQLSingleAccountRole(accountRole *psqlmodel.AccountRole) AccountR
 		ID:              int64(accountRole.ID),
 		AccountID:       int64(accountRole.AccountID),
 		RoleID:          int64(accountRole.RoleID),
+		OrganisationID:  int64(accountRole.OrganisationID.Int),
+		ValidFrom:       accountRole.ValidFrom.Ptr(),
+		ValidUntil:      accountRole.ValidUntil.Ptr(),
 		BaseInformation: creationInfo,
 	}
 }
@@ -135,6 +212,9 @@ func TransformPSQLAccountRole(role *psqlmodel.AccountRoleSlice) []AccountRole {
 			ID:              int64(v.ID),
 			AccountID:       int64(v.AccountID),
 			RoleID:          int64(v.RoleID),
+			OrganisationID:  int64(v.OrganisationID.Int),
+			ValidFrom:       v.ValidFrom.Ptr(),
+			ValidUntil:      v.ValidUntil.Ptr(),
 			BaseInformation: creationInfo,
 		})
 	}
@@ -0,0 +1,159 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// API keys look like APIKeyPrefix followed by a lookup prefix of
+// APIKeyLookupLength characters and the secret. Only the lookup prefix is
+// stored in the clear, the whole key is stored hashed.
+var (
+	APIKeyPrefix               string        = "crk_"
+	APIKeyLookupSize           int           = 6
+	APIKeyLookupLength         int           = 8
+	APIKeySecretSize           int           = 32
+	APIKeyMaxNameLength        int           = 100
+	DefaultAPIKeyExpiration    time.Duration = 90 * 24 * time.Hour
+	MaxAPIKeyExpiration        time.Duration = 365 * 24 * time.Hour
+	APIKeyLastUsedResolution   time.Duration = time.Minute
+	MaxAPIKeysPerAccount       int64         = 50
+	APIKeyAuthenticationMethod string        = "api_key"
+)
+
+// IsAPIKey reports whether the credential of an Authorization header is an
+// API key rather than a JWT.
+func IsAPIKey(credential string) bool {
+	return strings.HasPrefix(credential, APIKeyPrefix)
+}
+
+// APIKeyLookup returns the lookup prefix of key.
+func APIKeyLookup(key string) (string, bool) {
+	rest := strings.TrimPrefix(key, APIKeyPrefix)
+	if rest == key || len(rest) <= APIKeyLookupLength {
+		return "", false
+	}
+	return rest[:APIKeyLookupLength], true
+}
+
+type GetAPIKeyByParam struct {
+	ID        null.Int64  `schema:"id" json:"id"`
+	AccountID null.Int64  `schema:"-" json:"account_id"`
+	Prefix    null.String `schema:"prefix" json:"prefix"`
+}
+
+func (g *GetAPIKeyByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.AccountID.Valid {
+		res = append(res, qm.Where("account_id=?", g.AccountID.Int64))
+	}
+
+	if g.Prefix.Valid {
+		res = append(res, qm.Where("prefix=?", g.Prefix.String))
+	}
+	return res
+}
+
+type GetAPIKeysByParam struct {
+	GetAPIKeyByParam
+	PageParam
+}
+
+// apiKeyOrderColumns leave out the key hash, the order of the rows would
+// tell about it.
+var apiKeyOrderColumns = []string{"id", "name", "prefix", "scope", "expired_at", "last_used_at", "created_at", "updated_at"}
+
+func (g *GetAPIKeysByParam) GetQuery() []qm.QueryMod {
+	res := g.GetAPIKeyByParam.GetQuery()
+	if g.OrderBy.Valid {
+		res = append(res, OrderBy(g.OrderBy.String, apiKeyOrderColumns...)...)
+	}
+
+	return res
+}
+
+// CreateAPIKey names a new API key of the account. Scope lists the role
+// scopes of the account the key may use, all of them when empty. A zero
+// ExpiredAt falls back to the configured expiration.
+type CreateAPIKey struct {
+	Name      string    `json:"name"`
+	Scope     string    `json:"scope"`
+	ExpiredAt null.Time `json:"expired_at"`
+	AccountID int64     `json:"-"`
+}
+
+func (v *CreateAPIKey) Validate() error {
+	if v.Name == "" || len(v.Name) > APIKeyMaxNameLength {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidAPIKey, nil, "invalid name")
+	}
+
+	if v.ExpiredAt.Valid {
+		if !v.ExpiredAt.Time.After(time.Now()) {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidAPIKey, nil, "expiry in the past")
+		}
+
+		if time.Until(v.ExpiredAt.Time) > MaxAPIKeyExpiration {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidAPIKey, nil, "expiry too far in the future")
+		}
+	}
+	return nil
+}
+
+type APIKey struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name"`
+	Prefix     string    `json:"prefix"`
+	Scope      string    `json:"scope"`
+	Expired    bool      `json:"expired"`
+	ExpiredAt  time.Time `json:"expired_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	BaseInformation
+}
+
+// CreatedAPIKey is only returned when the key is created, Key cannot be
+// read again afterwards.
+type CreatedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+func TransformPSQLSingleAPIKey(apiKey *psqlmodel.APIKey) APIKey {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(apiKey.CreatedBy),
+		CreatedAt: apiKey.CreatedAt,
+		UpdatedBy: int64(apiKey.UpdatedBy),
+		UpdatedAt: apiKey.UpdatedAt,
+		DeletedBy: int64(apiKey.DeletedBy.Int),
+		DeletedAt: apiKey.DeletedAt.Time,
+	}
+
+	return APIKey{
+		ID:              int64(apiKey.ID),
+		Name:            apiKey.Name,
+		Prefix:          APIKeyPrefix + apiKey.Prefix,
+		Scope:           apiKey.Scope,
+		Expired:         !apiKey.ExpiredAt.After(time.Now()),
+		ExpiredAt:       apiKey.ExpiredAt,
+		LastUsedAt:      apiKey.LastUsedAt.Time,
+		BaseInformation: creationInfo,
+	}
+}
+
+func TransformPSQLAPIKey(apiKey *psqlmodel.APIKeySlice) []APIKey {
+	var res []APIKey
+	for _, v := range *apiKey {
+		res = append(res, TransformPSQLSingleAPIKey(v))
+	}
+
+	return res
+}
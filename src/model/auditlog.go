@@ -0,0 +1,83 @@
+package model
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Actions recorded in the audit log.
+var (
+	AuditActionImpersonationStart string = "impersonation.start"
+	AuditActionImpersonationStop  string = "impersonation.stop"
+)
+
+// GetAuditLogsByParam lists audit log entries. The log is never cached,
+// an entry shows up as soon as it is written.
+type GetAuditLogsByParam struct {
+	Action    null.String `schema:"action" json:"action"`
+	ActorID   null.Int64  `schema:"actor_id" json:"actor_id"`
+	SubjectID null.Int64  `schema:"subject_id" json:"subject_id"`
+	TokenID   null.String `schema:"token_id" json:"token_id"`
+	PageParam
+}
+
+var auditLogOrderColumns = []string{"id", "action", "actor_id", "subject_id", "created_at"}
+
+func (g *GetAuditLogsByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.Action.Valid {
+		res = append(res, qm.Where("action=?", g.Action.String))
+	}
+
+	if g.ActorID.Valid {
+		res = append(res, qm.Where("actor_id=?", g.ActorID.Int64))
+	}
+
+	if g.SubjectID.Valid {
+		res = append(res, qm.Where("subject_id=?", g.SubjectID.Int64))
+	}
+
+	if g.TokenID.Valid {
+		res = append(res, qm.Where("token_id=?", g.TokenID.String))
+	}
+
+	order := OrderBy(g.OrderBy.String, auditLogOrderColumns...)
+	if len(order) == 0 {
+		order = []qm.QueryMod{qm.OrderBy("created_at desc, id desc")}
+	}
+	res = append(res, order...)
+
+	return res
+}
+
+type AuditLog struct {
+	ID        int64     `json:"id"`
+	Action    string    `json:"action"`
+	ActorID   int64     `json:"actor_id"`
+	SubjectID int64     `json:"subject_id"`
+	TokenID   string    `json:"token_id,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	IPAddress string    `json:"ip_address,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func TransformPSQLAuditLog(auditLog *psqlmodel.AuditLogSlice) []AuditLog {
+	var res []AuditLog
+	for _, v := range *auditLog {
+		res = append(res, AuditLog{
+			ID:        int64(v.ID),
+			Action:    v.Action,
+			ActorID:   int64(v.ActorID),
+			SubjectID: int64(v.SubjectID),
+			TokenID:   v.TokenID,
+			Reason:    v.Reason,
+			IPAddress: v.IPAddress,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"regexp"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+)
+
+var (
+	AuthorizationCodeKey               string        = "authCode:%s"
+	DefaultAuthorizationCodeExpiration time.Duration = time.Minute
+	ResponseTypeCode                   string        = "code"
+	CodeChallengeMethodS256            string        = "S256"
+	AuthorizeEndpointPath              string        = "/authorize"
+	RegExpCodeVerifier                 string        = `^[A-Za-z0-9\-._~]{43,128}$`
+	// session cookie holding the CSRF token of the hosted authorize form
+	AuthorizeCSRFCookie    string = "authorize_csrf"
+	AuthorizeCSRFTokenSize int    = 32
+)
+
+var codeVerifierRegexp = regexp.MustCompile(RegExpCodeVerifier)
+
+// AuthorizeRequest holds the query parameters of /authorize. PKCE is
+// mandatory and only the S256 method is accepted.
+type AuthorizeRequest struct {
+	ResponseType        string `schema:"response_type"`
+	ClientID            string `schema:"client_id"`
+	RedirectURI         string `schema:"redirect_uri"`
+	Scope               string `schema:"scope"`
+	State               string `schema:"state"`
+	Nonce               string `schema:"nonce"`
+	CodeChallenge       string `schema:"code_challenge"`
+	CodeChallengeMethod string `schema:"code_challenge_method"`
+}
+
+func (a *AuthorizeRequest) Validate() error {
+	if a.ResponseType != ResponseTypeCode {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidResponseType, nil, "unsupported response type")
+	}
+
+	if a.CodeChallengeMethod != CodeChallengeMethodS256 {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidCodeChallenge, nil, "unsupported code challenge method")
+	}
+
+	// a S256 challenge is the unpadded base64url of a sha256 digest
+	if len(a.CodeChallenge) != 43 {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidCodeChallenge, nil, "invalid code challenge")
+	}
+	return nil
+}
+
+// Authorize is the login form posted back to /authorize.
+type Authorize struct {
+	AuthorizeRequest
+	Email     string `schema:"username"`
+	Password  string `schema:"password"`
+	Consent   string `schema:"consent"`
+	MFAToken  string `schema:"mfa_token"`
+	OTP       string `schema:"otp"`
+	CSRFToken string `schema:"csrf_token"`
+}
+
+// AuthorizeResult is the outcome of a login on the hosted page: either a
+// code for the client, or an MFA challenge that must be answered first.
+type AuthorizeResult struct {
+	Code     string
+	MFAToken string
+}
+
+// AuthorizationCode is what an issued code stands for until it is exchanged
+// on the token endpoint.
+type AuthorizationCode struct {
+	AccountID     int       `json:"account_id"`
+	RoleID        int       `json:"role_id"`
+	RedirectURI   string    `json:"redirect_uri"`
+	Scope         string    `json:"scope"`
+	Nonce         string    `json:"nonce"`
+	CodeChallenge string    `json:"code_challenge"`
+	AuthTime      time.Time `json:"auth_time"`
+}
+
+// VerifyCodeChallenge checks a PKCE code_verifier against the S256
+// code_challenge sent to /authorize (RFC 7636 section 4.6).
+func VerifyCodeChallenge(verifier, challenge string) bool {
+	if !codeVerifierRegexp.MatchString(verifier) {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
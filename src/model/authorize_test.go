@@ -0,0 +1,66 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	// RFC 7636 appendix B
+	const (
+		verifier  = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+		challenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+	)
+	tests := []struct {
+		name      string
+		verifier  string
+		challenge string
+		ok        bool
+	}{
+		{"rfc 7636 example", verifier, challenge, true},
+		{"mismatch", verifier[1:] + "A", challenge, false},
+		{"plain challenge", verifier, verifier, false},
+		{"padded challenge", verifier, challenge + "=", false},
+		{"empty challenge", verifier, "", false},
+		{"too short", verifier[:42], challenge, false},
+		{"too long", strings.Repeat("a", 129), challenge, false},
+		{"invalid characters", verifier[:42] + "+", challenge, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := VerifyCodeChallenge(tt.verifier, tt.challenge); ok != tt.ok {
+				t.Fatalf("got %v, want %v", ok, tt.ok)
+			}
+		})
+	}
+}
+
+func TestAuthorizeRequestValidateCodeChallenge(t *testing.T) {
+	const challenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+	tests := []struct {
+		name      string
+		method    string
+		challenge string
+		ok        bool
+	}{
+		{"s256", CodeChallengeMethodS256, challenge, true},
+		{"plain", "plain", challenge, false},
+		{"missing method", "", challenge, false},
+		{"missing challenge", CodeChallengeMethodS256, "", false},
+		{"not a sha256 digest", CodeChallengeMethodS256, challenge[:42], false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := AuthorizeRequest{
+				ResponseType:        ResponseTypeCode,
+				ClientID:            "cid",
+				RedirectURI:         "https://example.com/callback",
+				CodeChallenge:       tt.challenge,
+				CodeChallengeMethod: tt.method,
+			}
+			if err := v.Validate(); (err == nil) != tt.ok {
+				t.Fatalf("got %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
@@ -1,6 +1,13 @@
 package model
 
-import "time"
+import (
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
 
 var (
 	DefaultRedisExpiration time.Duration = 5 * time.Minute
@@ -23,3 +30,49 @@ type Pagination struct {
 	TotalElements   int64  `json:"total_elements"`
 	SortBy          string `json:"sort_by"`
 }
+
+// PageParam is the page and sort order asked of a list, embedded in every
+// list param.
+type PageParam struct {
+	OrderBy null.String `schema:"order_by" json:"order_by"`
+	Limit   int64       `schema:"limit" json:"limit"`
+	Page    int64       `schema:"page" json:"page"`
+}
+
+// Paging defaults the limit and page of p and returns them with the sort
+// order.
+func (p *PageParam) Paging(defaultLimit int64) (int64, int64, string) {
+	if p.Limit == 0 {
+		p.Limit = defaultLimit
+	}
+
+	if p.Page == 0 {
+		p.Page = 1
+	}
+	return p.Limit, p.Page, p.OrderBy.String
+}
+
+// OrderBy turns the comma separated order_by of a list param into order
+// clauses. A term is one of columns, optionally followed by asc or desc and
+// by nulls first or last. Other terms are dropped, order_by comes from the
+// request and must not reach the query as is.
+func OrderBy(orderBy string, columns ...string) []qm.QueryMod {
+	var res []qm.QueryMod
+	for _, term := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(strings.ToLower(term))
+		if len(fields) == 0 || !common.FindStrInSlice(fields[0], columns) {
+			continue
+		}
+		rest := fields[1:]
+		if len(rest) > 0 && (rest[0] == "asc" || rest[0] == "desc") {
+			rest = rest[1:]
+		}
+		if len(rest) == 2 && rest[0] == "nulls" && (rest[1] == "first" || rest[1] == "last") {
+			rest = rest[2:]
+		}
+		if len(rest) == 0 {
+			res = append(res, qm.OrderBy(strings.Join(fields, " ")))
+		}
+	}
+	return res
+}
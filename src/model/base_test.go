@@ -0,0 +1,57 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestOrderBy(t *testing.T) {
+	columns := []string{"id", "name", "organisation_id"}
+	tests := []struct {
+		orderBy string
+		want    []qm.QueryMod
+	}{
+		{"id", []qm.QueryMod{qm.OrderBy("id")}},
+		{"name DESC, id", []qm.QueryMod{qm.OrderBy("name desc"), qm.OrderBy("id")}},
+		{"organisation_id nulls first,id asc", []qm.QueryMod{qm.OrderBy("organisation_id nulls first"), qm.OrderBy("id asc")}},
+		{"organisation_id desc nulls last", []qm.QueryMod{qm.OrderBy("organisation_id desc nulls last")}},
+		{"password", nil},
+		{"id; DROP TABLE accounts", nil},
+		{"(SELECT 1)", nil},
+		{"id sideways", nil},
+		{"id desc nulls", nil},
+		{"name,password,id", []qm.QueryMod{qm.OrderBy("name"), qm.OrderBy("id")}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := OrderBy(tt.orderBy, columns...); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("OrderBy(%q) = %v, want %v", tt.orderBy, got, tt.want)
+		}
+	}
+}
+
+func TestPaging(t *testing.T) {
+	tests := []struct {
+		param PageParam
+		limit int64
+		page  int64
+	}{
+		{PageParam{}, 10, 1},
+		{PageParam{Limit: 3, Page: 2}, 3, 2},
+		{PageParam{OrderBy: null.NewString("id", true)}, 10, 1},
+	}
+	for _, tt := range tests {
+		// every list param pages through the embedded PageParam
+		param := GetAccountRolesByParam{PageParam: tt.param}
+		limit, page, orderBy := param.Paging(10)
+		if limit != tt.limit || page != tt.page || orderBy != tt.param.OrderBy.String {
+			t.Errorf("Paging(%+v) = %d, %d, %q", tt.param, limit, page, orderBy)
+		}
+		if param.Limit != limit || param.Page != page {
+			t.Errorf("Paging(%+v) left the param at %+v", tt.param, param.PageParam)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+var (
+	CacheLockKey string = "lockCache:%s"
+	// how long a cache lock is held at most, and how often the instances
+	// waiting on it check whether the entry was cached
+	DefaultCacheLockTimeout time.Duration = 2 * time.Second
+	CacheLockPollInterval   time.Duration = 25 * time.Millisecond
+	// early refresh scale, and load time assumed until a load was timed
+	DefaultEarlyRefreshBeta float64       = 1
+	DefaultCacheLoadTime    time.Duration = 10 * time.Millisecond
+	// how long a shared load may run apart from the requests waiting on it
+	DefaultCacheLoadTimeout time.Duration = 10 * time.Second
+	CacheMetricsPath        string        = "/metrics/cache"
+	// channel the invalidated cache tags are published to, and the
+	// bounds of the local caches
+	CacheInvalidationChannel string        = "cacheInvalidation"
+	DefaultLocalCacheSize    int           = 1000
+	DefaultLocalCacheTTL     time.Duration = 10 * time.Second
+	// consecutive redis failures opening the cache breaker, and how long
+	// it stays open before a call probes redis again
+	DefaultCacheBreakerThreshold int           = 5
+	DefaultCacheBreakerCooldown  time.Duration = 10 * time.Second
+	CacheHealthPath              string        = "/health/cache"
+	CacheHealthVar               string        = "cache_health"
+)
+
+const (
+	CacheBreakerClosed   string = "closed"
+	CacheBreakerOpen     string = "open"
+	CacheBreakerHalfOpen string = "half_open"
+	CacheStatusOK        string = "ok"
+	CacheStatusDegraded  string = "degraded"
+)
+
+// CacheHealth reports whether the caches are served from redis, or
+// degraded to reading through to psql while the breaker is not closed.
+type CacheHealth struct {
+	Status  string `json:"status"`
+	Breaker string `json:"breaker"`
+}
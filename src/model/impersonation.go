@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+)
+
+var (
+	DefaultImpersonationExpiration time.Duration = 15 * time.Minute
+)
+
+// Impersonate asks for a token of another account. Scope picks which role
+// of that account the token is issued for, the first granted role is used
+// when it is empty. The reason is kept in the audit log.
+type Impersonate struct {
+	Scope   string `json:"scope"`
+	Reason  string `json:"reason"`
+	ActorID int64  `json:"-"`
+}
+
+func (i *Impersonate) Validate() error {
+	if i.Reason == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidImpersonation, nil, "invalid empty reason")
+	}
+	return nil
+}
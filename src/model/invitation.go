@@ -0,0 +1,231 @@
+package model
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	GetSingleByParamInvitationKey string        = "gspInvitation:%s"
+	GetByParamInvitationKey       string        = "gpInvitation:%s"
+	GetByParamInvitationPgKey     string        = "gppgInvitation:%s"
+	InvitationCacheTag            string        = "tagInvitation:%d"
+	InvitationListCacheTag        string        = "tagInvitation:list"
+	JWTTypeInvitation             string        = "invite+jwt"
+	DefaultInvitationExpiration   time.Duration = 72 * time.Hour
+	MaxInvitationExpiration       time.Duration = 30 * 24 * time.Hour
+)
+
+// Status of an invitation. It is derived from its timestamps, so a pending
+// invitation turns expired on its own once expired_at has passed.
+var (
+	InvitationStatusPending  string   = "pending"
+	InvitationStatusAccepted string   = "accepted"
+	InvitationStatusRevoked  string   = "revoked"
+	InvitationStatusExpired  string   = "expired"
+	InvitationStatuses       []string = []string{InvitationStatusPending, InvitationStatusAccepted, InvitationStatusRevoked, InvitationStatusExpired}
+)
+
+// GetInvitationByParam looks up an invitation of staff to a role, and to an
+// organisation if one is set.
+type GetInvitationByParam struct {
+	ID             null.Int64  `schema:"id" json:"id"`
+	Email          null.String `schema:"email" json:"email"`
+	RoleID         null.Int64  `schema:"role_id" json:"role_id"`
+	OrganisationID null.Int64  `schema:"organisation_id" json:"organisation_id"`
+	Status         null.String `schema:"status" json:"status"`
+}
+
+func (g *GetInvitationByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.Email.Valid {
+		res = append(res, qm.Where("email=?", g.Email.String))
+	}
+
+	if g.RoleID.Valid {
+		res = append(res, qm.Where("role_id=?", g.RoleID.Int64))
+	}
+
+	if g.OrganisationID.Valid {
+		res = append(res, qm.Where("organisation_id=?", g.OrganisationID.Int64))
+	}
+
+	if g.Status.Valid {
+		switch g.Status.String {
+		case InvitationStatusPending:
+			res = append(res, qm.Where("accepted_at is null and revoked_at is null and expired_at>?", time.Now()))
+		case InvitationStatusAccepted:
+			res = append(res, qm.Where("accepted_at is not null"))
+		case InvitationStatusRevoked:
+			res = append(res, qm.Where("accepted_at is null and revoked_at is not null"))
+		case InvitationStatusExpired:
+			res = append(res, qm.Where("accepted_at is null and revoked_at is null and expired_at<=?", time.Now()))
+		}
+	}
+	return res
+}
+
+type GetInvitationsByParam struct {
+	GetInvitationByParam
+	PageParam
+}
+
+func (g *GetInvitationsByParam) Validate() error {
+	if g.Status.Valid && !common.FindStrInSlice(g.Status.String, InvitationStatuses) {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, nil, "invalid invitation status")
+	}
+	return nil
+}
+
+var invitationOrderColumns = []string{"id", "email", "role_id", "organisation_id", "organisation_role", "account_id", "expired_at", "accepted_at", "revoked_at", "created_at", "updated_at"}
+
+func (g *GetInvitationsByParam) GetQuery() []qm.QueryMod {
+	res := g.GetInvitationByParam.GetQuery()
+	if g.OrderBy.Valid {
+		res = append(res, OrderBy(g.OrderBy.String, invitationOrderColumns...)...)
+	}
+
+	return res
+}
+
+// CreateInvitation invites email to role. With an organisation the role
+// is granted within it and the invitee joins it as OrganisationRole. A
+// zero ExpiredAt falls back to the configured expiration.
+type CreateInvitation struct {
+	Email            string    `json:"email"`
+	RoleID           int64     `json:"role_id"`
+	OrganisationID   int64     `json:"organisation_id"`
+	OrganisationRole string    `json:"organisation_role"`
+	ExpiredAt        null.Time `json:"expired_at"`
+	CreatedBy        int64     `json:"-"`
+}
+
+func (v *CreateInvitation) Validate() error {
+	if v.Email == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmptyEmail, nil, "invalid empty email")
+	}
+
+	rg := regexp.MustCompile(RegExpEmail)
+	if !rg.MatchString(v.Email) {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmailFormat, nil, "invalid email format")
+	}
+
+	if v.RoleID == 0 {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, nil, "invalid role id")
+	}
+
+	if v.ExpiredAt.Valid {
+		if !v.ExpiredAt.Time.After(time.Now()) {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, nil, "expiry in the past")
+		}
+
+		if time.Until(v.ExpiredAt.Time) > MaxInvitationExpiration {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, nil, "expiry too far in the future")
+		}
+	}
+
+	if v.OrganisationID == 0 {
+		if v.OrganisationRole != "" {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidOrganisation, nil, "organisation role without organisation")
+		}
+		return nil
+	}
+	return ValidateOrganisationRole(v.OrganisationRole)
+}
+
+// AcceptInvitation is sent by the invitee with the token from the
+// invitation mail and the name and password of the new account.
+type AcceptInvitation struct {
+	Token           string `json:"token"`
+	Name            string `json:"name"`
+	Password        string `json:"password"`
+	ConfirmPassword string `json:"confirm_password"`
+}
+
+func (a *AcceptInvitation) Validate() error {
+	if a.Token == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, nil, "invalid empty token")
+	}
+
+	if a.Name == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmptyName, nil, "invalid empty name")
+	}
+
+	data := UpdatePasswordData{
+		Password:        a.Password,
+		ConfirmPassword: a.ConfirmPassword,
+	}
+	return data.IsValid()
+}
+
+type Invitation struct {
+	ID               int64     `json:"id"`
+	Email            string    `json:"email"`
+	RoleID           int64     `json:"role_id"`
+	OrganisationID   int64     `json:"organisation_id,omitempty"`
+	OrganisationRole string    `json:"organisation_role,omitempty"`
+	AccountID        int64     `json:"account_id,omitempty"`
+	Status           string    `json:"status"`
+	ExpiredAt        time.Time `json:"expired_at"`
+	AcceptedAt       time.Time `json:"accepted_at"`
+	RevokedAt        time.Time `json:"revoked_at"`
+	BaseInformation
+}
+
+// InvitationStatus returns the status of invitation at now.
+func InvitationStatus(invitation *psqlmodel.Invitation, now time.Time) string {
+	switch {
+	case invitation.AcceptedAt.Valid:
+		return InvitationStatusAccepted
+	case invitation.RevokedAt.Valid:
+		return InvitationStatusRevoked
+	case !invitation.ExpiredAt.After(now):
+		return InvitationStatusExpired
+	}
+	return InvitationStatusPending
+}
+
+func TransformPSQLSingleInvitation(invitation *psqlmodel.Invitation) Invitation {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(invitation.CreatedBy),
+		CreatedAt: invitation.CreatedAt,
+		UpdatedBy: int64(invitation.UpdatedBy),
+		UpdatedAt: invitation.UpdatedAt,
+		DeletedBy: int64(invitation.DeletedBy.Int),
+		DeletedAt: invitation.DeletedAt.Time,
+	}
+
+	return Invitation{
+		ID:               int64(invitation.ID),
+		Email:            invitation.Email,
+		RoleID:           int64(invitation.RoleID),
+		OrganisationID:   int64(invitation.OrganisationID.Int),
+		OrganisationRole: invitation.OrganisationRole,
+		AccountID:        int64(invitation.AccountID.Int),
+		Status:           InvitationStatus(invitation, time.Now()),
+		ExpiredAt:        invitation.ExpiredAt,
+		AcceptedAt:       invitation.AcceptedAt.Time,
+		RevokedAt:        invitation.RevokedAt.Time,
+		BaseInformation:  creationInfo,
+	}
+}
+
+func TransformPSQLInvitation(invitation *psqlmodel.InvitationSlice) []Invitation {
+	var res []Invitation
+	for _, v := range *invitation {
+		res = append(res, TransformPSQLSingleInvitation(v))
+	}
+
+	return res
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	LoginFailureKey             string        = "loginFailure:%s"
+	LoginDelayKey               string        = "loginDelay:%s"
+	LoginLockKey                string        = "loginLock:%s"
+	LoginSubjectAccount         string        = "account:%s"
+	LoginSubjectIP              string        = "ip:%s"
+	DefaultLoginMaxFailures     int64         = 5
+	DefaultLoginMaxIPFailures   int64         = 50
+	DefaultLoginFailureWindow   time.Duration = 15 * time.Minute
+	DefaultLoginLockoutDuration time.Duration = 15 * time.Minute
+	DefaultLoginDelayStep       time.Duration = time.Second
+	DefaultLoginMaxDelay        time.Duration = 30 * time.Second
+)
+
+// LoginAttemptStatus tells how long logins for a subject stay refused,
+// either because of the delay after the last failure or a lockout.
+type LoginAttemptStatus struct {
+	DelayedFor time.Duration
+	LockedFor  time.Duration
+}
+
+// LoginSubjectForAccount keys login failures by email rather than account
+// id, so attempts against unknown emails are counted the same way.
+func LoginSubjectForAccount(email string) string {
+	return fmt.Sprintf(LoginSubjectAccount, strings.ToLower(email))
+}
+
+func LoginSubjectForIP(ip string) string {
+	return fmt.Sprintf(LoginSubjectIP, ip)
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+)
+
+var (
+	JWTTypeMFAChallenge       string        = "mfa+jwt"
+	MFAAttemptKey             string        = "mfaAttempt:%s"
+	TOTPUsedKey               string        = "totpUsed:%d:%d"
+	DefaultMFAIssuer          string        = "CarRent"
+	DefaultMFATokenExpiration time.Duration = 5 * time.Minute
+	DefaultMFAMaxAttempts     int64         = 5
+	RecoveryCodeCount         int           = 10
+)
+
+// MFAEnrollment is returned when TOTP enrolment starts. OtpauthURI is the
+// payload to render as a QR code for authenticator apps.
+type MFAEnrollment struct {
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauth_uri"`
+}
+
+type MFACode struct {
+	Code string `json:"code"`
+}
+
+func (m *MFACode) Validate() error {
+	if m.Code == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidOTP, nil, "invalid empty code")
+	}
+	return nil
+}
+
+// RecoveryCodes holds freshly generated recovery codes. They are stored
+// hashed, so this is the only time they can be shown.
+type RecoveryCodes struct {
+	Codes []string `json:"recovery_codes"`
+}
+
+// NormalizeRecoveryCode strips the separator and case a user may type a
+// recovery code with.
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ScopeOpenID                        string = "openid"
+	ScopeProfile                       string = "profile"
+	ScopeEmail                         string = "email"
+	SubjectTypePublic                  string = "public"
+	ClientAuthSecretBasic              string = "client_secret_basic"
+	ClientAuthNone                     string = "none"
+	OAuth2ErrorInvalidToken            string = "invalid_token"
+	OAuth2ErrorInvalidRequest          string = "invalid_request"
+	OAuth2ErrorAccessDenied            string = "access_denied"
+	OAuth2ErrorUnsupportedResponseType string = "unsupported_response_type"
+	OAuth2ErrorServerError             string = "server_error"
+	OAuth2ErrorInvalidClient           string = "invalid_client"
+	OAuth2ErrorInvalidGrant            string = "invalid_grant"
+	OAuth2ErrorInvalidScope            string = "invalid_scope"
+	OAuth2ErrorUnsupportedGrantType    string = "unsupported_grant_type"
+	OAuth2ErrorMFARequired             string = "mfa_required"
+	OpenIDConfigurationPath            string = "/.well-known/openid-configuration"
+	JWKSPath                           string = "/.well-known/jwks.json"
+	TokenEndpointPath                  string = "/api/oauth2/token"
+	UserInfoEndpointPath               string = "/api/userinfo"
+	OpenIDSupportedScopes                     = []string{ScopeOpenID, ScopeProfile, ScopeEmail}
+	OpenIDSupportedClaims                     = []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "name", "email", "email_verified"}
+	OpenIDSupportedGrantTypes                 = []string{GrantTypeAuthorizationCode, GrantTypePassword, GrantTypeRefreshToken, GrantTypeClientCredentials, GrantTypeMFAOTP}
+	OpenIDSupportedResponseTypes              = []string{ResponseTypeCode}
+)
+
+// OpenIDConfiguration is the provider metadata served at
+// /.well-known/openid-configuration (OpenID Connect Discovery 1.0).
+type OpenIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+}
+
+// UserInfo is the standard claim set returned by the userinfo endpoint.
+type UserInfo struct {
+	Sub           string `json:"sub"`
+	Name          string `json:"name,omitempty"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified"`
+	UpdatedAt     int64  `json:"updated_at,omitempty"`
+}
+
+func TransformUserInfo(account Account) UserInfo {
+	return UserInfo{
+		Sub:           strconv.FormatInt(account.ID, 10),
+		Name:          account.Name,
+		Email:         account.Email,
+		EmailVerified: !account.EmailVerifiedAt.IsZero(),
+		UpdatedAt:     account.UpdatedAt.Unix(),
+	}
+}
+
+// OAuth2Error is the error body defined by RFC 6749 section 5.2, used by
+// endpoints that must answer in the OAuth2 format rather than Response.
+type OAuth2Error struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+	MFAToken         string `json:"mfa_token,omitempty"`
+}
+
+// TokenResponse is the token endpoint response of RFC 6749 section 5.1.
+// IDToken is added by OpenID Connect, OrganisationID, Restriction and
+// PasswordExpired are extensions describing the token issued.
+type TokenResponse struct {
+	AccessToken     string `json:"access_token"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in,omitempty"`
+	RefreshToken    string `json:"refresh_token,omitempty"`
+	Scope           string `json:"scope,omitempty"`
+	IDToken         string `json:"id_token,omitempty"`
+	OrganisationID  int64  `json:"org_id,omitempty"`
+	Restriction     string `json:"restriction,omitempty"`
+	PasswordExpired bool   `json:"password_expired,omitempty"`
+}
+
+func TransformTokenResponse(auth Auth) TokenResponse {
+	res := TokenResponse{
+		AccessToken:     auth.AccessToken,
+		TokenType:       auth.TokenType,
+		RefreshToken:    auth.RefreshToken,
+		Scope:           auth.Scope,
+		IDToken:         auth.IDToken,
+		OrganisationID:  auth.OrganisationID,
+		Restriction:     auth.Restriction,
+		PasswordExpired: auth.PasswordExpired,
+	}
+	if auth.Exp != nil {
+		res.ExpiresIn = int64(time.Until(*auth.Exp).Round(time.Second).Seconds())
+	}
+	return res
+}
+
+// HasScope reports whether the space-delimited scope list contains scope.
+func HasScope(scopes, scope string) bool {
+	for _, s := range strings.Fields(scopes) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
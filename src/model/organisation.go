@@ -0,0 +1,171 @@
+package model
+
+import (
+	"regexp"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	GetSingleByParamOrganisationKey string = "gspOrganisation:%s"
+	GetByParamOrganisationKey       string = "gpOrganisation:%s"
+	GetByParamOrganisationPgKey     string = "gppgOrganisation:%s"
+	OrganisationCacheTag            string = "tagOrganisation:%d"
+	OrganisationListCacheTag        string = "tagOrganisation:list"
+	RegExpOrganisationCode          string = `^[A-Za-z0-9][A-Za-z0-9-]{1,49}$`
+)
+
+// GetOrganisationByParam looks up an organisation, a car rental store.
+// Accounts join them as members and roles can be granted within one of
+// them, see OrganisationMember.
+type GetOrganisationByParam struct {
+	ID   null.Int64  `schema:"id" json:"id"`
+	Code null.String `schema:"code" json:"code"`
+	Name null.String `schema:"name" json:"name"`
+}
+
+func (g *GetOrganisationByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.Code.Valid {
+		res = append(res, qm.Where("code=?", g.Code.String))
+	}
+
+	if g.Name.Valid {
+		res = append(res, qm.Where("name=?", g.Name.String))
+	}
+	return res
+}
+
+type GetOrganisationsByParam struct {
+	GetOrganisationByParam
+	PageParam
+}
+
+var organisationOrderColumns = []string{"id", "code", "name", "created_at", "updated_at"}
+
+func (g *GetOrganisationsByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.Code.Valid {
+		res = append(res, qm.Where("code=?", g.Code.String))
+	}
+
+	if g.Name.Valid {
+		res = append(res, qm.Where("name like ?", g.Name.String+"%"))
+	}
+
+	if g.OrderBy.Valid {
+		res = append(res, OrderBy(g.OrderBy.String, organisationOrderColumns...)...)
+	}
+
+	return res
+}
+
+type CreateOrganisation struct {
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	CreatedBy int64  `json:"-"`
+}
+
+func (v *CreateOrganisation) Validate() error {
+	if v.Name == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidOrganisation, nil, "invalid empty name")
+	}
+	return ValidateOrganisationCode(v.Code)
+}
+
+type UpdateOrganisation struct {
+	Code      null.String `json:"code"`
+	Name      null.String `json:"name"`
+	UpdatedBy int64       `json:"-"`
+}
+
+func (v *UpdateOrganisation) Validate() error {
+	if v.Name.Valid && v.Name.String == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidOrganisation, nil, "invalid empty name")
+	}
+
+	if v.Code.Valid {
+		return ValidateOrganisationCode(v.Code.String)
+	}
+	return nil
+}
+
+func (v *UpdateOrganisation) FillEntity(organisation *psqlmodel.Organisation) {
+	if v.Code.Valid {
+		organisation.Code = v.Code.String
+	}
+
+	if v.Name.Valid {
+		organisation.Name = v.Name.String
+	}
+}
+
+// ValidateOrganisationCode checks the short code a store is known by, like
+// JKT-01.
+func ValidateOrganisationCode(code string) error {
+	rg := regexp.MustCompile(RegExpOrganisationCode)
+	if !rg.MatchString(code) {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidOrganisation, nil, "invalid organisation code")
+	}
+	return nil
+}
+
+type Organisation struct {
+	ID   int64  `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+	BaseInformation
+}
+
+func TransformPSQLSingleOrganisation(organisation *psqlmodel.Organisation) Organisation {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(organisation.CreatedBy),
+		CreatedAt: organisation.CreatedAt,
+		UpdatedBy: int64(organisation.UpdatedBy),
+		UpdatedAt: organisation.UpdatedAt,
+		DeletedBy: int64(organisation.DeletedBy.Int),
+		DeletedAt: organisation.DeletedAt.Time,
+	}
+
+	return Organisation{
+		ID:              int64(organisation.ID),
+		Code:            organisation.Code,
+		Name:            organisation.Name,
+		BaseInformation: creationInfo,
+	}
+}
+
+func TransformPSQLOrganisation(organisation *psqlmodel.OrganisationSlice) []Organisation {
+	var res []Organisation
+	for _, v := range *organisation {
+		creationInfo := BaseInformation{
+			CreatedBy: int64(v.CreatedBy),
+			CreatedAt: v.CreatedAt,
+			UpdatedBy: int64(v.UpdatedBy),
+			UpdatedAt: v.UpdatedAt,
+			DeletedBy: int64(v.DeletedBy.Int),
+			DeletedAt: v.DeletedAt.Time,
+		}
+
+		res = append(res, Organisation{
+			ID:              int64(v.ID),
+			Code:            v.Code,
+			Name:            v.Name,
+			BaseInformation: creationInfo,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,163 @@
+package model
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	GetSingleByParamOrganisationMemberKey string = "gspOrganisationMember:%s"
+	GetByParamOrganisationMemberKey       string = "gpOrganisationMember:%s"
+	GetByParamOrganisationMemberPgKey     string = "gppgOrganisationMember:%s"
+	OrganisationMemberCacheTag            string = "tagOrganisationMember:%d"
+	OrganisationMemberListCacheTag        string = "tagOrganisationMember:list"
+)
+
+// Roles of a member within its organisation. Admins manage the members of
+// their store and see its accounts, staff only belong to it.
+var (
+	OrganisationRoleAdmin string   = "admin"
+	OrganisationRoleStaff string   = "staff"
+	OrganisationRoles     []string = []string{OrganisationRoleAdmin, OrganisationRoleStaff}
+)
+
+type GetOrganisationMemberByParam struct {
+	ID             null.Int64  `schema:"id" json:"id"`
+	OrganisationID null.Int64  `schema:"organisation_id" json:"organisation_id"`
+	AccountID      null.Int64  `schema:"account_id" json:"account_id"`
+	Role           null.String `schema:"role" json:"role"`
+}
+
+func (g *GetOrganisationMemberByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.OrganisationID.Valid {
+		res = append(res, qm.Where("organisation_id=?", g.OrganisationID.Int64))
+	}
+
+	if g.AccountID.Valid {
+		res = append(res, qm.Where("account_id=?", g.AccountID.Int64))
+	}
+
+	if g.Role.Valid {
+		res = append(res, qm.Where("role=?", g.Role.String))
+	}
+	return res
+}
+
+type GetOrganisationMembersByParam struct {
+	GetOrganisationMemberByParam
+	PageParam
+}
+
+var organisationMemberOrderColumns = []string{"id", "organisation_id", "account_id", "role", "created_at", "updated_at"}
+
+func (g *GetOrganisationMembersByParam) GetQuery() []qm.QueryMod {
+	res := g.GetOrganisationMemberByParam.GetQuery()
+	if g.OrderBy.Valid {
+		res = append(res, OrderBy(g.OrderBy.String, organisationMemberOrderColumns...)...)
+	}
+
+	return res
+}
+
+type CreateOrganisationMember struct {
+	OrganisationID int64  `json:"organisation_id"`
+	AccountID      int64  `json:"account_id"`
+	Role           string `json:"role"`
+	CreatedBy      int64  `json:"-"`
+}
+
+func (v *CreateOrganisationMember) Validate() error {
+	if v.OrganisationID == 0 {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidOrganisation, nil, "invalid organisation id")
+	}
+
+	if v.AccountID == 0 {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "invalid account id")
+	}
+	return ValidateOrganisationRole(v.Role)
+}
+
+type UpdateOrganisationMember struct {
+	Role      null.String `json:"role"`
+	UpdatedBy int64       `json:"-"`
+}
+
+func (v *UpdateOrganisationMember) Validate() error {
+	if v.Role.Valid {
+		return ValidateOrganisationRole(v.Role.String)
+	}
+	return nil
+}
+
+func (v *UpdateOrganisationMember) FillEntity(member *psqlmodel.OrganisationMember) {
+	if v.Role.Valid {
+		member.Role = v.Role.String
+	}
+}
+
+func ValidateOrganisationRole(role string) error {
+	if !common.FindStrInSlice(role, OrganisationRoles) {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidOrganisation, nil, "invalid organisation role")
+	}
+	return nil
+}
+
+type OrganisationMember struct {
+	ID             int64  `json:"id"`
+	OrganisationID int64  `json:"organisation_id"`
+	AccountID      int64  `json:"account_id"`
+	Role           string `json:"role"`
+	BaseInformation
+}
+
+func TransformPSQLSingleOrganisationMember(member *psqlmodel.OrganisationMember) OrganisationMember {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(member.CreatedBy),
+		CreatedAt: member.CreatedAt,
+		UpdatedBy: int64(member.UpdatedBy),
+		UpdatedAt: member.UpdatedAt,
+		DeletedBy: int64(member.DeletedBy.Int),
+		DeletedAt: member.DeletedAt.Time,
+	}
+
+	return OrganisationMember{
+		ID:              int64(member.ID),
+		OrganisationID:  int64(member.OrganisationID),
+		AccountID:       int64(member.AccountID),
+		Role:            member.Role,
+		BaseInformation: creationInfo,
+	}
+}
+
+func TransformPSQLOrganisationMember(member *psqlmodel.OrganisationMemberSlice) []OrganisationMember {
+	var res []OrganisationMember
+	for _, v := range *member {
+		creationInfo := BaseInformation{
+			CreatedBy: int64(v.CreatedBy),
+			CreatedAt: v.CreatedAt,
+			UpdatedBy: int64(v.UpdatedBy),
+			UpdatedAt: v.UpdatedAt,
+			DeletedBy: int64(v.DeletedBy.Int),
+			DeletedAt: v.DeletedAt.Time,
+		}
+
+		res = append(res, OrganisationMember{
+			ID:              int64(v.ID),
+			OrganisationID:  int64(v.OrganisationID),
+			AccountID:       int64(v.AccountID),
+			Role:            v.Role,
+			BaseInformation: creationInfo,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+)
+
+var (
+	PasswordResetKey               string        = "passwordReset:%s"
+	PasswordForgotKey              string        = "passwordForgot:%s"
+	DefaultPasswordResetExpiration time.Duration = time.Hour
+	DefaultPasswordForgotInterval  time.Duration = time.Minute
+	DefaultPasswordHistorySize     int           = 5
+)
+
+type ForgotPassword struct {
+	Email string `json:"email"`
+}
+
+func (f *ForgotPassword) Validate() error {
+	if f.Email == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmptyEmail, nil, "invalid empty email")
+	}
+
+	rg := regexp.MustCompile(RegExpEmail)
+	if !rg.MatchString(f.Email) {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmailFormat, nil, "invalid email format")
+	}
+	return nil
+}
+
+type ResetPassword struct {
+	Token           string `json:"token"`
+	Password        string `json:"password"`
+	ConfirmPassword string `json:"confirm_password"`
+}
+
+func (r *ResetPassword) Validate() error {
+	if r.Token == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidResetToken, nil, "invalid empty token")
+	}
+
+	data := UpdatePasswordData{
+		Password:        r.Password,
+		ConfirmPassword: r.ConfirmPassword,
+	}
+	return data.IsValid()
+}
+
+// PasswordReset is what a reset token stands for until it is used. The
+// email is kept so a token stops working when the account's email changes,
+// and the issue time so it stops working once the password changes.
+type PasswordReset struct {
+	AccountID int       `json:"account_id"`
+	Email     string    `json:"email"`
+	IssuedAt  time.Time `json:"issued_at"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+const (
+	PasswordHashArgon2id string = "argon2id"
+	PasswordHashBcrypt   string = "bcrypt"
+)
+
+var (
+	// argon2id defaults follow the second recommended option of RFC 9106
+	DefaultPasswordHashAlgorithm   string        = PasswordHashArgon2id
+	DefaultArgon2Memory            uint32        = 64 * 1024
+	DefaultArgon2Iterations        uint32        = 3
+	DefaultArgon2Parallelism       uint8         = 4
+	DefaultArgon2SaltLength        uint32        = 16
+	DefaultArgon2KeyLength         uint32        = 32
+	DefaultBcryptCost              int           = 10
+	DefaultPasswordHashCalibration time.Duration = 250 * time.Millisecond
+)
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+)
+
+var (
+	// bcrypt ignores everything after 72 bytes, keep the default well below
+	DefaultPasswordMinLength int = 8
+	DefaultPasswordMaxLength int = 64
+)
+
+// PasswordCandidate is a password to check against the password policy,
+// with the account details it must not contain.
+type PasswordCandidate struct {
+	Password string
+	Email    string
+	Name     string
+}
+
+// PasswordViolation is one rule of the password policy that a password
+// breaks, described by the svcerr message of that rule.
+type PasswordViolation struct {
+	Code        int64       `json:"code"`
+	Message     string      `json:"message"`
+	Translation Translation `json:"translation"`
+}
+
+func NewPasswordViolation(msg errormsg.Message) PasswordViolation {
+	return PasswordViolation{
+		Code:        msg.Code,
+		Message:     msg.Message,
+		Translation: Translation(msg.Translation),
+	}
+}
+
+// PasswordViolations is carried as the debug error of the wrapped error the
+// policy returns, which is how responses find the full list.
+type PasswordViolations []PasswordViolation
+
+func (p PasswordViolations) Error() string {
+	var msgs []string
+	for _, v := range p {
+		msgs = append(msgs, v.Translation.EN)
+	}
+	return strings.Join(msgs, " ")
+}
+
+// Err wraps the violations in the svcerr message of the first one, or
+// returns nil when there are none.
+func (p PasswordViolations) Err() error {
+	if len(p) == 0 {
+		return nil
+	}
+	return errormsg.WrapErr(svcerr.ErrMsg[int(p[0].Code)], p, "password policy violated")
+}
+
+// GetPasswordViolations returns the violations carried by err, if any.
+func GetPasswordViolations(err error) PasswordViolations {
+	errData, ok := err.(*errormsg.ErrorMsg)
+	if !ok {
+		return nil
+	}
+	violations, _ := errData.DebugError.(PasswordViolations)
+	return violations
+}
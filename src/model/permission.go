@@ -0,0 +1,178 @@
+package model
+
+import (
+	"regexp"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	GetSingleByParamPermissionKey string = "gspPermission:%s"
+	GetByParamPermissionKey       string = "gpPermission:%s"
+	GetByParamPermissionPgKey     string = "gppgPermission:%s"
+	PermissionCacheTag            string = "tagPermission:%d"
+	PermissionListCacheTag        string = "tagPermission:list"
+	RegExpPermission              string = `^[a-z][a-z-]*:[a-z][a-z-]*$`
+)
+
+// Permissions checked by the routes of this service. They are seeded by the
+// migrations and granted to the sup role, other permissions can be created
+// through the api for other services to check.
+var (
+	PermissionAccountRead        string = "account:read"
+	PermissionAccountWrite       string = "account:write"
+	PermissionAccountDelete      string = "account:delete"
+	PermissionAccountUnlock      string = "account:unlock"
+	PermissionAccountImpersonate string = "account:impersonate"
+	PermissionRoleRead           string = "role:read"
+	PermissionRoleWrite          string = "role:write"
+	PermissionRoleDelete         string = "role:delete"
+	PermissionAccountRoleRead    string = "account-role:read"
+	PermissionAccountRoleWrite   string = "account-role:write"
+	PermissionAccountRoleDelete  string = "account-role:delete"
+	PermissionPermissionRead     string = "permission:read"
+	PermissionPermissionWrite    string = "permission:write"
+	PermissionPermissionDelete   string = "permission:delete"
+	PermissionOrganisationRead   string = "organisation:read"
+	PermissionOrganisationWrite  string = "organisation:write"
+	PermissionOrganisationDelete string = "organisation:delete"
+	PermissionInvitationRead     string = "invitation:read"
+	PermissionInvitationWrite    string = "invitation:write"
+	PermissionAuditLogRead       string = "audit-log:read"
+)
+
+type GetPermissionByParam struct {
+	ID   null.Int64  `schema:"id" json:"id"`
+	Name null.String `schema:"name" json:"name"`
+}
+
+func (g *GetPermissionByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.Name.Valid {
+		res = append(res, qm.Where("name=?", g.Name.String))
+	}
+	return res
+}
+
+type GetPermissionsByParam struct {
+	GetPermissionByParam
+	PageParam
+}
+
+var permissionOrderColumns = []string{"id", "name", "created_at", "updated_at"}
+
+func (g *GetPermissionsByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.Name.Valid {
+		res = append(res, qm.Where("name like ?", g.Name.String+"%"))
+	}
+
+	if g.OrderBy.Valid {
+		res = append(res, OrderBy(g.OrderBy.String, permissionOrderColumns...)...)
+	}
+
+	return res
+}
+
+type CreatePermission struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreatedBy   int64  `json:"-"`
+}
+
+func (v *CreatePermission) Validate() error {
+	return ValidatePermissionName(v.Name)
+}
+
+type UpdatePermission struct {
+	Name        null.String `json:"name"`
+	Description null.String `json:"description"`
+	UpdatedBy   int64       `json:"-"`
+}
+
+func (v *UpdatePermission) Validate() error {
+	if v.Name.Valid {
+		return ValidatePermissionName(v.Name.String)
+	}
+	return nil
+}
+
+func (v *UpdatePermission) FillEntity(permission *psqlmodel.Permission) {
+	if v.Name.Valid {
+		permission.Name = v.Name.String
+	}
+
+	if v.Description.Valid {
+		permission.Description = v.Description.String
+	}
+}
+
+// ValidatePermissionName checks a permission is written as resource:action,
+// lower case with dashes, like account-role:read.
+func ValidatePermissionName(name string) error {
+	rg := regexp.MustCompile(RegExpPermission)
+	if !rg.MatchString(name) {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidPermission, nil, "invalid permission name")
+	}
+	return nil
+}
+
+type Permission struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	BaseInformation
+}
+
+func TransformPSQLSinglePermission(permission *psqlmodel.Permission) Permission {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(permission.CreatedBy),
+		CreatedAt: permission.CreatedAt,
+		UpdatedBy: int64(permission.UpdatedBy),
+		UpdatedAt: permission.UpdatedAt,
+		DeletedBy: int64(permission.DeletedBy.Int),
+		DeletedAt: permission.DeletedAt.Time,
+	}
+
+	return Permission{
+		ID:              int64(permission.ID),
+		Name:            permission.Name,
+		Description:     permission.Description,
+		BaseInformation: creationInfo,
+	}
+}
+
+func TransformPSQLPermission(permission *psqlmodel.PermissionSlice) []Permission {
+	var res []Permission
+	for _, v := range *permission {
+		creationInfo := BaseInformation{
+			CreatedBy: int64(v.CreatedBy),
+			CreatedAt: v.CreatedAt,
+			UpdatedBy: int64(v.UpdatedBy),
+			UpdatedAt: v.UpdatedAt,
+			DeletedBy: int64(v.DeletedBy.Int),
+			DeletedAt: v.DeletedAt.Time,
+		}
+
+		res = append(res, Permission{
+			ID:              int64(v.ID),
+			Name:            v.Name,
+			Description:     v.Description,
+			BaseInformation: creationInfo,
+		})
+	}
+
+	return res
+}
@@ -24,62 +24,77 @@ import (
 
 // AccountRole is an object representing the database table.
 type AccountRole struct {
-	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	AccountID int       `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
-	RoleID    int       `boil:"role_id" json:"role_id" toml:"role_id" yaml:"role_id"`
-	CreatedBy int       `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedBy int       `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedBy null.Int  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
-	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID             int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	AccountID      int       `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	RoleID         int       `boil:"role_id" json:"role_id" toml:"role_id" yaml:"role_id"`
+	CreatedBy      int       `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy      int       `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy      null.Int  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt      null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	OrganisationID null.Int  `boil:"organisation_id" json:"organisation_id,omitempty" toml:"organisation_id" yaml:"organisation_id,omitempty"`
+	ValidFrom      null.Time `boil:"valid_from" json:"valid_from,omitempty" toml:"valid_from" yaml:"valid_from,omitempty"`
+	ValidUntil     null.Time `boil:"valid_until" json:"valid_until,omitempty" toml:"valid_until" yaml:"valid_until,omitempty"`
 
 	R *accountRoleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L accountRoleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AccountRoleColumns = struct {
-	ID        string
-	AccountID string
-	RoleID    string
-	CreatedBy string
-	CreatedAt string
-	UpdatedBy string
-	UpdatedAt string
-	DeletedBy string
-	DeletedAt string
+	ID             string
+	AccountID      string
+	RoleID         string
+	CreatedBy      string
+	CreatedAt      string
+	UpdatedBy      string
+	UpdatedAt      string
+	DeletedBy      string
+	DeletedAt      string
+	OrganisationID string
+	ValidFrom      string
+	ValidUntil     string
 }{
-	ID:        "id",
-	AccountID: "account_id",
-	RoleID:    "role_id",
-	CreatedBy: "created_by",
-	CreatedAt: "created_at",
-	UpdatedBy: "updated_by",
-	UpdatedAt: "updated_at",
-	DeletedBy: "deleted_by",
-	DeletedAt: "deleted_at",
+	ID:             "id",
+	AccountID:      "account_id",
+	RoleID:         "role_id",
+	CreatedBy:      "created_by",
+	CreatedAt:      "created_at",
+	UpdatedBy:      "updated_by",
+	UpdatedAt:      "updated_at",
+	DeletedBy:      "deleted_by",
+	DeletedAt:      "deleted_at",
+	OrganisationID: "organisation_id",
+	ValidFrom:      "valid_from",
+	ValidUntil:     "valid_until",
 }
 
 var AccountRoleTableColumns = struct {
-	ID        string
-	AccountID string
-	RoleID    string
-	CreatedBy string
-	CreatedAt string
-	UpdatedBy string
-	UpdatedAt string
-	DeletedBy string
-	DeletedAt string
+	ID             string
+	AccountID      string
+	RoleID         string
+	CreatedBy      string
+	CreatedAt      string
+	UpdatedBy      string
+	UpdatedAt      string
+	DeletedBy      string
+	DeletedAt      string
+	OrganisationID string
+	ValidFrom      string
+	ValidUntil     string
 }{
-	ID:        "account_roles.id",
-	AccountID: "account_roles.account_id",
-	RoleID:    "account_roles.role_id",
-	CreatedBy: "account_roles.created_by",
-	CreatedAt: "account_roles.created_at",
-	UpdatedBy: "account_roles.updated_by",
-	UpdatedAt: "account_roles.updated_at",
-	DeletedBy: "account_roles.deleted_by",
-	DeletedAt: "account_roles.deleted_at",
+	ID:             "account_roles.id",
+	AccountID:      "account_roles.account_id",
+	RoleID:         "account_roles.role_id",
+	CreatedBy:      "account_roles.created_by",
+	CreatedAt:      "account_roles.created_at",
+	UpdatedBy:      "account_roles.updated_by",
+	UpdatedAt:      "account_roles.updated_at",
+	DeletedBy:      "account_roles.deleted_by",
+	DeletedAt:      "account_roles.deleted_at",
+	OrganisationID: "account_roles.organisation_id",
+	ValidFrom:      "account_roles.valid_from",
+	ValidUntil:     "account_roles.valid_until",
 }
 
 // Generated where
@@ -191,40 +206,49 @@ func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsN
 func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var AccountRoleWhere = struct {
-	ID        whereHelperint
-	AccountID whereHelperint
-	RoleID    whereHelperint
-	CreatedBy whereHelperint
-	CreatedAt whereHelpertime_Time
-	UpdatedBy whereHelperint
-	UpdatedAt whereHelpertime_Time
-	DeletedBy whereHelpernull_Int
-	DeletedAt whereHelpernull_Time
+	ID             whereHelperint
+	AccountID      whereHelperint
+	RoleID         whereHelperint
+	CreatedBy      whereHelperint
+	CreatedAt      whereHelpertime_Time
+	UpdatedBy      whereHelperint
+	UpdatedAt      whereHelpertime_Time
+	DeletedBy      whereHelpernull_Int
+	DeletedAt      whereHelpernull_Time
+	OrganisationID whereHelpernull_Int
+	ValidFrom      whereHelpernull_Time
+	ValidUntil     whereHelpernull_Time
 }{
-	ID:        whereHelperint{field: "\"account_roles\".\"id\""},
-	AccountID: whereHelperint{field: "\"account_roles\".\"account_id\""},
-	RoleID:    whereHelperint{field: "\"account_roles\".\"role_id\""},
-	CreatedBy: whereHelperint{field: "\"account_roles\".\"created_by\""},
-	CreatedAt: whereHelpertime_Time{field: "\"account_roles\".\"created_at\""},
-	UpdatedBy: whereHelperint{field: "\"account_roles\".\"updated_by\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"account_roles\".\"updated_at\""},
-	DeletedBy: whereHelpernull_Int{field: "\"account_roles\".\"deleted_by\""},
-	DeletedAt: whereHelpernull_Time{field: "\"account_roles\".\"deleted_at\""},
+	ID:             whereHelperint{field: "\"account_roles\".\"id\""},
+	AccountID:      whereHelperint{field: "\"account_roles\".\"account_id\""},
+	RoleID:         whereHelperint{field: "\"account_roles\".\"role_id\""},
+	CreatedBy:      whereHelperint{field: "\"account_roles\".\"created_by\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"account_roles\".\"created_at\""},
+	UpdatedBy:      whereHelperint{field: "\"account_roles\".\"updated_by\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"account_roles\".\"updated_at\""},
+	DeletedBy:      whereHelpernull_Int{field: "\"account_roles\".\"deleted_by\""},
+	DeletedAt:      whereHelpernull_Time{field: "\"account_roles\".\"deleted_at\""},
+	OrganisationID: whereHelpernull_Int{field: "\"account_roles\".\"organisation_id\""},
+	ValidFrom:      whereHelpernull_Time{field: "\"account_roles\".\"valid_from\""},
+	ValidUntil:     whereHelpernull_Time{field: "\"account_roles\".\"valid_until\""},
 }
 
 // AccountRoleRels is where relationship names are stored.
 var AccountRoleRels = struct {
-	Account string
-	Role    string
+	Account      string
+	Role         string
+	Organisation string
 }{
-	Account: "Account",
-	Role:    "Role",
+	Account:      "Account",
+	Role:         "Role",
+	Organisation: "Organisation",
 }
 
 // accountRoleR is where relationships are stored.
 type accountRoleR struct {
-	Account *Account `boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
-	Role    *Role    `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
+	Account      *Account      `boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+	Role         *Role         `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
+	Organisation *Organisation `boil:"Organisation" json:"Organisation" toml:"Organisation" yaml:"Organisation"`
 }
 
 // NewStruct creates a new relationship struct
@@ -246,13 +270,20 @@ func (r *accountRoleR) GetRole() *Role {
 	return r.Role
 }
 
+func (r *accountRoleR) GetOrganisation() *Organisation {
+	if r == nil {
+		return nil
+	}
+	return r.Organisation
+}
+
 // accountRoleL is where Load methods for each relationship are stored.
 type accountRoleL struct{}
 
 var (
-	accountRoleAllColumns            = []string{"id", "account_id", "role_id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	accountRoleAllColumns            = []string{"id", "account_id", "role_id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "organisation_id", "valid_from", "valid_until"}
 	accountRoleColumnsWithoutDefault = []string{"account_id", "role_id"}
-	accountRoleColumnsWithDefault    = []string{"id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	accountRoleColumnsWithDefault    = []string{"id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "organisation_id", "valid_from", "valid_until"}
 	accountRolePrimaryKeyColumns     = []string{"id"}
 	accountRoleGeneratedColumns      = []string{}
 )
@@ -604,6 +635,17 @@ func (o *AccountRole) Role(mods ...qm.QueryMod) roleQuery {
 	return Roles(queryMods...)
 }
 
+// Organisation pointed to by the foreign key.
+func (o *AccountRole) Organisation(mods ...qm.QueryMod) organisationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrganisationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organisations(queryMods...)
+}
+
 // LoadAccount allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (accountRoleL) LoadAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccountRole interface{}, mods queries.Applicator) error {
@@ -846,6 +888,131 @@ func (accountRoleL) LoadRole(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
+// LoadOrganisation allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (accountRoleL) LoadOrganisation(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccountRole interface{}, mods queries.Applicator) error {
+	var slice []*AccountRole
+	var object *AccountRole
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccountRole.(*AccountRole)
+		if !ok {
+			object = new(AccountRole)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccountRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccountRole))
+			}
+		}
+	} else {
+		s, ok := maybeAccountRole.(*[]*AccountRole)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccountRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccountRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accountRoleR{}
+		}
+		if !queries.IsNil(object.OrganisationID) {
+			args[object.OrganisationID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountRoleR{}
+			}
+
+			if !queries.IsNil(obj.OrganisationID) {
+				args[obj.OrganisationID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organisations`),
+		qm.WhereIn(`organisations.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`organisations.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organisation")
+	}
+
+	var resultSlice []*Organisation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organisation")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organisations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organisations")
+	}
+
+	if len(organisationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Organisation = foreign
+		if foreign.R == nil {
+			foreign.R = &organisationR{}
+		}
+		foreign.R.AccountRoles = append(foreign.R.AccountRoles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OrganisationID, foreign.ID) {
+				local.R.Organisation = foreign
+				if foreign.R == nil {
+					foreign.R = &organisationR{}
+				}
+				foreign.R.AccountRoles = append(foreign.R.AccountRoles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetAccountG of the accountRole to the related item.
 // Sets o.R.Account to related.
 // Adds o to related.R.AccountRoles.
@@ -956,6 +1123,102 @@ func (o *AccountRole) SetRole(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
+// SetOrganisationG of the accountRole to the related item.
+// Sets o.R.Organisation to related.
+// Adds o to related.R.AccountRoles.
+// Uses the global database handle.
+func (o *AccountRole) SetOrganisationG(ctx context.Context, insert bool, related *Organisation) error {
+	return o.SetOrganisation(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetOrganisation of the accountRole to the related item.
+// Sets o.R.Organisation to related.
+// Adds o to related.R.AccountRoles.
+func (o *AccountRole) SetOrganisation(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organisation) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"account_roles\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"organisation_id"}),
+		strmangle.WhereClause("\"", "\"", 2, accountRolePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OrganisationID, related.ID)
+	if o.R == nil {
+		o.R = &accountRoleR{
+			Organisation: related,
+		}
+	} else {
+		o.R.Organisation = related
+	}
+
+	if related.R == nil {
+		related.R = &organisationR{
+			AccountRoles: AccountRoleSlice{o},
+		}
+	} else {
+		related.R.AccountRoles = append(related.R.AccountRoles, o)
+	}
+
+	return nil
+}
+
+// RemoveOrganisationG relationship.
+// Sets o.R.Organisation to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *AccountRole) RemoveOrganisationG(ctx context.Context, related *Organisation) error {
+	return o.RemoveOrganisation(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveOrganisation relationship.
+// Sets o.R.Organisation to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *AccountRole) RemoveOrganisation(ctx context.Context, exec boil.ContextExecutor, related *Organisation) error {
+	var err error
+
+	queries.SetScanner(&o.OrganisationID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("organisation_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Organisation = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AccountRoles {
+		if queries.Equal(o.OrganisationID, ri.OrganisationID) {
+			continue
+		}
+
+		ln := len(related.R.AccountRoles)
+		if ln > 1 && i < ln-1 {
+			related.R.AccountRoles[i] = related.R.AccountRoles[ln-1]
+		}
+		related.R.AccountRoles = related.R.AccountRoles[:ln-1]
+		break
+	}
+	return nil
+}
+
 // AccountRoles retrieves all the records using an executor.
 func AccountRoles(mods ...qm.QueryMod) accountRoleQuery {
 	mods = append(mods, qm.From("\"account_roles\""), qmhelper.WhereIsNull("\"account_roles\".\"deleted_at\""))
@@ -717,6 +717,67 @@ func testAccountRoleToOneRoleUsingRole(t *testing.T) {
 	}
 }
 
+func testAccountRoleToOneOrganisationUsingOrganisation(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local AccountRole
+	var foreign Organisation
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, accountRoleDBTypes, true, accountRoleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AccountRole struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, organisationDBTypes, false, organisationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Organisation struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.OrganisationID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Organisation().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddOrganisationHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Organisation) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := AccountRoleSlice{&local}
+	if err = local.L.LoadOrganisation(ctx, tx, false, (*[]*AccountRole)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organisation == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Organisation = nil
+	if err = local.L.LoadOrganisation(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Organisation == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
 func testAccountRoleToOneSetOpAccountUsingAccount(t *testing.T) {
 	var err error
 
@@ -831,6 +892,114 @@ func testAccountRoleToOneSetOpRoleUsingRole(t *testing.T) {
 		}
 	}
 }
+func testAccountRoleToOneSetOpOrganisationUsingOrganisation(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AccountRole
+	var b, c Organisation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, accountRoleDBTypes, false, strmangle.SetComplement(accountRolePrimaryKeyColumns, accountRoleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, organisationDBTypes, false, strmangle.SetComplement(organisationPrimaryKeyColumns, organisationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, organisationDBTypes, false, strmangle.SetComplement(organisationPrimaryKeyColumns, organisationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Organisation{&b, &c} {
+		err = a.SetOrganisation(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Organisation != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AccountRoles[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.OrganisationID, x.ID) {
+			t.Error("foreign key was wrong value", a.OrganisationID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OrganisationID))
+		reflect.Indirect(reflect.ValueOf(&a.OrganisationID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.OrganisationID, x.ID) {
+			t.Error("foreign key was wrong value", a.OrganisationID, x.ID)
+		}
+	}
+}
+
+func testAccountRoleToOneRemoveOpOrganisationUsingOrganisation(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a AccountRole
+	var b Organisation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, accountRoleDBTypes, false, strmangle.SetComplement(accountRolePrimaryKeyColumns, accountRoleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, organisationDBTypes, false, strmangle.SetComplement(organisationPrimaryKeyColumns, organisationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetOrganisation(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveOrganisation(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Organisation().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Organisation != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.OrganisationID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.AccountRoles) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
 
 func testAccountRolesReload(t *testing.T) {
 	t.Parallel()
@@ -906,7 +1075,7 @@ func testAccountRolesSelect(t *testing.T) {
 }
 
 var (
-	accountRoleDBTypes = map[string]string{`ID`: `integer`, `AccountID`: `integer`, `RoleID`: `integer`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`}
+	accountRoleDBTypes = map[string]string{`ID`: `integer`, `AccountID`: `integer`, `RoleID`: `integer`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedBy`: `integer`, `UpdatedAt`: `timestamp with time zone`, `DeletedBy`: `integer`, `DeletedAt`: `timestamp with time zone`, `OrganisationID`: `integer`, `ValidFrom`: `timestamp with time zone`, `ValidUntil`: `timestamp with time zone`}
 	_                  = bytes.MinRead
 )
 
@@ -24,67 +24,87 @@ import (
 
 // Account is an object representing the database table.
 type Account struct {
-	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Email     string    `boil:"email" json:"email" toml:"email" yaml:"email"`
-	Password  string    `boil:"password" json:"password" toml:"password" yaml:"password"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedBy int       `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedBy int       `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedBy null.Int  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
-	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID                int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Email             string    `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Password          string    `boil:"password" json:"password" toml:"password" yaml:"password"`
+	Name              string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedBy         int       `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt         time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy         int       `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt         time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy         null.Int  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt         null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	EmailVerifiedAt   null.Time `boil:"email_verified_at" json:"email_verified_at,omitempty" toml:"email_verified_at" yaml:"email_verified_at,omitempty"`
+	MfaSecret         string    `boil:"mfa_secret" json:"mfa_secret" toml:"mfa_secret" yaml:"mfa_secret"`
+	MfaEnabledAt      null.Time `boil:"mfa_enabled_at" json:"mfa_enabled_at,omitempty" toml:"mfa_enabled_at" yaml:"mfa_enabled_at,omitempty"`
+	PasswordChangedAt time.Time `boil:"password_changed_at" json:"password_changed_at" toml:"password_changed_at" yaml:"password_changed_at"`
 
 	R *accountR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L accountL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AccountColumns = struct {
-	ID        string
-	Email     string
-	Password  string
-	Name      string
-	CreatedBy string
-	CreatedAt string
-	UpdatedBy string
-	UpdatedAt string
-	DeletedBy string
-	DeletedAt string
+	ID                string
+	Email             string
+	Password          string
+	Name              string
+	CreatedBy         string
+	CreatedAt         string
+	UpdatedBy         string
+	UpdatedAt         string
+	DeletedBy         string
+	DeletedAt         string
+	EmailVerifiedAt   string
+	MfaSecret         string
+	MfaEnabledAt      string
+	PasswordChangedAt string
 }{
-	ID:        "id",
-	Email:     "email",
-	Password:  "password",
-	Name:      "name",
-	CreatedBy: "created_by",
-	CreatedAt: "created_at",
-	UpdatedBy: "updated_by",
-	UpdatedAt: "updated_at",
-	DeletedBy: "deleted_by",
-	DeletedAt: "deleted_at",
+	ID:                "id",
+	Email:             "email",
+	Password:          "password",
+	Name:              "name",
+	CreatedBy:         "created_by",
+	CreatedAt:         "created_at",
+	UpdatedBy:         "updated_by",
+	UpdatedAt:         "updated_at",
+	DeletedBy:         "deleted_by",
+	DeletedAt:         "deleted_at",
+	EmailVerifiedAt:   "email_verified_at",
+	MfaSecret:         "mfa_secret",
+	MfaEnabledAt:      "mfa_enabled_at",
+	PasswordChangedAt: "password_changed_at",
 }
 
 var AccountTableColumns = struct {
-	ID        string
-	Email     string
-	Password  string
-	Name      string
-	CreatedBy string
-	CreatedAt string
-	UpdatedBy string
-	UpdatedAt string
-	DeletedBy string
-	DeletedAt string
+	ID                string
+	Email             string
+	Password          string
+	Name              string
+	CreatedBy         string
+	CreatedAt         string
+	UpdatedBy         string
+	UpdatedAt         string
+	DeletedBy         string
+	DeletedAt         string
+	EmailVerifiedAt   string
+	MfaSecret         string
+	MfaEnabledAt      string
+	PasswordChangedAt string
 }{
-	ID:        "accounts.id",
-	Email:     "accounts.email",
-	Password:  "accounts.password",
-	Name:      "accounts.name",
-	CreatedBy: "accounts.created_by",
-	CreatedAt: "accounts.created_at",
-	UpdatedBy: "accounts.updated_by",
-	UpdatedAt: "accounts.updated_at",
-	DeletedBy: "accounts.deleted_by",
-	DeletedAt: "accounts.deleted_at",
+	ID:                "accounts.id",
+	Email:             "accounts.email",
+	Password:          "accounts.password",
+	Name:              "accounts.name",
+	CreatedBy:         "accounts.created_by",
+	CreatedAt:         "accounts.created_at",
+	UpdatedBy:         "accounts.updated_by",
+	UpdatedAt:         "accounts.updated_at",
+	DeletedBy:         "accounts.deleted_by",
+	DeletedAt:         "accounts.deleted_at",
+	EmailVerifiedAt:   "accounts.email_verified_at",
+	MfaSecret:         "accounts.mfa_secret",
+	MfaEnabledAt:      "accounts.mfa_enabled_at",
+	PasswordChangedAt: "accounts.password_changed_at",
 }
 
 // Generated where
@@ -117,39 +137,65 @@ func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
 }
 
 var AccountWhere = struct {
-	ID        whereHelperint
-	Email     whereHelperstring
-	Password  whereHelperstring
-	Name      whereHelperstring
-	CreatedBy whereHelperint
-	CreatedAt whereHelpertime_Time
-	UpdatedBy whereHelperint
-	UpdatedAt whereHelpertime_Time
-	DeletedBy whereHelpernull_Int
-	DeletedAt whereHelpernull_Time
+	ID                whereHelperint
+	Email             whereHelperstring
+	Password          whereHelperstring
+	Name              whereHelperstring
+	CreatedBy         whereHelperint
+	CreatedAt         whereHelpertime_Time
+	UpdatedBy         whereHelperint
+	UpdatedAt         whereHelpertime_Time
+	DeletedBy         whereHelpernull_Int
+	DeletedAt         whereHelpernull_Time
+	EmailVerifiedAt   whereHelpernull_Time
+	MfaSecret         whereHelperstring
+	MfaEnabledAt      whereHelpernull_Time
+	PasswordChangedAt whereHelpertime_Time
 }{
-	ID:        whereHelperint{field: "\"accounts\".\"id\""},
-	Email:     whereHelperstring{field: "\"accounts\".\"email\""},
-	Password:  whereHelperstring{field: "\"accounts\".\"password\""},
-	Name:      whereHelperstring{field: "\"accounts\".\"name\""},
-	CreatedBy: whereHelperint{field: "\"accounts\".\"created_by\""},
-	CreatedAt: whereHelpertime_Time{field: "\"accounts\".\"created_at\""},
-	UpdatedBy: whereHelperint{field: "\"accounts\".\"updated_by\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"accounts\".\"updated_at\""},
-	DeletedBy: whereHelpernull_Int{field: "\"accounts\".\"deleted_by\""},
-	DeletedAt: whereHelpernull_Time{field: "\"accounts\".\"deleted_at\""},
+	ID:                whereHelperint{field: "\"accounts\".\"id\""},
+	Email:             whereHelperstring{field: "\"accounts\".\"email\""},
+	Password:          whereHelperstring{field: "\"accounts\".\"password\""},
+	Name:              whereHelperstring{field: "\"accounts\".\"name\""},
+	CreatedBy:         whereHelperint{field: "\"accounts\".\"created_by\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"accounts\".\"created_at\""},
+	UpdatedBy:         whereHelperint{field: "\"accounts\".\"updated_by\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"accounts\".\"updated_at\""},
+	DeletedBy:         whereHelpernull_Int{field: "\"accounts\".\"deleted_by\""},
+	DeletedAt:         whereHelpernull_Time{field: "\"accounts\".\"deleted_at\""},
+	EmailVerifiedAt:   whereHelpernull_Time{field: "\"accounts\".\"email_verified_at\""},
+	MfaSecret:         whereHelperstring{field: "\"accounts\".\"mfa_secret\""},
+	MfaEnabledAt:      whereHelpernull_Time{field: "\"accounts\".\"mfa_enabled_at\""},
+	PasswordChangedAt: whereHelpertime_Time{field: "\"accounts\".\"password_changed_at\""},
 }
 
 // AccountRels is where relationship names are stored.
 var AccountRels = struct {
-	AccountRoles string
+	AccountRoles        string
+	APIKeys             string
+	Invitations         string
+	OrganisationMembers string
+	PasswordHistories   string
+	RecoveryCodes       string
+	RefreshTokens       string
 }{
-	AccountRoles: "AccountRoles",
+	AccountRoles:        "AccountRoles",
+	APIKeys:             "APIKeys",
+	Invitations:         "Invitations",
+	OrganisationMembers: "OrganisationMembers",
+	PasswordHistories:   "PasswordHistories",
+	RecoveryCodes:       "RecoveryCodes",
+	RefreshTokens:       "RefreshTokens",
 }
 
 // accountR is where relationships are stored.
 type accountR struct {
-	AccountRoles AccountRoleSlice `boil:"AccountRoles" json:"AccountRoles" toml:"AccountRoles" yaml:"AccountRoles"`
+	AccountRoles        AccountRoleSlice        `boil:"AccountRoles" json:"AccountRoles" toml:"AccountRoles" yaml:"AccountRoles"`
+	APIKeys             APIKeySlice             `boil:"APIKeys" json:"APIKeys" toml:"APIKeys" yaml:"APIKeys"`
+	Invitations         InvitationSlice         `boil:"Invitations" json:"Invitations" toml:"Invitations" yaml:"Invitations"`
+	OrganisationMembers OrganisationMemberSlice `boil:"OrganisationMembers" json:"OrganisationMembers" toml:"OrganisationMembers" yaml:"OrganisationMembers"`
+	PasswordHistories   PasswordHistorySlice    `boil:"PasswordHistories" json:"PasswordHistories" toml:"PasswordHistories" yaml:"PasswordHistories"`
+	RecoveryCodes       RecoveryCodeSlice       `boil:"RecoveryCodes" json:"RecoveryCodes" toml:"RecoveryCodes" yaml:"RecoveryCodes"`
+	RefreshTokens       RefreshTokenSlice       `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
 }
 
 // NewStruct creates a new relationship struct
@@ -164,13 +210,55 @@ func (r *accountR) GetAccountRoles() AccountRoleSlice {
 	return r.AccountRoles
 }
 
+func (r *accountR) GetAPIKeys() APIKeySlice {
+	if r == nil {
+		return nil
+	}
+	return r.APIKeys
+}
+
+func (r *accountR) GetInvitations() InvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Invitations
+}
+
+func (r *accountR) GetOrganisationMembers() OrganisationMemberSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OrganisationMembers
+}
+
+func (r *accountR) GetPasswordHistories() PasswordHistorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.PasswordHistories
+}
+
+func (r *accountR) GetRecoveryCodes() RecoveryCodeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RecoveryCodes
+}
+
+func (r *accountR) GetRefreshTokens() RefreshTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RefreshTokens
+}
+
 // accountL is where Load methods for each relationship are stored.
 type accountL struct{}
 
 var (
-	accountAllColumns            = []string{"id", "email", "password", "name", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	accountAllColumns            = []string{"id", "email", "password", "name", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "email_verified_at", "mfa_secret", "mfa_enabled_at", "password_changed_at"}
 	accountColumnsWithoutDefault = []string{"email", "password", "name"}
-	accountColumnsWithDefault    = []string{"id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	accountColumnsWithDefault    = []string{"id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "email_verified_at", "mfa_secret", "mfa_enabled_at", "password_changed_at"}
 	accountPrimaryKeyColumns     = []string{"id"}
 	accountGeneratedColumns      = []string{}
 )
@@ -514,6 +602,90 @@ func (o *Account) AccountRoles(mods ...qm.QueryMod) accountRoleQuery {
 	return AccountRoles(queryMods...)
 }
 
+// APIKeys retrieves all the api_key's APIKeys with an executor.
+func (o *Account) APIKeys(mods ...qm.QueryMod) apiKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"api_keys\".\"account_id\"=?", o.ID),
+	)
+
+	return APIKeys(queryMods...)
+}
+
+// Invitations retrieves all the invitation's Invitations with an executor.
+func (o *Account) Invitations(mods ...qm.QueryMod) invitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"invitations\".\"account_id\"=?", o.ID),
+	)
+
+	return Invitations(queryMods...)
+}
+
+// OrganisationMembers retrieves all the organisation_member's OrganisationMembers with an executor.
+func (o *Account) OrganisationMembers(mods ...qm.QueryMod) organisationMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"organisation_members\".\"account_id\"=?", o.ID),
+	)
+
+	return OrganisationMembers(queryMods...)
+}
+
+// PasswordHistories retrieves all the password_history's PasswordHistories with an executor.
+func (o *Account) PasswordHistories(mods ...qm.QueryMod) passwordHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"password_histories\".\"account_id\"=?", o.ID),
+	)
+
+	return PasswordHistories(queryMods...)
+}
+
+// RecoveryCodes retrieves all the recovery_code's RecoveryCodes with an executor.
+func (o *Account) RecoveryCodes(mods ...qm.QueryMod) recoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"recovery_codes\".\"account_id\"=?", o.ID),
+	)
+
+	return RecoveryCodes(queryMods...)
+}
+
+// RefreshTokens retrieves all the refresh_token's RefreshTokens with an executor.
+func (o *Account) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"refresh_tokens\".\"account_id\"=?", o.ID),
+	)
+
+	return RefreshTokens(queryMods...)
+}
+
 // LoadAccountRoles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (accountL) LoadAccountRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
@@ -628,59 +800,1208 @@ func (accountL) LoadAccountRoles(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// AddAccountRolesG adds the given related objects to the existing relationships
-// of the account, optionally inserting them as new records.
-// Appends related to o.R.AccountRoles.
-// Sets related.R.Account appropriately.
-// Uses the global database handle.
-func (o *Account) AddAccountRolesG(ctx context.Context, insert bool, related ...*AccountRole) error {
-	return o.AddAccountRoles(ctx, boil.GetContextDB(), insert, related...)
-}
+// LoadAPIKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadAPIKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
 
-// AddAccountRoles adds the given related objects to the existing relationships
-// of the account, optionally inserting them as new records.
-// Appends related to o.R.AccountRoles.
-// Sets related.R.Account appropriately.
-func (o *Account) AddAccountRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountRole) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.AccountID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
 			}
+		}
+	} else {
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
 		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"account_roles\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
-				strmangle.WhereClause("\"", "\"", 2, accountRolePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
+	}
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`api_keys`),
+		qm.WhereIn(`api_keys.account_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`api_keys.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load api_keys")
+	}
+
+	var resultSlice []*APIKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice api_keys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on api_keys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for api_keys")
+	}
+
+	if len(apiKeyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.APIKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &apiKeyR{}
 			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
 
-			rel.AccountID = o.ID
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.APIKeys = append(local.R.APIKeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &apiKeyR{}
+				}
+				foreign.R.Account = local
+				break
+			}
 		}
 	}
 
-	if o.R == nil {
-		o.R = &accountR{
-			AccountRoles: related,
+	return nil
+}
+
+// LoadInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
 		}
 	} else {
-		o.R.AccountRoles = append(o.R.AccountRoles, related...)
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &accountRoleR{
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`invitations`),
+		qm.WhereIn(`invitations.account_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`invitations.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load invitations")
+	}
+
+	var resultSlice []*Invitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for invitations")
+	}
+
+	if len(invitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Invitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &invitationR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AccountID) {
+				local.R.Invitations = append(local.R.Invitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &invitationR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganisationMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadOrganisationMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
+		}
+	} else {
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organisation_members`),
+		qm.WhereIn(`organisation_members.account_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`organisation_members.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load organisation_members")
+	}
+
+	var resultSlice []*OrganisationMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice organisation_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on organisation_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organisation_members")
+	}
+
+	if len(organisationMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OrganisationMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &organisationMemberR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.OrganisationMembers = append(local.R.OrganisationMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &organisationMemberR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadPasswordHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
+		}
+	} else {
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`password_histories`),
+		qm.WhereIn(`password_histories.account_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`password_histories.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load password_histories")
+	}
+
+	var resultSlice []*PasswordHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice password_histories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on password_histories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_histories")
+	}
+
+	if len(passwordHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PasswordHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &passwordHistoryR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.PasswordHistories = append(local.R.PasswordHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &passwordHistoryR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
+		}
+	} else {
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`recovery_codes`),
+		qm.WhereIn(`recovery_codes.account_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`recovery_codes.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load recovery_codes")
+	}
+
+	var resultSlice []*RecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for recovery_codes")
+	}
+
+	if len(recoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &recoveryCodeR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.RecoveryCodes = append(local.R.RecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &recoveryCodeR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
+		}
+	} else {
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_tokens`),
+		qm.WhereIn(`refresh_tokens.account_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`refresh_tokens.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_tokens")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+	}
+
+	if len(refreshTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.Account = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AccountID {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.Account = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAccountRolesG adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.AccountRoles.
+// Sets related.R.Account appropriately.
+// Uses the global database handle.
+func (o *Account) AddAccountRolesG(ctx context.Context, insert bool, related ...*AccountRole) error {
+	return o.AddAccountRoles(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAccountRoles adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.AccountRoles.
+// Sets related.R.Account appropriately.
+func (o *Account) AddAccountRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountRole) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account_roles\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountRolePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			AccountRoles: related,
+		}
+	} else {
+		o.R.AccountRoles = append(o.R.AccountRoles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountRoleR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddAPIKeysG adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.APIKeys.
+// Sets related.R.Account appropriately.
+// Uses the global database handle.
+func (o *Account) AddAPIKeysG(ctx context.Context, insert bool, related ...*APIKey) error {
+	return o.AddAPIKeys(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAPIKeys adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.APIKeys.
+// Sets related.R.Account appropriately.
+func (o *Account) AddAPIKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*APIKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"api_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, apiKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			APIKeys: related,
+		}
+	} else {
+		o.R.APIKeys = append(o.R.APIKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &apiKeyR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddInvitationsG adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.Invitations.
+// Sets related.R.Account appropriately.
+// Uses the global database handle.
+func (o *Account) AddInvitationsG(ctx context.Context, insert bool, related ...*Invitation) error {
+	return o.AddInvitations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddInvitations adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.Invitations.
+// Sets related.R.Account appropriately.
+func (o *Account) AddInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AccountID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, invitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AccountID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			Invitations: related,
+		}
+	} else {
+		o.R.Invitations = append(o.R.Invitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &invitationR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// SetInvitationsG removes all previously related items of the
+// account replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Account's Invitations accordingly.
+// Replaces o.R.Invitations with related.
+// Sets related.R.Account's Invitations accordingly.
+// Uses the global database handle.
+func (o *Account) SetInvitationsG(ctx context.Context, insert bool, related ...*Invitation) error {
+	return o.SetInvitations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetInvitations removes all previously related items of the
+// account replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Account's Invitations accordingly.
+// Replaces o.R.Invitations with related.
+// Sets related.R.Account's Invitations accordingly.
+func (o *Account) SetInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invitation) error {
+	query := "update \"invitations\" set \"account_id\" = null where \"account_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Invitations {
+			queries.SetScanner(&rel.AccountID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Account = nil
+		}
+		o.R.Invitations = nil
+	}
+
+	return o.AddInvitations(ctx, exec, insert, related...)
+}
+
+// RemoveInvitationsG relationships from objects passed in.
+// Removes related items from R.Invitations (uses pointer comparison, removal does not keep order)
+// Sets related.R.Account.
+// Uses the global database handle.
+func (o *Account) RemoveInvitationsG(ctx context.Context, related ...*Invitation) error {
+	return o.RemoveInvitations(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveInvitations relationships from objects passed in.
+// Removes related items from R.Invitations (uses pointer comparison, removal does not keep order)
+// Sets related.R.Account.
+func (o *Account) RemoveInvitations(ctx context.Context, exec boil.ContextExecutor, related ...*Invitation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AccountID, nil)
+		if rel.R != nil {
+			rel.R.Account = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("account_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Invitations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Invitations)
+			if ln > 1 && i < ln-1 {
+				o.R.Invitations[i] = o.R.Invitations[ln-1]
+			}
+			o.R.Invitations = o.R.Invitations[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddOrganisationMembersG adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.OrganisationMembers.
+// Sets related.R.Account appropriately.
+// Uses the global database handle.
+func (o *Account) AddOrganisationMembersG(ctx context.Context, insert bool, related ...*OrganisationMember) error {
+	return o.AddOrganisationMembers(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddOrganisationMembers adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.OrganisationMembers.
+// Sets related.R.Account appropriately.
+func (o *Account) AddOrganisationMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrganisationMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"organisation_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, organisationMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			OrganisationMembers: related,
+		}
+	} else {
+		o.R.OrganisationMembers = append(o.R.OrganisationMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &organisationMemberR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddPasswordHistoriesG adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.PasswordHistories.
+// Sets related.R.Account appropriately.
+// Uses the global database handle.
+func (o *Account) AddPasswordHistoriesG(ctx context.Context, insert bool, related ...*PasswordHistory) error {
+	return o.AddPasswordHistories(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddPasswordHistories adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.PasswordHistories.
+// Sets related.R.Account appropriately.
+func (o *Account) AddPasswordHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"password_histories\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passwordHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			PasswordHistories: related,
+		}
+	} else {
+		o.R.PasswordHistories = append(o.R.PasswordHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &passwordHistoryR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddRecoveryCodesG adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.RecoveryCodes.
+// Sets related.R.Account appropriately.
+// Uses the global database handle.
+func (o *Account) AddRecoveryCodesG(ctx context.Context, insert bool, related ...*RecoveryCode) error {
+	return o.AddRecoveryCodes(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRecoveryCodes adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.RecoveryCodes.
+// Sets related.R.Account appropriately.
+func (o *Account) AddRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RecoveryCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"recovery_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, recoveryCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			RecoveryCodes: related,
+		}
+	} else {
+		o.R.RecoveryCodes = append(o.R.RecoveryCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &recoveryCodeR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddRefreshTokensG adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.Account appropriately.
+// Uses the global database handle.
+func (o *Account) AddRefreshTokensG(ctx context.Context, insert bool, related ...*RefreshToken) error {
+	return o.AddRefreshTokens(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRefreshTokens adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.Account appropriately.
+func (o *Account) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			RefreshTokens: related,
+		}
+	} else {
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &refreshTokenR{
 				Account: o,
 			}
 		} else {
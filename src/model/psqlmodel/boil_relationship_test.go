@@ -10,6 +10,20 @@ import "testing"
 func TestToOne(t *testing.T) {
 	t.Run("AccountRoleToAccountUsingAccount", testAccountRoleToOneAccountUsingAccount)
 	t.Run("AccountRoleToRoleUsingRole", testAccountRoleToOneRoleUsingRole)
+	t.Run("AccountRoleToOrganisationUsingOrganisation", testAccountRoleToOneOrganisationUsingOrganisation)
+	t.Run("APIKeyToAccountUsingAccount", testAPIKeyToOneAccountUsingAccount)
+	t.Run("InvitationToRoleUsingRole", testInvitationToOneRoleUsingRole)
+	t.Run("InvitationToOrganisationUsingOrganisation", testInvitationToOneOrganisationUsingOrganisation)
+	t.Run("InvitationToAccountUsingAccount", testInvitationToOneAccountUsingAccount)
+	t.Run("OrganisationMemberToOrganisationUsingOrganisation", testOrganisationMemberToOneOrganisationUsingOrganisation)
+	t.Run("OrganisationMemberToAccountUsingAccount", testOrganisationMemberToOneAccountUsingAccount)
+	t.Run("PasswordHistoryToAccountUsingAccount", testPasswordHistoryToOneAccountUsingAccount)
+	t.Run("RecoveryCodeToAccountUsingAccount", testRecoveryCodeToOneAccountUsingAccount)
+	t.Run("RefreshTokenToAccountUsingAccount", testRefreshTokenToOneAccountUsingAccount)
+	t.Run("RefreshTokenToRoleUsingRole", testRefreshTokenToOneRoleUsingRole)
+	t.Run("RefreshTokenToOrganisationUsingOrganisation", testRefreshTokenToOneOrganisationUsingOrganisation)
+	t.Run("RolePermissionToRoleUsingRole", testRolePermissionToOneRoleUsingRole)
+	t.Run("RolePermissionToPermissionUsingPermission", testRolePermissionToOnePermissionUsingPermission)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -20,7 +34,21 @@ func TestOneToOne(t *testing.T) {}
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
 	t.Run("AccountToAccountRoles", testAccountToManyAccountRoles)
+	t.Run("AccountToAPIKeys", testAccountToManyAPIKeys)
+	t.Run("AccountToInvitations", testAccountToManyInvitations)
+	t.Run("AccountToOrganisationMembers", testAccountToManyOrganisationMembers)
+	t.Run("AccountToPasswordHistories", testAccountToManyPasswordHistories)
+	t.Run("AccountToRecoveryCodes", testAccountToManyRecoveryCodes)
+	t.Run("AccountToRefreshTokens", testAccountToManyRefreshTokens)
+	t.Run("OrganisationToAccountRoles", testOrganisationToManyAccountRoles)
+	t.Run("OrganisationToInvitations", testOrganisationToManyInvitations)
+	t.Run("OrganisationToOrganisationMembers", testOrganisationToManyOrganisationMembers)
+	t.Run("OrganisationToRefreshTokens", testOrganisationToManyRefreshTokens)
+	t.Run("PermissionToRolePermissions", testPermissionToManyRolePermissions)
 	t.Run("RoleToAccountRoles", testRoleToManyAccountRoles)
+	t.Run("RoleToInvitations", testRoleToManyInvitations)
+	t.Run("RoleToRefreshTokens", testRoleToManyRefreshTokens)
+	t.Run("RoleToRolePermissions", testRoleToManyRolePermissions)
 }
 
 // TestToOneSet tests cannot be run in parallel
@@ -28,11 +56,30 @@ func TestToMany(t *testing.T) {
 func TestToOneSet(t *testing.T) {
 	t.Run("AccountRoleToAccountUsingAccountRoles", testAccountRoleToOneSetOpAccountUsingAccount)
 	t.Run("AccountRoleToRoleUsingAccountRoles", testAccountRoleToOneSetOpRoleUsingRole)
+	t.Run("AccountRoleToOrganisationUsingAccountRoles", testAccountRoleToOneSetOpOrganisationUsingOrganisation)
+	t.Run("APIKeyToAccountUsingAPIKeys", testAPIKeyToOneSetOpAccountUsingAccount)
+	t.Run("InvitationToRoleUsingInvitations", testInvitationToOneSetOpRoleUsingRole)
+	t.Run("InvitationToOrganisationUsingInvitations", testInvitationToOneSetOpOrganisationUsingOrganisation)
+	t.Run("InvitationToAccountUsingInvitations", testInvitationToOneSetOpAccountUsingAccount)
+	t.Run("OrganisationMemberToOrganisationUsingOrganisationMembers", testOrganisationMemberToOneSetOpOrganisationUsingOrganisation)
+	t.Run("OrganisationMemberToAccountUsingOrganisationMembers", testOrganisationMemberToOneSetOpAccountUsingAccount)
+	t.Run("PasswordHistoryToAccountUsingPasswordHistories", testPasswordHistoryToOneSetOpAccountUsingAccount)
+	t.Run("RecoveryCodeToAccountUsingRecoveryCodes", testRecoveryCodeToOneSetOpAccountUsingAccount)
+	t.Run("RefreshTokenToAccountUsingRefreshTokens", testRefreshTokenToOneSetOpAccountUsingAccount)
+	t.Run("RefreshTokenToRoleUsingRefreshTokens", testRefreshTokenToOneSetOpRoleUsingRole)
+	t.Run("RefreshTokenToOrganisationUsingRefreshTokens", testRefreshTokenToOneSetOpOrganisationUsingOrganisation)
+	t.Run("RolePermissionToRoleUsingRolePermissions", testRolePermissionToOneSetOpRoleUsingRole)
+	t.Run("RolePermissionToPermissionUsingRolePermissions", testRolePermissionToOneSetOpPermissionUsingPermission)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("AccountRoleToOrganisationUsingAccountRoles", testAccountRoleToOneRemoveOpOrganisationUsingOrganisation)
+	t.Run("InvitationToOrganisationUsingInvitations", testInvitationToOneRemoveOpOrganisationUsingOrganisation)
+	t.Run("InvitationToAccountUsingInvitations", testInvitationToOneRemoveOpAccountUsingAccount)
+	t.Run("RefreshTokenToOrganisationUsingRefreshTokens", testRefreshTokenToOneRemoveOpOrganisationUsingOrganisation)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -46,13 +93,37 @@ func TestOneToOneRemove(t *testing.T) {}
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
 	t.Run("AccountToAccountRoles", testAccountToManyAddOpAccountRoles)
+	t.Run("AccountToAPIKeys", testAccountToManyAddOpAPIKeys)
+	t.Run("AccountToInvitations", testAccountToManyAddOpInvitations)
+	t.Run("AccountToOrganisationMembers", testAccountToManyAddOpOrganisationMembers)
+	t.Run("AccountToPasswordHistories", testAccountToManyAddOpPasswordHistories)
+	t.Run("AccountToRecoveryCodes", testAccountToManyAddOpRecoveryCodes)
+	t.Run("AccountToRefreshTokens", testAccountToManyAddOpRefreshTokens)
+	t.Run("OrganisationToAccountRoles", testOrganisationToManyAddOpAccountRoles)
+	t.Run("OrganisationToInvitations", testOrganisationToManyAddOpInvitations)
+	t.Run("OrganisationToOrganisationMembers", testOrganisationToManyAddOpOrganisationMembers)
+	t.Run("OrganisationToRefreshTokens", testOrganisationToManyAddOpRefreshTokens)
+	t.Run("PermissionToRolePermissions", testPermissionToManyAddOpRolePermissions)
 	t.Run("RoleToAccountRoles", testRoleToManyAddOpAccountRoles)
+	t.Run("RoleToInvitations", testRoleToManyAddOpInvitations)
+	t.Run("RoleToRefreshTokens", testRoleToManyAddOpRefreshTokens)
+	t.Run("RoleToRolePermissions", testRoleToManyAddOpRolePermissions)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("AccountToInvitations", testAccountToManySetOpInvitations)
+	t.Run("OrganisationToAccountRoles", testOrganisationToManySetOpAccountRoles)
+	t.Run("OrganisationToInvitations", testOrganisationToManySetOpInvitations)
+	t.Run("OrganisationToRefreshTokens", testOrganisationToManySetOpRefreshTokens)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("AccountToInvitations", testAccountToManyRemoveOpInvitations)
+	t.Run("OrganisationToAccountRoles", testOrganisationToManyRemoveOpAccountRoles)
+	t.Run("OrganisationToInvitations", testOrganisationToManyRemoveOpInvitations)
+	t.Run("OrganisationToRefreshTokens", testOrganisationToManyRemoveOpRefreshTokens)
+}
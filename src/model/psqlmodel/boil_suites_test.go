@@ -14,96 +14,250 @@ import "testing"
 func TestParent(t *testing.T) {
 	t.Run("AccountRoles", testAccountRoles)
 	t.Run("Accounts", testAccounts)
+	t.Run("APIKeys", testAPIKeys)
+	t.Run("AuditLogs", testAuditLogs)
+	t.Run("Invitations", testInvitations)
+	t.Run("OrganisationMembers", testOrganisationMembers)
+	t.Run("Organisations", testOrganisations)
+	t.Run("PasswordHistories", testPasswordHistories)
+	t.Run("Permissions", testPermissions)
+	t.Run("RecoveryCodes", testRecoveryCodes)
+	t.Run("RefreshTokens", testRefreshTokens)
+	t.Run("RolePermissions", testRolePermissions)
 	t.Run("Roles", testRoles)
 	t.Run("SchemaMigrations", testSchemaMigrations)
+	t.Run("SigningKeys", testSigningKeys)
 }
 
 func TestSoftDelete(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesSoftDelete)
 	t.Run("Accounts", testAccountsSoftDelete)
+	t.Run("APIKeys", testAPIKeysSoftDelete)
+	t.Run("AuditLogs", testAuditLogsSoftDelete)
+	t.Run("Invitations", testInvitationsSoftDelete)
+	t.Run("OrganisationMembers", testOrganisationMembersSoftDelete)
+	t.Run("Organisations", testOrganisationsSoftDelete)
+	t.Run("PasswordHistories", testPasswordHistoriesSoftDelete)
+	t.Run("Permissions", testPermissionsSoftDelete)
+	t.Run("RecoveryCodes", testRecoveryCodesSoftDelete)
+	t.Run("RefreshTokens", testRefreshTokensSoftDelete)
+	t.Run("RolePermissions", testRolePermissionsSoftDelete)
 	t.Run("Roles", testRolesSoftDelete)
+	t.Run("SigningKeys", testSigningKeysSoftDelete)
 }
 
 func TestQuerySoftDeleteAll(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesQuerySoftDeleteAll)
 	t.Run("Accounts", testAccountsQuerySoftDeleteAll)
+	t.Run("APIKeys", testAPIKeysQuerySoftDeleteAll)
+	t.Run("AuditLogs", testAuditLogsQuerySoftDeleteAll)
+	t.Run("Invitations", testInvitationsQuerySoftDeleteAll)
+	t.Run("OrganisationMembers", testOrganisationMembersQuerySoftDeleteAll)
+	t.Run("Organisations", testOrganisationsQuerySoftDeleteAll)
+	t.Run("PasswordHistories", testPasswordHistoriesQuerySoftDeleteAll)
+	t.Run("Permissions", testPermissionsQuerySoftDeleteAll)
+	t.Run("RecoveryCodes", testRecoveryCodesQuerySoftDeleteAll)
+	t.Run("RefreshTokens", testRefreshTokensQuerySoftDeleteAll)
+	t.Run("RolePermissions", testRolePermissionsQuerySoftDeleteAll)
 	t.Run("Roles", testRolesQuerySoftDeleteAll)
+	t.Run("SigningKeys", testSigningKeysQuerySoftDeleteAll)
 }
 
 func TestSliceSoftDeleteAll(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesSliceSoftDeleteAll)
 	t.Run("Accounts", testAccountsSliceSoftDeleteAll)
+	t.Run("APIKeys", testAPIKeysSliceSoftDeleteAll)
+	t.Run("AuditLogs", testAuditLogsSliceSoftDeleteAll)
+	t.Run("Invitations", testInvitationsSliceSoftDeleteAll)
+	t.Run("OrganisationMembers", testOrganisationMembersSliceSoftDeleteAll)
+	t.Run("Organisations", testOrganisationsSliceSoftDeleteAll)
+	t.Run("PasswordHistories", testPasswordHistoriesSliceSoftDeleteAll)
+	t.Run("Permissions", testPermissionsSliceSoftDeleteAll)
+	t.Run("RecoveryCodes", testRecoveryCodesSliceSoftDeleteAll)
+	t.Run("RefreshTokens", testRefreshTokensSliceSoftDeleteAll)
+	t.Run("RolePermissions", testRolePermissionsSliceSoftDeleteAll)
 	t.Run("Roles", testRolesSliceSoftDeleteAll)
+	t.Run("SigningKeys", testSigningKeysSliceSoftDeleteAll)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesDelete)
 	t.Run("Accounts", testAccountsDelete)
+	t.Run("APIKeys", testAPIKeysDelete)
+	t.Run("AuditLogs", testAuditLogsDelete)
+	t.Run("Invitations", testInvitationsDelete)
+	t.Run("OrganisationMembers", testOrganisationMembersDelete)
+	t.Run("Organisations", testOrganisationsDelete)
+	t.Run("PasswordHistories", testPasswordHistoriesDelete)
+	t.Run("Permissions", testPermissionsDelete)
+	t.Run("RecoveryCodes", testRecoveryCodesDelete)
+	t.Run("RefreshTokens", testRefreshTokensDelete)
+	t.Run("RolePermissions", testRolePermissionsDelete)
 	t.Run("Roles", testRolesDelete)
 	t.Run("SchemaMigrations", testSchemaMigrationsDelete)
+	t.Run("SigningKeys", testSigningKeysDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesQueryDeleteAll)
 	t.Run("Accounts", testAccountsQueryDeleteAll)
+	t.Run("APIKeys", testAPIKeysQueryDeleteAll)
+	t.Run("AuditLogs", testAuditLogsQueryDeleteAll)
+	t.Run("Invitations", testInvitationsQueryDeleteAll)
+	t.Run("OrganisationMembers", testOrganisationMembersQueryDeleteAll)
+	t.Run("Organisations", testOrganisationsQueryDeleteAll)
+	t.Run("PasswordHistories", testPasswordHistoriesQueryDeleteAll)
+	t.Run("Permissions", testPermissionsQueryDeleteAll)
+	t.Run("RecoveryCodes", testRecoveryCodesQueryDeleteAll)
+	t.Run("RefreshTokens", testRefreshTokensQueryDeleteAll)
+	t.Run("RolePermissions", testRolePermissionsQueryDeleteAll)
 	t.Run("Roles", testRolesQueryDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsQueryDeleteAll)
+	t.Run("SigningKeys", testSigningKeysQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesSliceDeleteAll)
 	t.Run("Accounts", testAccountsSliceDeleteAll)
+	t.Run("APIKeys", testAPIKeysSliceDeleteAll)
+	t.Run("AuditLogs", testAuditLogsSliceDeleteAll)
+	t.Run("Invitations", testInvitationsSliceDeleteAll)
+	t.Run("OrganisationMembers", testOrganisationMembersSliceDeleteAll)
+	t.Run("Organisations", testOrganisationsSliceDeleteAll)
+	t.Run("PasswordHistories", testPasswordHistoriesSliceDeleteAll)
+	t.Run("Permissions", testPermissionsSliceDeleteAll)
+	t.Run("RecoveryCodes", testRecoveryCodesSliceDeleteAll)
+	t.Run("RefreshTokens", testRefreshTokensSliceDeleteAll)
+	t.Run("RolePermissions", testRolePermissionsSliceDeleteAll)
 	t.Run("Roles", testRolesSliceDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceDeleteAll)
+	t.Run("SigningKeys", testSigningKeysSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesExists)
 	t.Run("Accounts", testAccountsExists)
+	t.Run("APIKeys", testAPIKeysExists)
+	t.Run("AuditLogs", testAuditLogsExists)
+	t.Run("Invitations", testInvitationsExists)
+	t.Run("OrganisationMembers", testOrganisationMembersExists)
+	t.Run("Organisations", testOrganisationsExists)
+	t.Run("PasswordHistories", testPasswordHistoriesExists)
+	t.Run("Permissions", testPermissionsExists)
+	t.Run("RecoveryCodes", testRecoveryCodesExists)
+	t.Run("RefreshTokens", testRefreshTokensExists)
+	t.Run("RolePermissions", testRolePermissionsExists)
 	t.Run("Roles", testRolesExists)
 	t.Run("SchemaMigrations", testSchemaMigrationsExists)
+	t.Run("SigningKeys", testSigningKeysExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesFind)
 	t.Run("Accounts", testAccountsFind)
+	t.Run("APIKeys", testAPIKeysFind)
+	t.Run("AuditLogs", testAuditLogsFind)
+	t.Run("Invitations", testInvitationsFind)
+	t.Run("OrganisationMembers", testOrganisationMembersFind)
+	t.Run("Organisations", testOrganisationsFind)
+	t.Run("PasswordHistories", testPasswordHistoriesFind)
+	t.Run("Permissions", testPermissionsFind)
+	t.Run("RecoveryCodes", testRecoveryCodesFind)
+	t.Run("RefreshTokens", testRefreshTokensFind)
+	t.Run("RolePermissions", testRolePermissionsFind)
 	t.Run("Roles", testRolesFind)
 	t.Run("SchemaMigrations", testSchemaMigrationsFind)
+	t.Run("SigningKeys", testSigningKeysFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesBind)
 	t.Run("Accounts", testAccountsBind)
+	t.Run("APIKeys", testAPIKeysBind)
+	t.Run("AuditLogs", testAuditLogsBind)
+	t.Run("Invitations", testInvitationsBind)
+	t.Run("OrganisationMembers", testOrganisationMembersBind)
+	t.Run("Organisations", testOrganisationsBind)
+	t.Run("PasswordHistories", testPasswordHistoriesBind)
+	t.Run("Permissions", testPermissionsBind)
+	t.Run("RecoveryCodes", testRecoveryCodesBind)
+	t.Run("RefreshTokens", testRefreshTokensBind)
+	t.Run("RolePermissions", testRolePermissionsBind)
 	t.Run("Roles", testRolesBind)
 	t.Run("SchemaMigrations", testSchemaMigrationsBind)
+	t.Run("SigningKeys", testSigningKeysBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesOne)
 	t.Run("Accounts", testAccountsOne)
+	t.Run("APIKeys", testAPIKeysOne)
+	t.Run("AuditLogs", testAuditLogsOne)
+	t.Run("Invitations", testInvitationsOne)
+	t.Run("OrganisationMembers", testOrganisationMembersOne)
+	t.Run("Organisations", testOrganisationsOne)
+	t.Run("PasswordHistories", testPasswordHistoriesOne)
+	t.Run("Permissions", testPermissionsOne)
+	t.Run("RecoveryCodes", testRecoveryCodesOne)
+	t.Run("RefreshTokens", testRefreshTokensOne)
+	t.Run("RolePermissions", testRolePermissionsOne)
 	t.Run("Roles", testRolesOne)
 	t.Run("SchemaMigrations", testSchemaMigrationsOne)
+	t.Run("SigningKeys", testSigningKeysOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesAll)
 	t.Run("Accounts", testAccountsAll)
+	t.Run("APIKeys", testAPIKeysAll)
+	t.Run("AuditLogs", testAuditLogsAll)
+	t.Run("Invitations", testInvitationsAll)
+	t.Run("OrganisationMembers", testOrganisationMembersAll)
+	t.Run("Organisations", testOrganisationsAll)
+	t.Run("PasswordHistories", testPasswordHistoriesAll)
+	t.Run("Permissions", testPermissionsAll)
+	t.Run("RecoveryCodes", testRecoveryCodesAll)
+	t.Run("RefreshTokens", testRefreshTokensAll)
+	t.Run("RolePermissions", testRolePermissionsAll)
 	t.Run("Roles", testRolesAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsAll)
+	t.Run("SigningKeys", testSigningKeysAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesCount)
 	t.Run("Accounts", testAccountsCount)
+	t.Run("APIKeys", testAPIKeysCount)
+	t.Run("AuditLogs", testAuditLogsCount)
+	t.Run("Invitations", testInvitationsCount)
+	t.Run("OrganisationMembers", testOrganisationMembersCount)
+	t.Run("Organisations", testOrganisationsCount)
+	t.Run("PasswordHistories", testPasswordHistoriesCount)
+	t.Run("Permissions", testPermissionsCount)
+	t.Run("RecoveryCodes", testRecoveryCodesCount)
+	t.Run("RefreshTokens", testRefreshTokensCount)
+	t.Run("RolePermissions", testRolePermissionsCount)
 	t.Run("Roles", testRolesCount)
 	t.Run("SchemaMigrations", testSchemaMigrationsCount)
+	t.Run("SigningKeys", testSigningKeysCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesHooks)
 	t.Run("Accounts", testAccountsHooks)
+	t.Run("APIKeys", testAPIKeysHooks)
+	t.Run("AuditLogs", testAuditLogsHooks)
+	t.Run("Invitations", testInvitationsHooks)
+	t.Run("OrganisationMembers", testOrganisationMembersHooks)
+	t.Run("Organisations", testOrganisationsHooks)
+	t.Run("PasswordHistories", testPasswordHistoriesHooks)
+	t.Run("Permissions", testPermissionsHooks)
+	t.Run("RecoveryCodes", testRecoveryCodesHooks)
+	t.Run("RefreshTokens", testRefreshTokensHooks)
+	t.Run("RolePermissions", testRolePermissionsHooks)
 	t.Run("Roles", testRolesHooks)
 	t.Run("SchemaMigrations", testSchemaMigrationsHooks)
+	t.Run("SigningKeys", testSigningKeysHooks)
 }
 
 func TestInsert(t *testing.T) {
@@ -111,43 +265,120 @@ func TestInsert(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesInsertWhitelist)
 	t.Run("Accounts", testAccountsInsert)
 	t.Run("Accounts", testAccountsInsertWhitelist)
+	t.Run("APIKeys", testAPIKeysInsert)
+	t.Run("APIKeys", testAPIKeysInsertWhitelist)
+	t.Run("AuditLogs", testAuditLogsInsert)
+	t.Run("AuditLogs", testAuditLogsInsertWhitelist)
+	t.Run("Invitations", testInvitationsInsert)
+	t.Run("Invitations", testInvitationsInsertWhitelist)
+	t.Run("OrganisationMembers", testOrganisationMembersInsert)
+	t.Run("OrganisationMembers", testOrganisationMembersInsertWhitelist)
+	t.Run("Organisations", testOrganisationsInsert)
+	t.Run("Organisations", testOrganisationsInsertWhitelist)
+	t.Run("PasswordHistories", testPasswordHistoriesInsert)
+	t.Run("PasswordHistories", testPasswordHistoriesInsertWhitelist)
+	t.Run("Permissions", testPermissionsInsert)
+	t.Run("Permissions", testPermissionsInsertWhitelist)
+	t.Run("RecoveryCodes", testRecoveryCodesInsert)
+	t.Run("RecoveryCodes", testRecoveryCodesInsertWhitelist)
+	t.Run("RefreshTokens", testRefreshTokensInsert)
+	t.Run("RefreshTokens", testRefreshTokensInsertWhitelist)
+	t.Run("RolePermissions", testRolePermissionsInsert)
+	t.Run("RolePermissions", testRolePermissionsInsertWhitelist)
 	t.Run("Roles", testRolesInsert)
 	t.Run("Roles", testRolesInsertWhitelist)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsert)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsertWhitelist)
+	t.Run("SigningKeys", testSigningKeysInsert)
+	t.Run("SigningKeys", testSigningKeysInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesReload)
 	t.Run("Accounts", testAccountsReload)
+	t.Run("APIKeys", testAPIKeysReload)
+	t.Run("AuditLogs", testAuditLogsReload)
+	t.Run("Invitations", testInvitationsReload)
+	t.Run("OrganisationMembers", testOrganisationMembersReload)
+	t.Run("Organisations", testOrganisationsReload)
+	t.Run("PasswordHistories", testPasswordHistoriesReload)
+	t.Run("Permissions", testPermissionsReload)
+	t.Run("RecoveryCodes", testRecoveryCodesReload)
+	t.Run("RefreshTokens", testRefreshTokensReload)
+	t.Run("RolePermissions", testRolePermissionsReload)
 	t.Run("Roles", testRolesReload)
 	t.Run("SchemaMigrations", testSchemaMigrationsReload)
+	t.Run("SigningKeys", testSigningKeysReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesReloadAll)
 	t.Run("Accounts", testAccountsReloadAll)
+	t.Run("APIKeys", testAPIKeysReloadAll)
+	t.Run("AuditLogs", testAuditLogsReloadAll)
+	t.Run("Invitations", testInvitationsReloadAll)
+	t.Run("OrganisationMembers", testOrganisationMembersReloadAll)
+	t.Run("Organisations", testOrganisationsReloadAll)
+	t.Run("PasswordHistories", testPasswordHistoriesReloadAll)
+	t.Run("Permissions", testPermissionsReloadAll)
+	t.Run("RecoveryCodes", testRecoveryCodesReloadAll)
+	t.Run("RefreshTokens", testRefreshTokensReloadAll)
+	t.Run("RolePermissions", testRolePermissionsReloadAll)
 	t.Run("Roles", testRolesReloadAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsReloadAll)
+	t.Run("SigningKeys", testSigningKeysReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesSelect)
 	t.Run("Accounts", testAccountsSelect)
+	t.Run("APIKeys", testAPIKeysSelect)
+	t.Run("AuditLogs", testAuditLogsSelect)
+	t.Run("Invitations", testInvitationsSelect)
+	t.Run("OrganisationMembers", testOrganisationMembersSelect)
+	t.Run("Organisations", testOrganisationsSelect)
+	t.Run("PasswordHistories", testPasswordHistoriesSelect)
+	t.Run("Permissions", testPermissionsSelect)
+	t.Run("RecoveryCodes", testRecoveryCodesSelect)
+	t.Run("RefreshTokens", testRefreshTokensSelect)
+	t.Run("RolePermissions", testRolePermissionsSelect)
 	t.Run("Roles", testRolesSelect)
 	t.Run("SchemaMigrations", testSchemaMigrationsSelect)
+	t.Run("SigningKeys", testSigningKeysSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesUpdate)
 	t.Run("Accounts", testAccountsUpdate)
+	t.Run("APIKeys", testAPIKeysUpdate)
+	t.Run("AuditLogs", testAuditLogsUpdate)
+	t.Run("Invitations", testInvitationsUpdate)
+	t.Run("OrganisationMembers", testOrganisationMembersUpdate)
+	t.Run("Organisations", testOrganisationsUpdate)
+	t.Run("PasswordHistories", testPasswordHistoriesUpdate)
+	t.Run("Permissions", testPermissionsUpdate)
+	t.Run("RecoveryCodes", testRecoveryCodesUpdate)
+	t.Run("RefreshTokens", testRefreshTokensUpdate)
+	t.Run("RolePermissions", testRolePermissionsUpdate)
 	t.Run("Roles", testRolesUpdate)
 	t.Run("SchemaMigrations", testSchemaMigrationsUpdate)
+	t.Run("SigningKeys", testSigningKeysUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AccountRoles", testAccountRolesSliceUpdateAll)
 	t.Run("Accounts", testAccountsSliceUpdateAll)
+	t.Run("APIKeys", testAPIKeysSliceUpdateAll)
+	t.Run("AuditLogs", testAuditLogsSliceUpdateAll)
+	t.Run("Invitations", testInvitationsSliceUpdateAll)
+	t.Run("OrganisationMembers", testOrganisationMembersSliceUpdateAll)
+	t.Run("Organisations", testOrganisationsSliceUpdateAll)
+	t.Run("PasswordHistories", testPasswordHistoriesSliceUpdateAll)
+	t.Run("Permissions", testPermissionsSliceUpdateAll)
+	t.Run("RecoveryCodes", testRecoveryCodesSliceUpdateAll)
+	t.Run("RefreshTokens", testRefreshTokensSliceUpdateAll)
+	t.Run("RolePermissions", testRolePermissionsSliceUpdateAll)
 	t.Run("Roles", testRolesSliceUpdateAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceUpdateAll)
+	t.Run("SigningKeys", testSigningKeysSliceUpdateAll)
 }
@@ -4,13 +4,35 @@
 package psqlmodel
 
 var TableNames = struct {
-	AccountRoles     string
-	Accounts         string
-	Roles            string
-	SchemaMigrations string
+	AccountRoles        string
+	Accounts            string
+	APIKeys             string
+	AuditLogs           string
+	Invitations         string
+	OrganisationMembers string
+	Organisations       string
+	PasswordHistories   string
+	Permissions         string
+	RecoveryCodes       string
+	RefreshTokens       string
+	RolePermissions     string
+	Roles               string
+	SchemaMigrations    string
+	SigningKeys         string
 }{
-	AccountRoles:     "account_roles",
-	Accounts:         "accounts",
-	Roles:            "roles",
-	SchemaMigrations: "schema_migrations",
+	AccountRoles:        "account_roles",
+	Accounts:            "accounts",
+	APIKeys:             "api_keys",
+	AuditLogs:           "audit_logs",
+	Invitations:         "invitations",
+	OrganisationMembers: "organisation_members",
+	Organisations:       "organisations",
+	PasswordHistories:   "password_histories",
+	Permissions:         "permissions",
+	RecoveryCodes:       "recovery_codes",
+	RefreshTokens:       "refresh_tokens",
+	RolePermissions:     "role_permissions",
+	Roles:               "roles",
+	SchemaMigrations:    "schema_migrations",
+	SigningKeys:         "signing_keys",
 }
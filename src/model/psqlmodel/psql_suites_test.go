@@ -10,7 +10,29 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("Accounts", testAccountsUpsert)
 
+	t.Run("APIKeys", testAPIKeysUpsert)
+
+	t.Run("AuditLogs", testAuditLogsUpsert)
+
+	t.Run("Invitations", testInvitationsUpsert)
+
+	t.Run("OrganisationMembers", testOrganisationMembersUpsert)
+
+	t.Run("Organisations", testOrganisationsUpsert)
+
+	t.Run("PasswordHistories", testPasswordHistoriesUpsert)
+
+	t.Run("Permissions", testPermissionsUpsert)
+
+	t.Run("RecoveryCodes", testRecoveryCodesUpsert)
+
+	t.Run("RefreshTokens", testRefreshTokensUpsert)
+
+	t.Run("RolePermissions", testRolePermissionsUpsert)
+
 	t.Run("Roles", testRolesUpsert)
 
 	t.Run("SchemaMigrations", testSchemaMigrationsUpsert)
+
+	t.Run("SigningKeys", testSigningKeysUpsert)
 }
@@ -24,105 +24,151 @@ import (
 
 // Role is an object representing the database table.
 type Role struct {
-	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Scope     string    `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
-	Cid       string    `boil:"cid" json:"cid" toml:"cid" yaml:"cid"`
-	Sec       string    `boil:"sec" json:"sec" toml:"sec" yaml:"sec"`
-	CreatedBy int       `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedBy int       `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedBy null.Int  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
-	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID                 int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Scope              string    `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
+	Cid                string    `boil:"cid" json:"cid" toml:"cid" yaml:"cid"`
+	Sec                string    `boil:"sec" json:"sec" toml:"sec" yaml:"sec"`
+	CreatedBy          int       `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt          time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedBy          int       `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	UpdatedAt          time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedBy          null.Int  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	DeletedAt          null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	RedirectUris       string    `boil:"redirect_uris" json:"redirect_uris" toml:"redirect_uris" yaml:"redirect_uris"`
+	MfaRequired        bool      `boil:"mfa_required" json:"mfa_required" toml:"mfa_required" yaml:"mfa_required"`
+	PasswordMaxAgeDays int       `boil:"password_max_age_days" json:"password_max_age_days" toml:"password_max_age_days" yaml:"password_max_age_days"`
+	PublicClient       bool      `boil:"public_client" json:"public_client" toml:"public_client" yaml:"public_client"`
 
 	R *roleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L roleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var RoleColumns = struct {
-	ID        string
-	Scope     string
-	Cid       string
-	Sec       string
-	CreatedBy string
-	CreatedAt string
-	UpdatedBy string
-	UpdatedAt string
-	DeletedBy string
-	DeletedAt string
+	ID                 string
+	Scope              string
+	Cid                string
+	Sec                string
+	CreatedBy          string
+	CreatedAt          string
+	UpdatedBy          string
+	UpdatedAt          string
+	DeletedBy          string
+	DeletedAt          string
+	RedirectUris       string
+	MfaRequired        string
+	PasswordMaxAgeDays string
+	PublicClient       string
 }{
-	ID:        "id",
-	Scope:     "scope",
-	Cid:       "cid",
-	Sec:       "sec",
-	CreatedBy: "created_by",
-	CreatedAt: "created_at",
-	UpdatedBy: "updated_by",
-	UpdatedAt: "updated_at",
-	DeletedBy: "deleted_by",
-	DeletedAt: "deleted_at",
+	ID:                 "id",
+	Scope:              "scope",
+	Cid:                "cid",
+	Sec:                "sec",
+	CreatedBy:          "created_by",
+	CreatedAt:          "created_at",
+	UpdatedBy:          "updated_by",
+	UpdatedAt:          "updated_at",
+	DeletedBy:          "deleted_by",
+	DeletedAt:          "deleted_at",
+	RedirectUris:       "redirect_uris",
+	MfaRequired:        "mfa_required",
+	PasswordMaxAgeDays: "password_max_age_days",
+	PublicClient:       "public_client",
 }
 
 var RoleTableColumns = struct {
-	ID        string
-	Scope     string
-	Cid       string
-	Sec       string
-	CreatedBy string
-	CreatedAt string
-	UpdatedBy string
-	UpdatedAt string
-	DeletedBy string
-	DeletedAt string
+	ID                 string
+	Scope              string
+	Cid                string
+	Sec                string
+	CreatedBy          string
+	CreatedAt          string
+	UpdatedBy          string
+	UpdatedAt          string
+	DeletedBy          string
+	DeletedAt          string
+	RedirectUris       string
+	MfaRequired        string
+	PasswordMaxAgeDays string
+	PublicClient       string
 }{
-	ID:        "roles.id",
-	Scope:     "roles.scope",
-	Cid:       "roles.cid",
-	Sec:       "roles.sec",
-	CreatedBy: "roles.created_by",
-	CreatedAt: "roles.created_at",
-	UpdatedBy: "roles.updated_by",
-	UpdatedAt: "roles.updated_at",
-	DeletedBy: "roles.deleted_by",
-	DeletedAt: "roles.deleted_at",
+	ID:                 "roles.id",
+	Scope:              "roles.scope",
+	Cid:                "roles.cid",
+	Sec:                "roles.sec",
+	CreatedBy:          "roles.created_by",
+	CreatedAt:          "roles.created_at",
+	UpdatedBy:          "roles.updated_by",
+	UpdatedAt:          "roles.updated_at",
+	DeletedBy:          "roles.deleted_by",
+	DeletedAt:          "roles.deleted_at",
+	RedirectUris:       "roles.redirect_uris",
+	MfaRequired:        "roles.mfa_required",
+	PasswordMaxAgeDays: "roles.password_max_age_days",
+	PublicClient:       "roles.public_client",
 }
 
 // Generated where
 
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
 var RoleWhere = struct {
-	ID        whereHelperint
-	Scope     whereHelperstring
-	Cid       whereHelperstring
-	Sec       whereHelperstring
-	CreatedBy whereHelperint
-	CreatedAt whereHelpertime_Time
-	UpdatedBy whereHelperint
-	UpdatedAt whereHelpertime_Time
-	DeletedBy whereHelpernull_Int
-	DeletedAt whereHelpernull_Time
+	ID                 whereHelperint
+	Scope              whereHelperstring
+	Cid                whereHelperstring
+	Sec                whereHelperstring
+	CreatedBy          whereHelperint
+	CreatedAt          whereHelpertime_Time
+	UpdatedBy          whereHelperint
+	UpdatedAt          whereHelpertime_Time
+	DeletedBy          whereHelpernull_Int
+	DeletedAt          whereHelpernull_Time
+	RedirectUris       whereHelperstring
+	MfaRequired        whereHelperbool
+	PasswordMaxAgeDays whereHelperint
+	PublicClient       whereHelperbool
 }{
-	ID:        whereHelperint{field: "\"roles\".\"id\""},
-	Scope:     whereHelperstring{field: "\"roles\".\"scope\""},
-	Cid:       whereHelperstring{field: "\"roles\".\"cid\""},
-	Sec:       whereHelperstring{field: "\"roles\".\"sec\""},
-	CreatedBy: whereHelperint{field: "\"roles\".\"created_by\""},
-	CreatedAt: whereHelpertime_Time{field: "\"roles\".\"created_at\""},
-	UpdatedBy: whereHelperint{field: "\"roles\".\"updated_by\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"roles\".\"updated_at\""},
-	DeletedBy: whereHelpernull_Int{field: "\"roles\".\"deleted_by\""},
-	DeletedAt: whereHelpernull_Time{field: "\"roles\".\"deleted_at\""},
+	ID:                 whereHelperint{field: "\"roles\".\"id\""},
+	Scope:              whereHelperstring{field: "\"roles\".\"scope\""},
+	Cid:                whereHelperstring{field: "\"roles\".\"cid\""},
+	Sec:                whereHelperstring{field: "\"roles\".\"sec\""},
+	CreatedBy:          whereHelperint{field: "\"roles\".\"created_by\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"roles\".\"created_at\""},
+	UpdatedBy:          whereHelperint{field: "\"roles\".\"updated_by\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"roles\".\"updated_at\""},
+	DeletedBy:          whereHelpernull_Int{field: "\"roles\".\"deleted_by\""},
+	DeletedAt:          whereHelpernull_Time{field: "\"roles\".\"deleted_at\""},
+	RedirectUris:       whereHelperstring{field: "\"roles\".\"redirect_uris\""},
+	MfaRequired:        whereHelperbool{field: "\"roles\".\"mfa_required\""},
+	PasswordMaxAgeDays: whereHelperint{field: "\"roles\".\"password_max_age_days\""},
+	PublicClient:       whereHelperbool{field: "\"roles\".\"public_client\""},
 }
 
 // RoleRels is where relationship names are stored.
 var RoleRels = struct {
-	AccountRoles string
+	AccountRoles    string
+	Invitations     string
+	RefreshTokens   string
+	RolePermissions string
 }{
-	AccountRoles: "AccountRoles",
+	AccountRoles:    "AccountRoles",
+	Invitations:     "Invitations",
+	RefreshTokens:   "RefreshTokens",
+	RolePermissions: "RolePermissions",
 }
 
 // roleR is where relationships are stored.
 type roleR struct {
-	AccountRoles AccountRoleSlice `boil:"AccountRoles" json:"AccountRoles" toml:"AccountRoles" yaml:"AccountRoles"`
+	AccountRoles    AccountRoleSlice    `boil:"AccountRoles" json:"AccountRoles" toml:"AccountRoles" yaml:"AccountRoles"`
+	Invitations     InvitationSlice     `boil:"Invitations" json:"Invitations" toml:"Invitations" yaml:"Invitations"`
+	RefreshTokens   RefreshTokenSlice   `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+	RolePermissions RolePermissionSlice `boil:"RolePermissions" json:"RolePermissions" toml:"RolePermissions" yaml:"RolePermissions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -137,13 +183,34 @@ func (r *roleR) GetAccountRoles() AccountRoleSlice {
 	return r.AccountRoles
 }
 
+func (r *roleR) GetInvitations() InvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Invitations
+}
+
+func (r *roleR) GetRefreshTokens() RefreshTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RefreshTokens
+}
+
+func (r *roleR) GetRolePermissions() RolePermissionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RolePermissions
+}
+
 // roleL is where Load methods for each relationship are stored.
 type roleL struct{}
 
 var (
-	roleAllColumns            = []string{"id", "scope", "cid", "sec", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	roleAllColumns            = []string{"id", "scope", "cid", "sec", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "redirect_uris", "mfa_required", "password_max_age_days", "public_client"}
 	roleColumnsWithoutDefault = []string{"scope", "cid", "sec"}
-	roleColumnsWithDefault    = []string{"id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	roleColumnsWithDefault    = []string{"id", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at", "redirect_uris", "mfa_required", "password_max_age_days", "public_client"}
 	rolePrimaryKeyColumns     = []string{"id"}
 	roleGeneratedColumns      = []string{}
 )
@@ -487,6 +554,48 @@ func (o *Role) AccountRoles(mods ...qm.QueryMod) accountRoleQuery {
 	return AccountRoles(queryMods...)
 }
 
+// Invitations retrieves all the invitation's Invitations with an executor.
+func (o *Role) Invitations(mods ...qm.QueryMod) invitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"invitations\".\"role_id\"=?", o.ID),
+	)
+
+	return Invitations(queryMods...)
+}
+
+// RefreshTokens retrieves all the refresh_token's RefreshTokens with an executor.
+func (o *Role) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"refresh_tokens\".\"role_id\"=?", o.ID),
+	)
+
+	return RefreshTokens(queryMods...)
+}
+
+// RolePermissions retrieves all the role_permission's RolePermissions with an executor.
+func (o *Role) RolePermissions(mods ...qm.QueryMod) rolePermissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"role_permissions\".\"role_id\"=?", o.ID),
+	)
+
+	return RolePermissions(queryMods...)
+}
+
 // LoadAccountRoles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (roleL) LoadAccountRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
@@ -601,6 +710,348 @@ func (roleL) LoadAccountRoles(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
+// LoadInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`invitations`),
+		qm.WhereIn(`invitations.role_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`invitations.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load invitations")
+	}
+
+	var resultSlice []*Invitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for invitations")
+	}
+
+	if len(invitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Invitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &invitationR{}
+			}
+			foreign.R.Role = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoleID {
+				local.R.Invitations = append(local.R.Invitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &invitationR{}
+				}
+				foreign.R.Role = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_tokens`),
+		qm.WhereIn(`refresh_tokens.role_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`refresh_tokens.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_tokens")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+	}
+
+	if len(refreshTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.Role = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoleID {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.Role = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRolePermissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadRolePermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`role_permissions`),
+		qm.WhereIn(`role_permissions.role_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`role_permissions.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load role_permissions")
+	}
+
+	var resultSlice []*RolePermission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice role_permissions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on role_permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for role_permissions")
+	}
+
+	if len(rolePermissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RolePermissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rolePermissionR{}
+			}
+			foreign.R.Role = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoleID {
+				local.R.RolePermissions = append(local.R.RolePermissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &rolePermissionR{}
+				}
+				foreign.R.Role = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // AddAccountRolesG adds the given related objects to the existing relationships
 // of the role, optionally inserting them as new records.
 // Appends related to o.R.AccountRoles.
@@ -663,6 +1114,192 @@ func (o *Role) AddAccountRoles(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
+// AddInvitationsG adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.Invitations.
+// Sets related.R.Role appropriately.
+// Uses the global database handle.
+func (o *Role) AddInvitationsG(ctx context.Context, insert bool, related ...*Invitation) error {
+	return o.AddInvitations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddInvitations adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.Invitations.
+// Sets related.R.Role appropriately.
+func (o *Role) AddInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, invitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roleR{
+			Invitations: related,
+		}
+	} else {
+		o.R.Invitations = append(o.R.Invitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &invitationR{
+				Role: o,
+			}
+		} else {
+			rel.R.Role = o
+		}
+	}
+	return nil
+}
+
+// AddRefreshTokensG adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.Role appropriately.
+// Uses the global database handle.
+func (o *Role) AddRefreshTokensG(ctx context.Context, insert bool, related ...*RefreshToken) error {
+	return o.AddRefreshTokens(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRefreshTokens adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.Role appropriately.
+func (o *Role) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roleR{
+			RefreshTokens: related,
+		}
+	} else {
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &refreshTokenR{
+				Role: o,
+			}
+		} else {
+			rel.R.Role = o
+		}
+	}
+	return nil
+}
+
+// AddRolePermissionsG adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.RolePermissions.
+// Sets related.R.Role appropriately.
+// Uses the global database handle.
+func (o *Role) AddRolePermissionsG(ctx context.Context, insert bool, related ...*RolePermission) error {
+	return o.AddRolePermissions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddRolePermissions adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.RolePermissions.
+// Sets related.R.Role appropriately.
+func (o *Role) AddRolePermissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RolePermission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"role_permissions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rolePermissionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roleR{
+			RolePermissions: related,
+		}
+	} else {
+		o.R.RolePermissions = append(o.R.RolePermissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rolePermissionR{
+				Role: o,
+			}
+		} else {
+			rel.R.Role = o
+		}
+	}
+	return nil
+}
+
 // Roles retrieves all the records using an executor.
 func Roles(mods ...qm.QueryMod) roleQuery {
 	mods = append(mods, qm.From("\"roles\""), qmhelper.WhereIsNull("\"roles\".\"deleted_at\""))
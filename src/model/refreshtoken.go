@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	DefaultRefreshTokenExpiration time.Duration = 30 * 24 * time.Hour
+)
+
+type GetRefreshTokenByParam struct {
+	ID        null.Int64  `json:"id"`
+	TokenHash null.String `json:"token_hash"`
+	Family    null.String `json:"family"`
+}
+
+func (g *GetRefreshTokenByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.TokenHash.Valid {
+		res = append(res, qm.Where("token_hash=?", g.TokenHash.String))
+	}
+
+	if g.Family.Valid {
+		res = append(res, qm.Where("family=?", g.Family.String))
+	}
+	return res
+}
@@ -18,10 +18,11 @@ type TransactionInfo struct {
 }
 
 type Response struct {
-	TransactionInfo TransactionInfo `json:"transaction_info"`
-	Code            int64           `json:"status_code"`
-	Message         string          `json:"message,omitempty"`
-	Translation     *Translation    `json:"translation,omitempty"`
+	TransactionInfo TransactionInfo     `json:"transaction_info"`
+	Code            int64               `json:"status_code"`
+	Message         string              `json:"message,omitempty"`
+	Translation     *Translation        `json:"translation,omitempty"`
+	Violations      []PasswordViolation `json:"violations,omitempty"`
 }
 
 type Translation struct {
@@ -51,6 +52,7 @@ func (r *RegisterResponse) Transform(ctx *gin.Context, log logger.Logger, code i
 		r.Response.Message = getErrMsg.WrappedMessage.Message
 		translation := Translation(getErrMsg.WrappedMessage.Translation)
 		r.Response.Translation = &translation
+		r.Response.Violations = GetPasswordViolations(err)
 	}
 
 	return int(r.Response.Code)
@@ -107,6 +109,7 @@ func (r *SingleAccountResponse) Transform(ctx *gin.Context, log logger.Logger, c
 		r.Response.Message = getErrMsg.WrappedMessage.Message
 		translation := Translation(getErrMsg.WrappedMessage.Translation)
 		r.Response.Translation = &translation
+		r.Response.Violations = GetPasswordViolations(err)
 	}
 
 	return int(r.Response.Code)
@@ -134,6 +137,7 @@ func (r *EmptyResponse) Transform(ctx *gin.Context, log logger.Logger, code int,
 		r.Response.Message = getErrMsg.WrappedMessage.Message
 		translation := Translation(getErrMsg.WrappedMessage.Translation)
 		r.Response.Translation = &translation
+		r.Response.Violations = GetPasswordViolations(err)
 	}
 
 	return int(r.Response.Code)
@@ -293,3 +297,458 @@ func (r *AccountRolesResponse) Transform(ctx *gin.Context, log logger.Logger, co
 
 	return int(r.Response.Code)
 }
+
+type MFAEnrollmentResponse struct {
+	Response
+	Data MFAEnrollment `json:"data"`
+}
+
+func (r *MFAEnrollmentResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type RecoveryCodesResponse struct {
+	Response
+	Data RecoveryCodes `json:"data"`
+}
+
+func (r *RecoveryCodesResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type SinglePermissionResponse struct {
+	Response
+	Data Permission `json:"data"`
+}
+
+func (r *SinglePermissionResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type PermissionsResponse struct {
+	Response
+	Data       []Permission `json:"data"`
+	Pagination Pagination   `json:"pagination"`
+}
+
+func (r *PermissionsResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []Permission{}
+	}
+
+	return int(r.Response.Code)
+}
+
+type SingleRolePermissionResponse struct {
+	Response
+	Data RolePermission `json:"data"`
+}
+
+func (r *SingleRolePermissionResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type RolePermissionsResponse struct {
+	Response
+	Data       []RolePermission `json:"data"`
+	Pagination Pagination       `json:"pagination"`
+}
+
+func (r *RolePermissionsResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []RolePermission{}
+	}
+
+	return int(r.Response.Code)
+}
+
+type SingleOrganisationResponse struct {
+	Response
+	Data Organisation `json:"data"`
+}
+
+func (r *SingleOrganisationResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type OrganisationsResponse struct {
+	Response
+	Data       []Organisation `json:"data"`
+	Pagination Pagination     `json:"pagination"`
+}
+
+func (r *OrganisationsResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []Organisation{}
+	}
+
+	return int(r.Response.Code)
+}
+
+type SingleOrganisationMemberResponse struct {
+	Response
+	Data OrganisationMember `json:"data"`
+}
+
+func (r *SingleOrganisationMemberResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type OrganisationMembersResponse struct {
+	Response
+	Data       []OrganisationMember `json:"data"`
+	Pagination Pagination           `json:"pagination"`
+}
+
+func (r *OrganisationMembersResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []OrganisationMember{}
+	}
+
+	return int(r.Response.Code)
+}
+
+type SingleInvitationResponse struct {
+	Response
+	Data Invitation `json:"data"`
+}
+
+func (r *SingleInvitationResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type InvitationsResponse struct {
+	Response
+	Data       []Invitation `json:"data"`
+	Pagination Pagination   `json:"pagination"`
+}
+
+func (r *InvitationsResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []Invitation{}
+	}
+
+	return int(r.Response.Code)
+}
+
+type AuditLogsResponse struct {
+	Response
+	Data       []AuditLog `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (r *AuditLogsResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []AuditLog{}
+	}
+
+	return int(r.Response.Code)
+}
+
+type CreatedAPIKeyResponse struct {
+	Response
+	Data CreatedAPIKey `json:"data"`
+}
+
+func (r *CreatedAPIKeyResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	return int(r.Response.Code)
+}
+
+type APIKeysResponse struct {
+	Response
+	Data       []APIKey   `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (r *APIKeysResponse) Transform(ctx *gin.Context, log logger.Logger, code int, err error) int {
+	r.Response = Response{
+		TransactionInfo: TransactionInfo{
+			RequestURI:    ctx.Request.RequestURI,
+			RequestMethod: ctx.Request.Method,
+			RequestID:     ctx.GetHeader("x-request-id"),
+			Timestamp:     time.Now(),
+		},
+		Code: int64(code),
+	}
+	if err != nil {
+		getErrMsg := errormsg.GetErrorData(err)
+		r.Response.TransactionInfo.ErrorCode = getErrMsg.Code
+		log.Error(ctx, errormsg.WriteErr(err))
+		r.Response.Code = getErrMsg.WrappedMessage.StatusCode
+		r.Response.Message = getErrMsg.WrappedMessage.Message
+		translation := Translation(getErrMsg.WrappedMessage.Translation)
+		r.Response.Translation = &translation
+	}
+
+	if len(r.Data) == 0 {
+		r.Data = []APIKey{}
+	}
+
+	return int(r.Response.Code)
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+)
+
+var (
+	RestrictionMFAEnrollment         string        = "mfa_enrollment"
+	RestrictionPasswordExpired       string        = "password_expired"
+	DefaultRestrictedTokenExpiration time.Duration = 10 * time.Minute
+	JWTTypeRestrictedToken           string        = "restricted+jwt"
+)
+
+// RestrictedRoutes lists, per restriction claim, the only routes a
+// restricted access token may call. Routes are "METHOD full-path" as
+// reported by gin.
+var RestrictedRoutes = map[string][]string{
+	RestrictionMFAEnrollment:   {"POST /api/me/mfa", "POST /api/me/mfa/confirm"},
+	RestrictionPasswordExpired: {"PUT /api/me/password"},
+}
+
+// AcceptsRestrictedToken reports whether route is listed for any
+// restriction, the only routes a restricted token is parsed for.
+func AcceptsRestrictedToken(route string) bool {
+	for _, routes := range RestrictedRoutes {
+		if common.FindStrInSlice(route, routes) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+)
+
+var (
+	RevokedTokenKey           string = "revokedToken:%s"
+	RevokedAccountKey         string = "revokedAccount:%d"
+	UsedTokenKey              string = "usedToken:%s"
+	TokenTypeHintAccessToken  string = "access_token"
+	TokenTypeHintRefreshToken string = "refresh_token"
+	RevocationEndpointPath    string = "/oauth2/revoke"
+	IntrospectionEndpointPath string = "/oauth2/introspect"
+)
+
+// TokenRequest is the body of the revocation (RFC 7009) and introspection
+// (RFC 7662) endpoints. Both require an authenticated client.
+type TokenRequest struct {
+	Token         string
+	TokenTypeHint string
+	ClientID      string
+	ClientSecret  string
+}
+
+func (t *TokenRequest) Validate() error {
+	if t.ClientID == "" || t.ClientSecret == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidClientIDClientSecret, nil, "invalid empty client id/client secret")
+	}
+
+	if t.Token == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "invalid empty token")
+	}
+	return nil
+}
+
+// Introspection is the RFC 7662 response. Only Active is set for tokens
+// that are expired, revoked, malformed or unknown.
+type Introspection struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+}
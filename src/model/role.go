@@ -1,8 +1,10 @@
 package model
 
 import (
+	"net/url"
 	"strings"
 
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
@@ -14,11 +16,38 @@ var (
 	GetSingleByParamRoleKey string = "gspRole:%s"
 	GetByParamRoleKey       string = "gpRole:%s"
 	GetByParamRolePgKey     string = "gppgRole:%s"
+	RoleCacheTag            string = "tagRole:%d"
+	RoleListCacheTag        string = "tagRole:list"
 	SuperAdminScope         string = "sup"
 	StoreScope              string = "sto"
 	CustomerScope           string = "cus"
+	// caps the roles of one account that are looked up for a token
+	MaxTokenRoles int64 = 100
 )
 
+// RoleScopes returns the role scopes, like sup, in a space-delimited scope
+// request, leaving out the OpenID Connect scopes.
+func RoleScopes(scope string) []string {
+	var res []string
+	for _, s := range strings.Fields(scope) {
+		if !common.FindStrInSlice(s, OpenIDSupportedScopes) {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// RolesScope joins the scopes of roles into a space-delimited scope claim.
+func RolesScope(roles []psqlmodel.Role) string {
+	var scopes []string
+	for _, r := range roles {
+		if !common.FindStrInSlice(r.Scope, scopes) {
+			scopes = append(scopes, r.Scope)
+		}
+	}
+	return strings.Join(scopes, " ")
+}
+
 type GetRoleByParam struct {
 	ID    null.Int64  `schema:"id" json:"id"`
 	Scope null.String `schema:"scope" json:"scope"`
@@ -43,11 +72,13 @@ func (g *GetRoleByParam) GetQuery() []qm.QueryMod {
 
 type GetRolesByParam struct {
 	GetRoleByParam
-	OrderBy null.String `schema:"order_by" json:"order_by"`
-	Limit   int64       `schema:"limit" json:"limit"`
-	Page    int64       `schema:"page" json:"page"`
+	PageParam
 }
 
+// roleOrderColumns leave out the client secret, the order of the rows
+// would tell about it.
+var roleOrderColumns = []string{"id", "scope", "cid", "created_at", "updated_at"}
+
 func (g *GetRolesByParam) GetQuery() []qm.QueryMod {
 	var res []qm.QueryMod
 	if g.ID.Valid {
@@ -63,20 +94,21 @@ func (g *GetRolesByParam) GetQuery() []qm.QueryMod {
 	}
 
 	if g.OrderBy.Valid {
-		order := strings.Split(g.OrderBy.String, ",")
-		for _, o := range order {
-			res = append(res, qm.OrderBy(o))
-		}
+		res = append(res, OrderBy(g.OrderBy.String, roleOrderColumns...)...)
 	}
 
 	return res
 }
 
 type CreateRole struct {
-	Scope     string `json:"scope"`
-	Cid       string `json:"client_id"`
-	Sec       string `json:"client_secret"`
-	CreatedBy int64  `json:"-"`
+	Scope              string   `json:"scope"`
+	Cid                string   `json:"client_id"`
+	Sec                string   `json:"client_secret"`
+	RedirectURIs       []string `json:"redirect_uris"`
+	MFARequired        bool     `json:"mfa_required"`
+	PasswordMaxAgeDays int      `json:"password_max_age_days"`
+	PublicClient       bool     `json:"public_client"`
+	CreatedBy          int64    `json:"-"`
 }
 
 func (v *CreateRole) Validate() error {
@@ -91,14 +123,18 @@ func (v *CreateRole) Validate() error {
 	if v.Cid == "" {
 		return errormsg.WrapErr(svcerr.AccountSVCInvalidClientIDClientSecret, nil, "invalid scope")
 	}
-	return nil
+	return ValidateRedirectURIs(v.RedirectURIs)
 }
 
 type UpdateRole struct {
-	Scope     null.String `json:"scope"`
-	Cid       null.String `json:"client_id"`
-	Sec       null.String `json:"client_secret"`
-	UpdatedBy int64       `json:"-"`
+	Scope              null.String `json:"scope"`
+	Cid                null.String `json:"client_id"`
+	Sec                null.String `json:"client_secret"`
+	RedirectURIs       []string    `json:"redirect_uris"`
+	MFARequired        null.Bool   `json:"mfa_required"`
+	PasswordMaxAgeDays null.Int    `json:"password_max_age_days"`
+	PublicClient       null.Bool   `json:"public_client"`
+	UpdatedBy          int64       `json:"-"`
 }
 
 func (v *UpdateRole) FillEntity(role *psqlmodel.Role) {
@@ -113,12 +149,55 @@ func (v *UpdateRole) FillEntity(role *psqlmodel.Role) {
 	if v.Sec.Valid {
 		role.Sec = v.Sec.String
 	}
+
+	if v.RedirectURIs != nil {
+		role.RedirectUris = strings.Join(v.RedirectURIs, " ")
+	}
+
+	if v.MFARequired.Valid {
+		role.MfaRequired = v.MFARequired.Bool
+	}
+
+	if v.PasswordMaxAgeDays.Valid {
+		role.PasswordMaxAgeDays = v.PasswordMaxAgeDays.Int
+	}
+
+	if v.PublicClient.Valid {
+		role.PublicClient = v.PublicClient.Bool
+	}
+}
+
+// ValidateRedirectURIs checks the redirect URIs registered for a client.
+// They must be absolute and carry no fragment (RFC 6749 section 3.1.2).
+func ValidateRedirectURIs(uris []string) error {
+	for _, v := range uris {
+		u, err := url.Parse(v)
+		if err != nil || !u.IsAbs() || u.Fragment != "" || strings.ContainsAny(v, " \t\n") {
+			return errormsg.WrapErr(svcerr.AccountSVCInvalidRedirectURI, err, "invalid redirect uri")
+		}
+	}
+	return nil
+}
+
+// HasRedirectURI reports whether uri is registered for role. Matching is an
+// exact string comparison as recommended for public clients.
+func HasRedirectURI(role *psqlmodel.Role, uri string) bool {
+	for _, v := range strings.Fields(role.RedirectUris) {
+		if v == uri {
+			return true
+		}
+	}
+	return false
 }
 
 type Role struct {
-	ID    int64  `json:"id"`
-	Scope string `json:"scope"`
-	Cid   string `json:"client_id"`
+	ID                 int64    `json:"id"`
+	Scope              string   `json:"scope"`
+	Cid                string   `json:"client_id"`
+	RedirectURIs       []string `json:"redirect_uris"`
+	MFARequired        bool     `json:"mfa_required"`
+	PasswordMaxAgeDays int      `json:"password_max_age_days"`
+	PublicClient       bool     `json:"public_client"`
 	BaseInformation
 }
 
@@ -133,10 +212,14 @@ func TransformPSQLSingleRole(role *psqlmodel.Role) Role {
 	}
 
 	return Role{
-		ID:              int64(role.ID),
-		Scope:           role.Scope,
-		Cid:             role.Cid,
-		BaseInformation: creationInfo,
+		ID:                 int64(role.ID),
+		Scope:              role.Scope,
+		Cid:                role.Cid,
+		RedirectURIs:       strings.Fields(role.RedirectUris),
+		MFARequired:        role.MfaRequired,
+		PasswordMaxAgeDays: role.PasswordMaxAgeDays,
+		PublicClient:       role.PublicClient,
+		BaseInformation:    creationInfo,
 	}
 }
 
@@ -153,10 +236,14 @@ func TransformPSQLRole(role *psqlmodel.RoleSlice) []Role {
 		}
 
 		res = append(res, Role{
-			ID:              int64(v.ID),
-			Scope:           v.Scope,
-			Cid:             v.Cid,
-			BaseInformation: creationInfo,
+			ID:                 int64(v.ID),
+			Scope:              v.Scope,
+			Cid:                v.Cid,
+			RedirectURIs:       strings.Fields(v.RedirectUris),
+			MFARequired:        v.MfaRequired,
+			PasswordMaxAgeDays: v.PasswordMaxAgeDays,
+			PublicClient:       v.PublicClient,
+			BaseInformation:    creationInfo,
 		})
 	}
 
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	GetSingleByParamRolePermissionKey string = "gspRolePermission:%s"
+	GetByParamRolePermissionKey       string = "gpRolePermission:%s"
+	GetByParamRolePermissionPgKey     string = "gppgRolePermission:%s"
+	RolePermissionCacheTag            string = "tagRolePermission:%d"
+	RolePermissionListCacheTag        string = "tagRolePermission:list"
+	PermissionNamesByRoleKey          string = "permissionNames:%d"
+)
+
+type GetRolePermissionByParam struct {
+	ID           null.Int64 `schema:"id" json:"id"`
+	RoleID       null.Int64 `schema:"role_id" json:"role_id"`
+	PermissionID null.Int64 `schema:"permission_id" json:"permission_id"`
+}
+
+func (g *GetRolePermissionByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.ID.Valid {
+		res = append(res, qm.Where("id=?", g.ID.Int64))
+	}
+
+	if g.RoleID.Valid {
+		res = append(res, qm.Where("role_id=?", g.RoleID.Int64))
+	}
+
+	if g.PermissionID.Valid {
+		res = append(res, qm.Where("permission_id=?", g.PermissionID.Int64))
+	}
+	return res
+}
+
+type GetRolePermissionsByParam struct {
+	GetRolePermissionByParam
+	PageParam
+}
+
+var rolePermissionOrderColumns = []string{"id", "role_id", "permission_id", "created_at", "updated_at"}
+
+func (g *GetRolePermissionsByParam) GetQuery() []qm.QueryMod {
+	res := g.GetRolePermissionByParam.GetQuery()
+	if g.OrderBy.Valid {
+		res = append(res, OrderBy(g.OrderBy.String, rolePermissionOrderColumns...)...)
+	}
+
+	return res
+}
+
+type CreateRolePermission struct {
+	RoleID       int64 `json:"role_id"`
+	PermissionID int64 `json:"permission_id"`
+	CreatedBy    int64 `json:"-"`
+}
+
+func (v *CreateRolePermission) Validate() error {
+	if v.RoleID == 0 {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "invalid role id")
+	}
+
+	if v.PermissionID == 0 {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidPermission, nil, "invalid permission id")
+	}
+	return nil
+}
+
+type RolePermission struct {
+	ID           int64 `json:"id"`
+	RoleID       int64 `json:"role_id"`
+	PermissionID int64 `json:"permission_id"`
+	BaseInformation
+}
+
+func TransformPSQLSingleRolePermission(rolePermission *psqlmodel.RolePermission) RolePermission {
+	creationInfo := BaseInformation{
+		CreatedBy: int64(rolePermission.CreatedBy),
+		CreatedAt: rolePermission.CreatedAt,
+		UpdatedBy: int64(rolePermission.UpdatedBy),
+		UpdatedAt: rolePermission.UpdatedAt,
+		DeletedBy: int64(rolePermission.DeletedBy.Int),
+		DeletedAt: rolePermission.DeletedAt.Time,
+	}
+
+	return RolePermission{
+		ID:              int64(rolePermission.ID),
+		RoleID:          int64(rolePermission.RoleID),
+		PermissionID:    int64(rolePermission.PermissionID),
+		BaseInformation: creationInfo,
+	}
+}
+
+func TransformPSQLRolePermission(rolePermission *psqlmodel.RolePermissionSlice) []RolePermission {
+	var res []RolePermission
+	for _, v := range *rolePermission {
+		creationInfo := BaseInformation{
+			CreatedBy: int64(v.CreatedBy),
+			CreatedAt: v.CreatedAt,
+			UpdatedBy: int64(v.UpdatedBy),
+			UpdatedAt: v.UpdatedAt,
+			DeletedBy: int64(v.DeletedBy.Int),
+			DeletedAt: v.DeletedAt.Time,
+		}
+
+		res = append(res, RolePermission{
+			ID:              int64(v.ID),
+			RoleID:          int64(v.RoleID),
+			PermissionID:    int64(v.PermissionID),
+			BaseInformation: creationInfo,
+		})
+	}
+
+	return res
+}
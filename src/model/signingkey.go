@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	AlgorithmRS256               string        = "RS256"
+	AlgorithmEdDSA               string        = "EdDSA"
+	DefaultRSAKeySize            int           = 2048
+	DefaultKeyRotationInterval   time.Duration = 30 * 24 * time.Hour
+	DefaultKeyRetentionPeriod    time.Duration = 24 * time.Hour
+	DefaultKeySetRefreshInterval time.Duration = 5 * time.Minute
+	KeyUseSignature              string        = "sig"
+	KeyTypeRSA                   string        = "RSA"
+	KeyTypeOKP                   string        = "OKP"
+	CurveEd25519                 string        = "Ed25519"
+	JWTTypeAccessToken           string        = "at+jwt"
+	JWTTypeIDToken               string        = "JWT"
+)
+
+type GetSigningKeysByParam struct {
+	Algorithm    null.String `json:"algorithm"`
+	ExpiredAfter null.Time   `json:"expired_after"`
+}
+
+func (g *GetSigningKeysByParam) GetQuery() []qm.QueryMod {
+	var res []qm.QueryMod
+	if g.Algorithm.Valid {
+		res = append(res, qm.Where("algorithm=?", g.Algorithm.String))
+	}
+
+	if g.ExpiredAfter.Valid {
+		res = append(res, qm.Where("expired_at>?", g.ExpiredAfter.Time))
+	}
+
+	res = append(res, qm.OrderBy("created_at desc"))
+	return res
+}
+
+// JWK is a public key as published in the JWKS document (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
@@ -25,32 +25,108 @@ const (
 	CodeInvalidPasswordNotMatch
 	CodeInvalidScope
 	CodeInvalidClientIDClientSecret
+	CodeInvalidGrantType
+	CodeInvalidRefreshToken
+	CodeInvalidRedirectURI
+	CodeInvalidAuthorizationCode
+	CodeInvalidCodeChallenge
+	CodeInvalidResponseType
+	CodeInvalidVerificationToken
+	CodeEmailNotVerified
+	CodeTooManyRequests
+	CodeInvalidResetToken
+	CodeInvalidMFAToken
+	CodeInvalidOTP
+	CodeMFAAlreadyEnabled
+	CodeMFANotEnrolled
+	CodeMFAEnrollmentRequired
+	CodeRestrictedToken
+	CodeAccountLocked
+	CodePasswordMissingUppercase
+	CodePasswordMissingLowercase
+	CodePasswordMissingDigit
+	CodePasswordMissingSymbol
+	CodePasswordTooCommon
+	CodePasswordContainsPersonalInfo
+	CodePasswordReused
+	CodePasswordExpired
+	CodePermissionDenied
+	CodeInvalidPermission
+	CodeInvalidOrganisation
+	CodeNotOrganisationMember
+	CodeInvalidInvitation
+	CodeEmailAlreadyRegistered
+	CodeInvalidImpersonation
+	CodeImpersonationForbidden
+	CodeInvalidAPIKey
+	CodeAPIKeyForbidden
+	CodeInvalidGrantWindow
+	CodeServiceUnavailable
+	CodeInvalidClient
 
 	CodeNotAuthorized = 401000
 	CodeNotFound      = 404000
 )
 
 var (
-	AccountSVCPSQLErrorTransaction        = ErrMsg[CodePSQLErrorTransaction]
-	AccountSVCPSQLErrorCommit             = ErrMsg[CodePSQLErrorCommit]
-	AccountSVCPSQLErrorRollback           = ErrMsg[CodePSQLErrorRollback]
-	AccountSVCPSQLErrorInsert             = ErrMsg[CodePSQLErrorInsert]
-	AccountSVCPSQLErrorUpdate             = ErrMsg[CodePSQLErrorUpdate]
-	AccountSVCPSQLErrorDelete             = ErrMsg[CodePSQLErrorDelete]
-	AccountSVCPSQLErrorGet                = ErrMsg[CodePSQLErrorGet]
-	AccountSVCNotAuthorized               = ErrMsg[CodeNotAuthorized]
-	AccountSVCNotFound                    = ErrMsg[CodeNotFound]
-	AccountSVCBadRequest                  = ErrMsg[CodeBadRequest]
-	AccountSVCInvalidEmptyName            = ErrMsg[CodeInvalidEmptyName]
-	AccountSVCInvalidEmptyEmail           = ErrMsg[CodeInvalidEmptyEmail]
-	AccountSVCInvalidEmailFormat          = ErrMsg[CodeInvalidEmailFormat]
-	AccountSVCInvalidEmptyPassword        = ErrMsg[CodeInvalidEmptyPassword]
-	AccountSVCInvalidMinimumPassword      = ErrMsg[CodeInvalidMinimumPassword]
-	AccountSVCInvalidMaximumPassword      = ErrMsg[CodeInvalidMaximumPassword]
-	AccountSVCInvalidPasswordConfirmation = ErrMsg[CodeInvalidPasswordConfirmation]
-	AccountSVCInvalidPasswordNotMatch     = ErrMsg[CodeInvalidPasswordNotMatch]
-	AccountSVCInvalidScope                = ErrMsg[CodeInvalidScope]
-	AccountSVCInvalidClientIDClientSecret = ErrMsg[CodeInvalidClientIDClientSecret]
+	AccountSVCPSQLErrorTransaction         = ErrMsg[CodePSQLErrorTransaction]
+	AccountSVCPSQLErrorCommit              = ErrMsg[CodePSQLErrorCommit]
+	AccountSVCPSQLErrorRollback            = ErrMsg[CodePSQLErrorRollback]
+	AccountSVCPSQLErrorInsert              = ErrMsg[CodePSQLErrorInsert]
+	AccountSVCPSQLErrorUpdate              = ErrMsg[CodePSQLErrorUpdate]
+	AccountSVCPSQLErrorDelete              = ErrMsg[CodePSQLErrorDelete]
+	AccountSVCPSQLErrorGet                 = ErrMsg[CodePSQLErrorGet]
+	AccountSVCNotAuthorized                = ErrMsg[CodeNotAuthorized]
+	AccountSVCNotFound                     = ErrMsg[CodeNotFound]
+	AccountSVCBadRequest                   = ErrMsg[CodeBadRequest]
+	AccountSVCInvalidEmptyName             = ErrMsg[CodeInvalidEmptyName]
+	AccountSVCInvalidEmptyEmail            = ErrMsg[CodeInvalidEmptyEmail]
+	AccountSVCInvalidEmailFormat           = ErrMsg[CodeInvalidEmailFormat]
+	AccountSVCInvalidEmptyPassword         = ErrMsg[CodeInvalidEmptyPassword]
+	AccountSVCInvalidMinimumPassword       = ErrMsg[CodeInvalidMinimumPassword]
+	AccountSVCInvalidMaximumPassword       = ErrMsg[CodeInvalidMaximumPassword]
+	AccountSVCInvalidPasswordConfirmation  = ErrMsg[CodeInvalidPasswordConfirmation]
+	AccountSVCInvalidPasswordNotMatch      = ErrMsg[CodeInvalidPasswordNotMatch]
+	AccountSVCInvalidScope                 = ErrMsg[CodeInvalidScope]
+	AccountSVCInvalidClientIDClientSecret  = ErrMsg[CodeInvalidClientIDClientSecret]
+	AccountSVCInvalidGrantType             = ErrMsg[CodeInvalidGrantType]
+	AccountSVCInvalidRefreshToken          = ErrMsg[CodeInvalidRefreshToken]
+	AccountSVCInvalidRedirectURI           = ErrMsg[CodeInvalidRedirectURI]
+	AccountSVCInvalidAuthorizationCode     = ErrMsg[CodeInvalidAuthorizationCode]
+	AccountSVCInvalidCodeChallenge         = ErrMsg[CodeInvalidCodeChallenge]
+	AccountSVCInvalidResponseType          = ErrMsg[CodeInvalidResponseType]
+	AccountSVCInvalidVerificationToken     = ErrMsg[CodeInvalidVerificationToken]
+	AccountSVCEmailNotVerified             = ErrMsg[CodeEmailNotVerified]
+	AccountSVCTooManyRequests              = ErrMsg[CodeTooManyRequests]
+	AccountSVCInvalidResetToken            = ErrMsg[CodeInvalidResetToken]
+	AccountSVCInvalidMFAToken              = ErrMsg[CodeInvalidMFAToken]
+	AccountSVCInvalidOTP                   = ErrMsg[CodeInvalidOTP]
+	AccountSVCMFAAlreadyEnabled            = ErrMsg[CodeMFAAlreadyEnabled]
+	AccountSVCMFANotEnrolled               = ErrMsg[CodeMFANotEnrolled]
+	AccountSVCMFAEnrollmentRequired        = ErrMsg[CodeMFAEnrollmentRequired]
+	AccountSVCRestrictedToken              = ErrMsg[CodeRestrictedToken]
+	AccountSVCAccountLocked                = ErrMsg[CodeAccountLocked]
+	AccountSVCPasswordMissingUppercase     = ErrMsg[CodePasswordMissingUppercase]
+	AccountSVCPasswordMissingLowercase     = ErrMsg[CodePasswordMissingLowercase]
+	AccountSVCPasswordMissingDigit         = ErrMsg[CodePasswordMissingDigit]
+	AccountSVCPasswordMissingSymbol        = ErrMsg[CodePasswordMissingSymbol]
+	AccountSVCPasswordTooCommon            = ErrMsg[CodePasswordTooCommon]
+	AccountSVCPasswordContainsPersonalInfo = ErrMsg[CodePasswordContainsPersonalInfo]
+	AccountSVCPasswordReused               = ErrMsg[CodePasswordReused]
+	AccountSVCPasswordExpired              = ErrMsg[CodePasswordExpired]
+	AccountSVCPermissionDenied             = ErrMsg[CodePermissionDenied]
+	AccountSVCInvalidPermission            = ErrMsg[CodeInvalidPermission]
+	AccountSVCInvalidOrganisation          = ErrMsg[CodeInvalidOrganisation]
+	AccountSVCNotOrganisationMember        = ErrMsg[CodeNotOrganisationMember]
+	AccountSVCInvalidInvitation            = ErrMsg[CodeInvalidInvitation]
+	AccountSVCEmailAlreadyRegistered       = ErrMsg[CodeEmailAlreadyRegistered]
+	AccountSVCInvalidImpersonation         = ErrMsg[CodeInvalidImpersonation]
+	AccountSVCImpersonationForbidden       = ErrMsg[CodeImpersonationForbidden]
+	AccountSVCInvalidAPIKey                = ErrMsg[CodeInvalidAPIKey]
+	AccountSVCAPIKeyForbidden              = ErrMsg[CodeAPIKeyForbidden]
+	AccountSVCInvalidGrantWindow           = ErrMsg[CodeInvalidGrantWindow]
+	AccountSVCServiceUnavailable           = ErrMsg[CodeServiceUnavailable]
+	AccountSVCInvalidClient                = ErrMsg[CodeInvalidClient]
 )
 
 var ErrMsg = map[int]errormsg.Message{
@@ -169,17 +245,17 @@ var ErrMsg = map[int]errormsg.Message{
 	CodeInvalidMinimumPassword: {
 		Code:       CodeInvalidMinimumPassword,
 		StatusCode: http.StatusBadRequest,
-		Message:    "Kata sandi minimal 5 karakter!",
+		Message:    "Kata sandi terlalu pendek!",
 		Translation: errormsg.Translation{
-			EN: "Minimum password is 5 character!",
+			EN: "Password is too short!",
 		},
 	},
 	CodeInvalidMaximumPassword: {
 		Code:       CodeInvalidMaximumPassword,
 		StatusCode: http.StatusBadRequest,
-		Message:    "Kata sandi maksimal 8 karakter!",
+		Message:    "Kata sandi terlalu panjang!",
 		Translation: errormsg.Translation{
-			EN: "Maximum password is 8 character!",
+			EN: "Password is too long!",
 		},
 	},
 	CodeInvalidPasswordConfirmation: {
@@ -214,4 +290,308 @@ var ErrMsg = map[int]errormsg.Message{
 			EN: "Client ID/Client Secret should not be empty",
 		},
 	},
+	CodeInvalidGrantType: {
+		Code:       CodeInvalidGrantType,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Grant type tidak didukung!",
+		Translation: errormsg.Translation{
+			EN: "Unsupported grant type!",
+		},
+	},
+	CodeInvalidRefreshToken: {
+		Code:       CodeInvalidRefreshToken,
+		StatusCode: http.StatusUnauthorized,
+		Message:    "Refresh token tidak valid! Silakan login kembali!",
+		Translation: errormsg.Translation{
+			EN: "Invalid refresh token! Please login again!",
+		},
+	},
+	CodeInvalidRedirectURI: {
+		Code:       CodeInvalidRedirectURI,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Redirect URI tidak terdaftar untuk client ini!",
+		Translation: errormsg.Translation{
+			EN: "Redirect URI is not registered for this client!",
+		},
+	},
+	CodeInvalidAuthorizationCode: {
+		Code:       CodeInvalidAuthorizationCode,
+		StatusCode: http.StatusUnauthorized,
+		Message:    "Kode otorisasi tidak valid atau sudah kedaluwarsa!",
+		Translation: errormsg.Translation{
+			EN: "Invalid or expired authorization code!",
+		},
+	},
+	CodeInvalidCodeChallenge: {
+		Code:       CodeInvalidCodeChallenge,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Code challenge PKCE tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid PKCE code challenge!",
+		},
+	},
+	CodeInvalidResponseType: {
+		Code:       CodeInvalidResponseType,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Response type tidak didukung!",
+		Translation: errormsg.Translation{
+			EN: "Unsupported response type!",
+		},
+	},
+	CodeInvalidVerificationToken: {
+		Code:       CodeInvalidVerificationToken,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Token verifikasi tidak valid atau sudah kedaluwarsa!",
+		Translation: errormsg.Translation{
+			EN: "Invalid or expired verification token!",
+		},
+	},
+	CodeEmailNotVerified: {
+		Code:       CodeEmailNotVerified,
+		StatusCode: http.StatusForbidden,
+		Message:    "Email belum diverifikasi! Silakan cek email Anda!",
+		Translation: errormsg.Translation{
+			EN: "Email is not verified! Please check your email!",
+		},
+	},
+	CodeTooManyRequests: {
+		Code:       CodeTooManyRequests,
+		StatusCode: http.StatusTooManyRequests,
+		Message:    "Terlalu banyak permintaan! Silakan coba beberapa saat lagi!",
+		Translation: errormsg.Translation{
+			EN: "Too many requests! Please try again later!",
+		},
+	},
+	CodeInvalidResetToken: {
+		Code:       CodeInvalidResetToken,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Token reset kata sandi tidak valid atau sudah kedaluwarsa!",
+		Translation: errormsg.Translation{
+			EN: "Invalid or expired password reset token!",
+		},
+	},
+	CodeInvalidMFAToken: {
+		Code:       CodeInvalidMFAToken,
+		StatusCode: http.StatusUnauthorized,
+		Message:    "Token MFA tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid MFA token!",
+		},
+	},
+	CodeInvalidOTP: {
+		Code:       CodeInvalidOTP,
+		StatusCode: http.StatusUnauthorized,
+		Message:    "Kode OTP tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid OTP code!",
+		},
+	},
+	CodeMFAAlreadyEnabled: {
+		Code:       CodeMFAAlreadyEnabled,
+		StatusCode: http.StatusBadRequest,
+		Message:    "MFA sudah aktif!",
+		Translation: errormsg.Translation{
+			EN: "MFA already enabled!",
+		},
+	},
+	CodeMFANotEnrolled: {
+		Code:       CodeMFANotEnrolled,
+		StatusCode: http.StatusBadRequest,
+		Message:    "MFA belum didaftarkan!",
+		Translation: errormsg.Translation{
+			EN: "MFA not enrolled!",
+		},
+	},
+	CodeMFAEnrollmentRequired: {
+		Code:       CodeMFAEnrollmentRequired,
+		StatusCode: http.StatusForbidden,
+		Message:    "Pendaftaran MFA diperlukan!",
+		Translation: errormsg.Translation{
+			EN: "MFA enrollment required!",
+		},
+	},
+	CodeRestrictedToken: {
+		Code:       CodeRestrictedToken,
+		StatusCode: http.StatusForbidden,
+		Message:    "Token terbatas tidak dapat mengakses sumber ini!",
+		Translation: errormsg.Translation{
+			EN: "Restricted token cannot access this resource!",
+		},
+	},
+	CodeAccountLocked: {
+		Code:       CodeAccountLocked,
+		StatusCode: http.StatusLocked,
+		Message:    "Akun dikunci sementara karena terlalu banyak percobaan masuk!",
+		Translation: errormsg.Translation{
+			EN: "Account is temporarily locked because of too many login attempts!",
+		},
+	},
+	CodePasswordMissingUppercase: {
+		Code:       CodePasswordMissingUppercase,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Kata sandi harus mengandung huruf besar!",
+		Translation: errormsg.Translation{
+			EN: "Password must contain an uppercase letter!",
+		},
+	},
+	CodePasswordMissingLowercase: {
+		Code:       CodePasswordMissingLowercase,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Kata sandi harus mengandung huruf kecil!",
+		Translation: errormsg.Translation{
+			EN: "Password must contain a lowercase letter!",
+		},
+	},
+	CodePasswordMissingDigit: {
+		Code:       CodePasswordMissingDigit,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Kata sandi harus mengandung angka!",
+		Translation: errormsg.Translation{
+			EN: "Password must contain a digit!",
+		},
+	},
+	CodePasswordMissingSymbol: {
+		Code:       CodePasswordMissingSymbol,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Kata sandi harus mengandung simbol!",
+		Translation: errormsg.Translation{
+			EN: "Password must contain a symbol!",
+		},
+	},
+	CodePasswordTooCommon: {
+		Code:       CodePasswordTooCommon,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Kata sandi terlalu umum atau pernah bocor!",
+		Translation: errormsg.Translation{
+			EN: "Password is too common or has been breached!",
+		},
+	},
+	CodePasswordContainsPersonalInfo: {
+		Code:       CodePasswordContainsPersonalInfo,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Kata sandi tidak boleh mengandung email atau nama!",
+		Translation: errormsg.Translation{
+			EN: "Password must not contain your email or name!",
+		},
+	},
+	CodePasswordReused: {
+		Code:       CodePasswordReused,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Kata sandi sudah pernah digunakan, silakan pilih kata sandi lain!",
+		Translation: errormsg.Translation{
+			EN: "Password was used before, please choose another password!",
+		},
+	},
+	CodePasswordExpired: {
+		Code:       CodePasswordExpired,
+		StatusCode: http.StatusForbidden,
+		Message:    "Kata sandi sudah kedaluwarsa, silakan ganti kata sandi!",
+		Translation: errormsg.Translation{
+			EN: "Password has expired, please change your password!",
+		},
+	},
+	CodePermissionDenied: {
+		Code:       CodePermissionDenied,
+		StatusCode: http.StatusForbidden,
+		Message:    "Anda tidak memiliki izin untuk mengakses data ini!",
+		Translation: errormsg.Translation{
+			EN: "You do not have permission to access this data!",
+		},
+	},
+	CodeInvalidPermission: {
+		Code:       CodeInvalidPermission,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Nama izin tidak valid, gunakan format resource:action!",
+		Translation: errormsg.Translation{
+			EN: "Invalid permission name, use the resource:action format!",
+		},
+	},
+	CodeInvalidOrganisation: {
+		Code:       CodeInvalidOrganisation,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Organisasi tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid organisation!",
+		},
+	},
+	CodeNotOrganisationMember: {
+		Code:       CodeNotOrganisationMember,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Akun bukan anggota organisasi!",
+		Translation: errormsg.Translation{
+			EN: "Account is not a member of the organisation!",
+		},
+	},
+	CodeInvalidInvitation: {
+		Code:       CodeInvalidInvitation,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Undangan tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid invitation!",
+		},
+	},
+	CodeEmailAlreadyRegistered: {
+		Code:       CodeEmailAlreadyRegistered,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Email sudah terdaftar!",
+		Translation: errormsg.Translation{
+			EN: "Email is already registered!",
+		},
+	},
+	CodeInvalidImpersonation: {
+		Code:       CodeInvalidImpersonation,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Impersonasi tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid impersonation!",
+		},
+	},
+	CodeImpersonationForbidden: {
+		Code:       CodeImpersonationForbidden,
+		StatusCode: http.StatusForbidden,
+		Message:    "Tidak diizinkan selama impersonasi!",
+		Translation: errormsg.Translation{
+			EN: "Not allowed while impersonating!",
+		},
+	},
+	CodeInvalidAPIKey: {
+		Code:       CodeInvalidAPIKey,
+		StatusCode: http.StatusBadRequest,
+		Message:    "API key tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid API key!",
+		},
+	},
+	CodeAPIKeyForbidden: {
+		Code:       CodeAPIKeyForbidden,
+		StatusCode: http.StatusForbidden,
+		Message:    "Tidak diizinkan dengan API key!",
+		Translation: errormsg.Translation{
+			EN: "Not allowed with an API key!",
+		},
+	},
+	CodeInvalidGrantWindow: {
+		Code:       CodeInvalidGrantWindow,
+		StatusCode: http.StatusBadRequest,
+		Message:    "Masa berlaku hak akses tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid grant validity window!",
+		},
+	},
+	CodeServiceUnavailable: {
+		Code:       CodeServiceUnavailable,
+		StatusCode: http.StatusServiceUnavailable,
+		Message:    "Layanan sedang tidak tersedia! Silakan coba beberapa saat lagi!",
+		Translation: errormsg.Translation{
+			EN: "Service unavailable! Please try again later!",
+		},
+	},
+	CodeInvalidClient: {
+		Code:       CodeInvalidClient,
+		StatusCode: http.StatusUnauthorized,
+		Message:    "Client ID/Client Secret tidak valid!",
+		Translation: errormsg.Translation{
+			EN: "Invalid client id/client secret!",
+		},
+	},
 }
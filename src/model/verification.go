@@ -0,0 +1,50 @@
+package model
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+)
+
+var (
+	EmailVerificationResendKey             string        = "verifyResend:%s"
+	JWTTypeEmailVerification               string        = "email-verify+jwt"
+	DefaultEmailVerificationExpiration     time.Duration = 24 * time.Hour
+	DefaultEmailVerificationResendInterval time.Duration = time.Minute
+)
+
+type VerifyEmail struct {
+	Token string `json:"token"`
+}
+
+func (v *VerifyEmail) Validate() error {
+	if v.Token == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidVerificationToken, nil, "invalid empty token")
+	}
+	return nil
+}
+
+type ResendVerification struct {
+	Email string `json:"email"`
+}
+
+func (r *ResendVerification) Validate() error {
+	if r.Email == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmptyEmail, nil, "invalid empty email")
+	}
+
+	rg := regexp.MustCompile(RegExpEmail)
+	if !rg.MatchString(r.Email) {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmailFormat, nil, "invalid email format")
+	}
+	return nil
+}
+
+// Mail is a plain text message handed to the mailer domain.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
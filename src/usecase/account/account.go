@@ -1,34 +1,84 @@
 package account
 
 import (
+	"context"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/achwanyusuf/carrent-accountsvc/src/domain/account"
 	"github.com/achwanyusuf/carrent-accountsvc/src/domain/accountrole"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/apikey"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/authcode"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/loginattempt"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/mailer"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/organisationmember"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/passwordhistory"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/passwordreset"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/ratelimit"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/recoverycode"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/refreshtoken"
 	"github.com/achwanyusuf/carrent-accountsvc/src/domain/role"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/rolepermission"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/passwordhash"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/passwordpolicy"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/token"
 	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
 	"github.com/achwanyusuf/carrent-lib/pkg/hash"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 	"github.com/volatiletech/null/v8"
 )
 
 type AccountDep struct {
-	log         logger.Logger
-	conf        Conf
-	account     account.AccountInterface
-	role        role.RoleInterface
-	accountRole accountrole.AccountRoleInterface
+	log                logger.Logger
+	conf               Conf
+	account            account.AccountInterface
+	role               role.RoleInterface
+	accountRole        accountrole.AccountRoleInterface
+	refreshToken       refreshtoken.RefreshTokenInterface
+	token              token.TokenInterface
+	authCode           authcode.AuthCodeInterface
+	mailer             mailer.MailerInterface
+	rateLimit          ratelimit.RateLimitInterface
+	passwordReset      passwordreset.PasswordResetInterface
+	recoveryCode       recoverycode.RecoveryCodeInterface
+	loginAttempt       loginattempt.LoginAttemptInterface
+	passwordPolicy     passwordpolicy.PasswordPolicyInterface
+	passwordHistory    passwordhistory.PasswordHistoryInterface
+	passwordHash       passwordhash.PasswordHashInterface
+	rolePermission     rolepermission.RolePermissionInterface
+	organisationMember organisationmember.OrganisationMemberInterface
+	apiKey             apikey.APIKeyInterface
+	mailing            sync.WaitGroup
 }
 
 type Conf struct {
-	TokenTimeout time.Duration `mapstructure:"token_timeout"`
-	TokenSecret  string        `mapstructure:"token_secret"`
-	AESSecret    string        `mapstructure:"aes_secret"`
+	TokenTimeout               time.Duration `mapstructure:"token_timeout"`
+	RefreshTokenTimeout        time.Duration `mapstructure:"refresh_token_timeout"`
+	AESSecret                  string        `mapstructure:"aes_secret"`
+	RequireVerifiedEmail       bool          `mapstructure:"require_verified_email"`
+	EmailVerificationURL       string        `mapstructure:"email_verification_url"`
+	EmailVerificationTimeout   time.Duration `mapstructure:"email_verification_timeout"`
+	VerificationResendInterval time.Duration `mapstructure:"verification_resend_interval"`
+	PasswordResetURL           string        `mapstructure:"password_reset_url"`
+	PasswordForgotInterval     time.Duration `mapstructure:"password_forgot_interval"`
+	MFAIssuer                  string        `mapstructure:"mfa_issuer"`
+	MFATokenTimeout            time.Duration `mapstructure:"mfa_token_timeout"`
+	MFAMaxAttempts             int64         `mapstructure:"mfa_max_attempts"`
+	LoginMaxFailures           int64         `mapstructure:"login_max_failures"`
+	LoginMaxIPFailures         int64         `mapstructure:"login_max_ip_failures"`
+	LoginFailureWindow         time.Duration `mapstructure:"login_failure_window"`
+	LoginLockoutDuration       time.Duration `mapstructure:"login_lockout_duration"`
+	LoginDelayStep             time.Duration `mapstructure:"login_delay_step"`
+	LoginMaxDelay              time.Duration `mapstructure:"login_max_delay"`
+	PasswordHistorySize        int           `mapstructure:"password_history_size"`
+	MultiScopeTokens           bool          `mapstructure:"multi_scope_tokens"`
 }
 
 type AccountInterface interface {
@@ -39,15 +89,47 @@ type AccountInterface interface {
 	UpdateByID(ctx *gin.Context, id int64, v model.UpdateAccountData) (model.Account, error)
 	UpdatePasswordByID(ctx *gin.Context, id int64, v model.UpdatePasswordData) (model.Account, error)
 	DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error
+	ValidateAuthorize(ctx *gin.Context, v model.AuthorizeRequest) (model.Role, error)
+	Authorize(ctx *gin.Context, v model.Authorize) (model.AuthorizeResult, error)
+	Revoke(ctx *gin.Context, v model.TokenRequest) error
+	Introspect(ctx *gin.Context, v model.TokenRequest) (model.Introspection, error)
+	VerifyEmail(ctx *gin.Context, v model.VerifyEmail) (model.Account, error)
+	ResendVerification(ctx *gin.Context, v model.ResendVerification) error
+	ForgotPassword(ctx *gin.Context, v model.ForgotPassword) error
+	ResetPassword(ctx *gin.Context, v model.ResetPassword) error
+	EnrollMFA(ctx *gin.Context, id int64) (model.MFAEnrollment, error)
+	ConfirmMFA(ctx *gin.Context, id int64, v model.MFACode) (model.RecoveryCodes, error)
+	DisableMFA(ctx *gin.Context, id int64, v model.MFACode) error
+	RegenerateRecoveryCodes(ctx *gin.Context, id int64, v model.MFACode) (model.RecoveryCodes, error)
+	Unlock(ctx *gin.Context, id int64) error
+	AccountAccessToken(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role, scope string, organisationID int64, claims jwt.MapClaims) (model.Auth, error)
+	RolesPermissions(ctx *gin.Context, roles []psqlmodel.Role) ([]string, error)
+	GrantedRoles(ctx *gin.Context, accountID int64, within null.Int64) ([]psqlmodel.Role, error)
+	RecordPasswordHistory(ctx *gin.Context, account *psqlmodel.Account) error
+	RevokeAccountTokens(ctx context.Context, accountID int) error
 }
 
-func New(conf Conf, logger *logger.Logger, account account.AccountInterface, role role.RoleInterface, accountRole accountrole.AccountRoleInterface) AccountInterface {
+func New(conf Conf, logger *logger.Logger, account account.AccountInterface, role role.RoleInterface, accountRole accountrole.AccountRoleInterface, refreshToken refreshtoken.RefreshTokenInterface, token token.TokenInterface, authCode authcode.AuthCodeInterface, mailer mailer.MailerInterface, rateLimit ratelimit.RateLimitInterface, passwordReset passwordreset.PasswordResetInterface, recoveryCode recoverycode.RecoveryCodeInterface, loginAttempt loginattempt.LoginAttemptInterface, passwordPolicy passwordpolicy.PasswordPolicyInterface, passwordHistory passwordhistory.PasswordHistoryInterface, passwordHash passwordhash.PasswordHashInterface, rolePermission rolepermission.RolePermissionInterface, organisationMember organisationmember.OrganisationMemberInterface, apiKey apikey.APIKeyInterface) AccountInterface {
 	return &AccountDep{
-		conf:        conf,
-		log:         *logger,
-		account:     account,
-		role:        role,
-		accountRole: accountRole,
+		conf:               conf,
+		log:                *logger,
+		account:            account,
+		role:               role,
+		accountRole:        accountRole,
+		refreshToken:       refreshToken,
+		token:              token,
+		authCode:           authCode,
+		mailer:             mailer,
+		rateLimit:          rateLimit,
+		passwordReset:      passwordReset,
+		recoveryCode:       recoveryCode,
+		loginAttempt:       loginAttempt,
+		passwordPolicy:     passwordPolicy,
+		passwordHistory:    passwordHistory,
+		passwordHash:       passwordHash,
+		rolePermission:     rolePermission,
+		organisationMember: organisationMember,
+		apiKey:             apiKey,
 	}
 }
 
@@ -57,22 +139,112 @@ func (a *AccountDep) Oauth2(ctx *gin.Context, v model.Login) (model.Auth, error)
 	if err != nil {
 		return auth, err
 	}
+
+	// clients registered as public cannot keep a secret, they redeem codes
+	// with PKCE alone. Every other client authenticates with its secret.
+	var role psqlmodel.Role
+	if v.GrantType == model.GrantTypeAuthorizationCode && v.ClientSecret == "" {
+		role, err = a.getClient(ctx, v.ClientID)
+		if err == nil && !role.PublicClient {
+			err = errormsg.WrapErr(svcerr.AccountSVCInvalidClient, nil, "invalid client id/client secret")
+		}
+	} else {
+		role, err = a.authenticateClient(ctx, v.ClientID, v.ClientSecret)
+	}
+	if err != nil {
+		return auth, err
+	}
+
+	switch v.GrantType {
+	case model.GrantTypeRefreshToken:
+		return a.refreshTokenGrant(ctx, v, role)
+	case model.GrantTypeClientCredentials:
+		return a.clientCredentialsGrant(ctx, v, &role)
+	case model.GrantTypeAuthorizationCode:
+		return a.authorizationCodeGrant(ctx, v, role)
+	case model.GrantTypeMFAOTP:
+		return a.mfaOTPGrant(ctx, v, role)
+	}
+
+	account, err := a.authenticateAccount(ctx, &role, v.Email, v.Password)
+	if err != nil {
+		return auth, err
+	}
+
+	if account.MfaEnabledAt.Valid {
+		auth.MFAToken, err = a.issueMFAToken(ctx, &account, &role, v.Scope, v.OrganisationID)
+		if err != nil {
+			return model.Auth{}, err
+		}
+		auth.MFARequired = true
+		return auth, nil
+	}
+
+	if role.MfaRequired {
+		return a.issueRestrictedToken(ctx, &account, &role, model.RestrictionMFAEnrollment)
+	}
+
+	if a.passwordExpired(&account, &role) {
+		return a.issuePasswordExpiredToken(ctx, &account, &role)
+	}
+	authTime := time.Now()
+
+	auth, err = a.generateAccessToken(ctx, &account, &role, v.Scope, v.OrganisationID)
+	if err != nil {
+		return auth, err
+	}
+
+	if model.HasScope(v.Scope, model.ScopeOpenID) {
+		auth.IDToken, err = a.issueIDToken(ctx, &account, &role, v.Scope, "", authTime)
+		if err != nil {
+			return model.Auth{}, err
+		}
+	}
+
+	auth.RefreshToken, err = a.issueRefreshToken(ctx, &account, &role, auth, uuid.NewString())
+	if err != nil {
+		return model.Auth{}, err
+	}
+
+	return auth, nil
+}
+
+func (a *AccountDep) getClient(ctx *gin.Context, clientID string) (psqlmodel.Role, error) {
 	role, err := a.role.GetSingleByParam(ctx, "", &model.GetRoleByParam{
-		Cid: null.NewString(v.ClientID, true),
+		Cid: null.NewString(clientID, true),
 	})
 	if err != nil {
-		return auth, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, err, "role not found")
+		return role, errormsg.WrapErr(svcerr.AccountSVCInvalidClient, err, "role not found")
+	}
+	return role, nil
+}
+
+func (a *AccountDep) authenticateClient(ctx *gin.Context, clientID, clientSecret string) (psqlmodel.Role, error) {
+	role, err := a.getClient(ctx, clientID)
+	if err != nil {
+		return role, err
+	}
+
+	if match := hash.CompareAES(role.Sec, a.conf.AESSecret, clientSecret); !match {
+		return role, errormsg.WrapErr(svcerr.AccountSVCInvalidClient, err, "invalid client id/client secret")
 	}
+	return role, nil
+}
 
-	if match := hash.CompareAES(role.Sec, a.conf.AESSecret, v.ClientSecret); !match {
-		return auth, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, err, "invalid client id/client secret")
+// authenticateAccount checks the account credentials and that the account
+// holds role, which is how a client is allowed to log an account in. Every
+// failure counts towards the delays and lockout of brute-force protection.
+func (a *AccountDep) authenticateAccount(ctx *gin.Context, role *psqlmodel.Role, email, password string) (psqlmodel.Account, error) {
+	if err := a.checkLoginAttempts(ctx, email); err != nil {
+		return psqlmodel.Account{}, err
 	}
 
 	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
-		Email: null.NewString(v.Email, true),
+		Email: null.NewString(email, true),
 	})
 	if err != nil {
-		return auth, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, err, "account not found")
+		a.recordLoginFailure(ctx, email)
+		return account, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, err, "account not found")
 	}
 
 	_, err = a.accountRole.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountRoleByParam{
@@ -80,31 +252,114 @@ func (a *AccountDep) Oauth2(ctx *gin.Context, v model.Login) (model.Auth, error)
 		RoleID:    null.NewInt64(int64(role.ID), true),
 	})
 	if err != nil {
-		return auth, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, err, "invalid client id/client secret")
+		a.recordLoginFailure(ctx, email)
+		return account, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, err, "invalid client id/client secret")
 	}
 
-	err = hash.Compare(account.Password, v.Password)
+	err = a.passwordHash.Compare(account.Password, password)
 	if err != nil {
-		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidPasswordNotMatch, err, "password not match")
+		a.recordLoginFailure(ctx, email)
+		return account, errormsg.WrapErr(svcerr.AccountSVCInvalidPasswordNotMatch, err, "password not match")
+	}
+	a.rehashPassword(ctx, &account, password)
+
+	// with MFA the login only succeeds once the challenge is answered, so
+	// a known password cannot be used to clear failed OTP attempts
+	if !account.MfaEnabledAt.Valid {
+		a.resetLoginFailures(ctx, email)
+	}
+
+	if a.conf.RequireVerifiedEmail && !account.EmailVerifiedAt.Valid {
+		return account, errormsg.WrapErr(svcerr.AccountSVCEmailNotVerified, nil, "email not verified")
+	}
+	return account, nil
+}
+
+// generateAccessToken issues the access token of an account logging in
+// through role. scope is the requested scope, see tokenRoles, and
+// organisationID the requested organisation, see tokenOrganisation.
+func (a *AccountDep) generateAccessToken(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role, scope string, organisationID int64) (model.Auth, error) {
+	return a.AccountAccessToken(ctx, account, role, scope, organisationID, jwt.MapClaims{})
+}
+
+// AccountAccessToken signs an access token of account for the client role
+// on top of claims, which can set extra claims such as a shorter "exp".
+func (a *AccountDep) AccountAccessToken(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role, scope string, organisationID int64, claims jwt.MapClaims) (model.Auth, error) {
+	member, err := a.tokenOrganisation(ctx, account, role, organisationID)
+	if err != nil {
+		return model.Auth{}, err
+	}
+
+	var tokenOrganisationID int64
+	if member != nil {
+		tokenOrganisationID = int64(member.OrganisationID)
+	}
+	roles, err := a.tokenRoles(ctx, account, role, scope, tokenOrganisationID)
+	if err != nil {
+		return model.Auth{}, err
 	}
 
-	token := jwt.New(jwt.SigningMethodHS512)
-	expired := time.Now().Add(a.conf.TokenTimeout)
-	claims := token.Claims.(jwt.MapClaims)
 	claims["id"] = account.ID
+	claims["sub"] = strconv.Itoa(account.ID)
 	claims["username"] = account.Email
+	if member != nil {
+		claims["org_id"] = member.OrganisationID
+		claims["org_role"] = member.Role
+	}
+
+	auth, err := a.signAccessToken(ctx, role, roles, claims)
+	if err != nil {
+		return auth, err
+	}
+	if member != nil {
+		auth.OrganisationID = int64(member.OrganisationID)
+	}
+	return auth, nil
+}
+
+// clientCredentialsGrant issues a token for service-to-service calls. The
+// subject is the client itself, so the token has no account id and no
+// refresh token. The client only holds the scope of its role, so a request
+// for other role scopes alone is refused.
+func (a *AccountDep) clientCredentialsGrant(ctx *gin.Context, v model.Login, role *psqlmodel.Role) (model.Auth, error) {
+	if _, err := narrowScope(role.Scope, v.Scope); err != nil {
+		return model.Auth{}, err
+	}
+	return a.signAccessToken(ctx, role, []psqlmodel.Role{*role}, jwt.MapClaims{
+		"sub": role.Cid,
+	})
+}
+
+// signAccessToken signs an access token for the client role that carries
+// the scopes and permissions of roles.
+func (a *AccountDep) signAccessToken(ctx *gin.Context, role *psqlmodel.Role, roles []psqlmodel.Role, claims jwt.MapClaims) (model.Auth, error) {
+	var auth model.Auth
+	expired := time.Now().Add(a.conf.TokenTimeout)
+	if exp, ok := claims["exp"].(int64); ok {
+		expired = time.Unix(exp, 0)
+	}
+	// permissions are embedded so routes can be authorized without a
+	// lookup, a changed grant applies to tokens issued after it
+	permissions, err := a.RolesPermissions(ctx, roles)
+	if err != nil {
+		return auth, err
+	}
+	scope := model.RolesScope(roles)
+
+	claims["client_id"] = role.Cid
 	claims["exp"] = expired.Unix()
-	claims["scope"] = role.Scope
-	t, err := token.SignedString([]byte(a.conf.TokenSecret))
+	claims["scope"] = scope
+	claims["permissions"] = permissions
+	t, err := a.token.Sign(ctx, model.JWTTypeAccessToken, claims)
 	if err != nil {
-		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidPasswordNotMatch, err, "invalid token")
+		return auth, err
 	}
 
 	auth = model.Auth{
 		AccessToken: t,
 		Exp:         &expired,
 		TokenType:   model.TokenTypeBearer,
-		Scope:       role.Scope,
+		Scope:       scope,
 	}
 
 	return auth, nil
@@ -117,7 +372,16 @@ func (a *AccountDep) Create(ctx *gin.Context, v model.Register) (model.Account,
 		return result, err
 	}
 
-	pwd, err := hash.Hash(v.Password)
+	err = a.passwordPolicy.Validate(model.PasswordCandidate{
+		Password: v.Password,
+		Email:    v.Email,
+		Name:     v.Name,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	pwd, err := a.passwordHash.Hash(v.Password)
 	if err != nil {
 		return result, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error hash password")
 	}
@@ -135,6 +399,17 @@ func (a *AccountDep) Create(ctx *gin.Context, v model.Register) (model.Account,
 		return result, err
 	}
 
+	// the account exists at this point, a missing first history entry
+	// only means the initial password can be reused once
+	if err = a.RecordPasswordHistory(ctx, account); err != nil {
+		a.log.Warn(ctx, err)
+	}
+
+	// registration succeeds even if the mail fails, the user can resend it
+	if err = a.sendVerification(ctx, account); err != nil {
+		a.log.Warn(ctx, err)
+	}
+
 	return model.TransformPSQLSingleAccount(account), nil
 }
 
@@ -194,19 +469,56 @@ func (a *AccountDep) UpdatePasswordByID(ctx *gin.Context, id int64, v model.Upda
 	if err != nil {
 		return model.Account{}, err
 	}
-	pwd, err := hash.Hash(v.Password)
+
+	err = a.passwordPolicy.Validate(model.PasswordCandidate{
+		Password: v.Password,
+		Email:    account.Email,
+		Name:     account.Name,
+	})
+	if err != nil {
+		return model.Account{}, err
+	}
+
+	err = a.setPassword(ctx, &account, v.Password, v.UpdateBy)
+	if err != nil {
+		return model.Account{}, err
+	}
+	return model.TransformPSQLSingleAccount(&account), nil
+}
+
+// setPassword rejects a recently used password and stores any other, see
+// storePassword.
+func (a *AccountDep) setPassword(ctx *gin.Context, account *psqlmodel.Account, password string, updatedBy int64) error {
+	err := a.checkPasswordReuse(ctx, account, password)
+	if err != nil {
+		return err
+	}
+	return a.storePassword(ctx, account, password, updatedBy)
+}
+
+// storePassword stores the new password hash, records it in the password
+// history and revokes every token of the account, so sessions opened with
+// the old password end. The password must already be checked for reuse.
+func (a *AccountDep) storePassword(ctx *gin.Context, account *psqlmodel.Account, password string, updatedBy int64) error {
+	pwd, err := a.passwordHash.Hash(password)
 	if err != nil {
-		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error hash password")
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error hash password")
 	}
 
 	account.Password = pwd
-	account.UpdatedBy = int(v.UpdateBy)
+	account.PasswordChangedAt = time.Now()
+	account.UpdatedBy = int(updatedBy)
 
-	err = a.account.Update(ctx, &account)
+	err = a.account.Update(ctx, account)
 	if err != nil {
-		return model.Account{}, err
+		return err
 	}
-	return model.TransformPSQLSingleAccount(&account), nil
+
+	err = a.RecordPasswordHistory(ctx, account)
+	if err != nil {
+		return err
+	}
+	return a.revokeAccountCredentials(ctx, account.ID, updatedBy)
 }
 
 func (a *AccountDep) DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error {
@@ -216,5 +528,10 @@ func (a *AccountDep) DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, v
 	if err != nil {
 		return err
 	}
-	return a.account.Delete(ctx, &account, id, isHardDelete)
+
+	err = a.account.Delete(ctx, &account, id, isHardDelete)
+	if err != nil {
+		return err
+	}
+	return a.revokeAccountCredentials(ctx, account.ID, id)
 }
@@ -0,0 +1,102 @@
+package account
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/hash"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestOauth2ClientCredentials(t *testing.T) {
+	const secret = "client-secret"
+	conf := Conf{AESSecret: "0123456789abcdef0123456789abcdef", TokenTimeout: time.Hour}
+	sec, err := hash.EncAES(secret, conf.AESSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	role := psqlmodel.Role{ID: 3, Cid: "cid", Sec: sec, Scope: "svc"}
+
+	tests := []struct {
+		name   string
+		secret string
+		scope  string
+		// signed is set when the token gets issued
+		signed bool
+		code   int64
+	}{
+		{
+			name:   "client scope",
+			secret: secret,
+			signed: true,
+		},
+		{
+			name:   "client scope requested",
+			secret: secret,
+			scope:  "svc",
+			signed: true,
+		},
+		{
+			name:   "wrong client secret",
+			secret: "other-secret",
+			code:   svcerr.CodeInvalidClient,
+		},
+		{
+			name:   "scope not granted to the client",
+			secret: secret,
+			scope:  model.SuperAdminScope,
+			code:   svcerr.CodeInvalidScope,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, conf)
+			m.role.EXPECT().GetSingleByParam(ctx, "", &model.GetRoleByParam{Cid: null.NewString(role.Cid, true)}).Return(role, nil)
+			if tt.signed {
+				m.rolePermission.EXPECT().GetPermissionNames(ctx, "", int64(role.ID)).Return([]string{model.PermissionAccountRead}, nil)
+				m.token.EXPECT().Sign(ctx, model.JWTTypeAccessToken, gomock.Any()).DoAndReturn(func(_ interface{}, _ string, claims jwt.MapClaims) (string, error) {
+					// the token stands for the client, there is no account
+					// behind it
+					for _, claim := range []string{"id", "username", "email"} {
+						if _, ok := claims[claim]; ok {
+							t.Errorf("claim %s set on a client token", claim)
+						}
+					}
+					if claims["sub"] != role.Cid || claims["client_id"] != role.Cid || claims["scope"] != "svc" {
+						t.Errorf("got claims %v", claims)
+					}
+					if !reflect.DeepEqual(claims["permissions"], []string{model.PermissionAccountRead}) {
+						t.Errorf("got permissions %v", claims["permissions"])
+					}
+					return "signed", nil
+				})
+			}
+
+			auth, err := a.Oauth2(ctx, model.Login{
+				GrantType:    model.GrantTypeClientCredentials,
+				ClientID:     role.Cid,
+				ClientSecret: tt.secret,
+				Scope:        tt.scope,
+			})
+			if tt.code != 0 {
+				if code := errormsg.GetErrorCode(err); code != tt.code {
+					t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if auth.AccessToken != "signed" || auth.Scope != "svc" || auth.RefreshToken != "" || auth.IDToken != "" {
+				t.Fatalf("got %+v", auth)
+			}
+		})
+	}
+}
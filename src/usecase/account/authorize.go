@@ -0,0 +1,149 @@
+package account
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+)
+
+const authorizationCodeSize = 32
+
+// ValidateAuthorize checks an /authorize request before the login page is
+// shown. The client and redirect uri are checked first: when either is
+// wrong the caller must not redirect back, since the target is untrusted.
+func (a *AccountDep) ValidateAuthorize(ctx *gin.Context, v model.AuthorizeRequest) (model.Role, error) {
+	role, err := a.getClient(ctx, v.ClientID)
+	if err != nil {
+		return model.Role{}, err
+	}
+
+	if !model.HasRedirectURI(&role, v.RedirectURI) {
+		return model.Role{}, errormsg.WrapErr(svcerr.AccountSVCInvalidRedirectURI, nil, "redirect uri not registered")
+	}
+
+	if err = v.Validate(); err != nil {
+		return model.Role{}, err
+	}
+	return model.TransformPSQLSingleRole(&role), nil
+}
+
+// Authorize logs the account in on the hosted login page and returns a
+// single-use authorization code bound to the client, redirect uri and PKCE
+// challenge of the request. Accounts with MFA enabled first get an MFA
+// challenge, which the page posts back with the OTP instead of a password.
+func (a *AccountDep) Authorize(ctx *gin.Context, v model.Authorize) (model.AuthorizeResult, error) {
+	var res model.AuthorizeResult
+	client, err := a.ValidateAuthorize(ctx, v.AuthorizeRequest)
+	if err != nil {
+		return res, err
+	}
+
+	role, err := a.getClient(ctx, client.Cid)
+	if err != nil {
+		return res, err
+	}
+
+	var account psqlmodel.Account
+	authTime := time.Now()
+	if v.MFAToken != "" {
+		var claims jwt.MapClaims
+		account, claims, err = a.answerMFAChallenge(ctx, &role, v.MFAToken, v.OTP)
+		if err != nil {
+			return res, err
+		}
+		at, _ := claims["auth_time"].(float64)
+		authTime = time.Unix(int64(at), 0)
+	} else {
+		account, err = a.authenticateAccount(ctx, &role, v.Email, v.Password)
+		if err != nil {
+			return res, err
+		}
+
+		if account.MfaEnabledAt.Valid {
+			res.MFAToken, err = a.issueMFAToken(ctx, &account, &role, v.Scope, 0)
+			return res, err
+		}
+
+		// enrolment needs an authenticated session, which the hosted page
+		// does not keep
+		if role.MfaRequired {
+			return res, errormsg.WrapErr(svcerr.AccountSVCMFAEnrollmentRequired, nil, "mfa enrollment required")
+		}
+	}
+
+	// the hosted page has no way to change the password
+	if a.passwordExpired(&account, &role) {
+		return res, errormsg.WrapErr(svcerr.AccountSVCPasswordExpired, nil, "password expired")
+	}
+
+	code, err := common.GenerateRandomToken(authorizationCodeSize)
+	if err != nil {
+		return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate authorization code")
+	}
+
+	err = a.authCode.Insert(ctx, common.HashToken(code), &model.AuthorizationCode{
+		AccountID:     account.ID,
+		RoleID:        role.ID,
+		RedirectURI:   v.RedirectURI,
+		Scope:         v.Scope,
+		Nonce:         v.Nonce,
+		CodeChallenge: v.CodeChallenge,
+		AuthTime:      authTime,
+	})
+	if err != nil {
+		return res, err
+	}
+	res.Code = code
+	return res, nil
+}
+
+// authorizationCodeGrant exchanges a code from Authorize for tokens. The
+// code is deleted on lookup, so a failed exchange cannot be retried.
+func (a *AccountDep) authorizationCodeGrant(ctx *gin.Context, v model.Login, role psqlmodel.Role) (model.Auth, error) {
+	var auth model.Auth
+	data, err := a.authCode.Take(ctx, common.HashToken(v.Code))
+	if err != nil {
+		return auth, err
+	}
+
+	if data.RoleID != role.ID || data.RedirectURI != v.RedirectURI {
+		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidAuthorizationCode, nil, "authorization code issued to another client")
+	}
+
+	if !model.VerifyCodeChallenge(v.CodeVerifier, data.CodeChallenge) {
+		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidCodeChallenge, nil, "code verifier does not match")
+	}
+
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(int64(data.AccountID), true),
+	})
+	if err != nil {
+		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidAuthorizationCode, err, "account not found")
+	}
+
+	auth, err = a.generateAccessToken(ctx, &account, &role, data.Scope, 0)
+	if err != nil {
+		return auth, err
+	}
+
+	if model.HasScope(data.Scope, model.ScopeOpenID) {
+		auth.IDToken, err = a.issueIDToken(ctx, &account, &role, data.Scope, data.Nonce, data.AuthTime)
+		if err != nil {
+			return model.Auth{}, err
+		}
+	}
+
+	auth.RefreshToken, err = a.issueRefreshToken(ctx, &account, &role, auth, uuid.NewString())
+	if err != nil {
+		return model.Auth{}, err
+	}
+	return auth, nil
+}
@@ -0,0 +1,49 @@
+package account
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestOauth2AuthorizationCodeClient(t *testing.T) {
+	tests := []struct {
+		name   string
+		public bool
+		// redeemed is set when the client gets as far as taking the code
+		redeemed bool
+		code     int64
+	}{
+		{name: "confidential client without secret", code: svcerr.CodeInvalidClient},
+		{name: "public client", public: true, redeemed: true, code: svcerr.CodeInvalidAuthorizationCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{})
+			role := psqlmodel.Role{ID: 1, Cid: "cid", Sec: "sec", PublicClient: tt.public}
+			m.role.EXPECT().GetSingleByParam(ctx, "", &model.GetRoleByParam{
+				Cid: null.NewString(role.Cid, true),
+			}).Return(role, nil)
+			if tt.redeemed {
+				m.authCode.EXPECT().Take(ctx, common.HashToken("code")).Return(model.AuthorizationCode{}, errormsg.WrapErr(svcerr.AccountSVCInvalidAuthorizationCode, errors.New("not found"), "invalid code"))
+			}
+
+			_, err := a.Oauth2(ctx, model.Login{
+				GrantType:    model.GrantTypeAuthorizationCode,
+				ClientID:     role.Cid,
+				Code:         "code",
+				RedirectURI:  "https://example.com/callback",
+				CodeVerifier: "verifier",
+			})
+			if code := errormsg.GetErrorCode(err); code != tt.code {
+				t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+			}
+		})
+	}
+}
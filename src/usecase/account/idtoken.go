@@ -0,0 +1,38 @@
+package account
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+)
+
+// issueIDToken builds the OpenID Connect id_token for account. The profile
+// and email claims are only included when their scope was requested.
+// nonce is only known on the authorization_code grant, and authTime is
+// unknown on the refresh_token grant; both are left out when empty.
+func (a *AccountDep) issueIDToken(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role, scope, nonce string, authTime time.Time) (string, error) {
+	claims := jwt.MapClaims{
+		"sub": strconv.Itoa(account.ID),
+		"aud": role.Cid,
+		"exp": time.Now().Add(a.conf.TokenTimeout).Unix(),
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	if !authTime.IsZero() {
+		claims["auth_time"] = authTime.Unix()
+	}
+	if model.HasScope(scope, model.ScopeProfile) {
+		claims["name"] = account.Name
+		claims["updated_at"] = account.UpdatedAt.Unix()
+	}
+	if model.HasScope(scope, model.ScopeEmail) {
+		claims["email"] = account.Email
+		claims["email_verified"] = account.EmailVerifiedAt.Valid
+	}
+	return a.token.Sign(ctx, model.JWTTypeIDToken, claims)
+}
@@ -0,0 +1,88 @@
+package account
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestIssueIDToken(t *testing.T) {
+	updatedAt := time.Now().Add(-time.Hour)
+	authTime := time.Now().Add(-time.Minute)
+	account := psqlmodel.Account{ID: 7, Name: "user", Email: testEmail, UpdatedAt: updatedAt, EmailVerifiedAt: null.TimeFrom(updatedAt)}
+	role := psqlmodel.Role{ID: 2, Cid: "cid"}
+
+	tests := []struct {
+		name     string
+		scope    string
+		nonce    string
+		authTime time.Time
+		// want holds the claims besides sub, aud and exp
+		want jwt.MapClaims
+	}{
+		{
+			name:     "authorization code grant",
+			scope:    model.ScopeOpenID,
+			nonce:    "nonce",
+			authTime: authTime,
+			want:     jwt.MapClaims{"nonce": "nonce", "auth_time": authTime.Unix()},
+		},
+		{
+			name:  "refresh token grant",
+			scope: model.ScopeOpenID,
+			want:  jwt.MapClaims{},
+		},
+		{
+			name:     "profile scope",
+			scope:    "openid profile",
+			authTime: authTime,
+			want:     jwt.MapClaims{"auth_time": authTime.Unix(), "name": "user", "updated_at": updatedAt.Unix()},
+		},
+		{
+			name:     "email scope",
+			scope:    "openid email",
+			authTime: authTime,
+			want:     jwt.MapClaims{"auth_time": authTime.Unix(), "email": testEmail, "email_verified": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{TokenTimeout: time.Hour})
+			// the typ header set from JWTTypeIDToken keeps id tokens from
+			// being accepted as access tokens
+			m.token.EXPECT().Sign(ctx, model.JWTTypeIDToken, gomock.Any()).DoAndReturn(func(_ interface{}, _ string, claims jwt.MapClaims) (string, error) {
+				if claims["sub"] != "7" || claims["aud"] != role.Cid {
+					t.Errorf("got sub %v, aud %v", claims["sub"], claims["aud"])
+				}
+				exp, _ := claims["exp"].(int64)
+				if d := time.Until(time.Unix(exp, 0)); d < 59*time.Minute || d > time.Hour {
+					t.Errorf("expires in %s, want the token timeout", d)
+				}
+				rest := jwt.MapClaims{}
+				for k, v := range claims {
+					if k != "sub" && k != "aud" && k != "exp" {
+						rest[k] = v
+					}
+				}
+				if !reflect.DeepEqual(rest, tt.want) {
+					t.Errorf("got claims %v, want %v", rest, tt.want)
+				}
+				return "signed", nil
+			})
+
+			got, err := a.issueIDToken(ctx, &account, &role, tt.scope, tt.nonce, tt.authTime)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != "signed" {
+				t.Fatalf("got %q", got)
+			}
+		})
+	}
+}
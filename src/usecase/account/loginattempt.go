@@ -0,0 +1,123 @@
+package account
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+)
+
+// Unlock lifts the lockout of an account and forgets its failed logins.
+// Locks on client ips are left alone, they expire on their own.
+func (a *AccountDep) Unlock(ctx *gin.Context, id int64) error {
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCNotFound, err, "data not found")
+	}
+	return a.loginAttempt.Reset(ctx, model.LoginSubjectForAccount(account.Email))
+}
+
+// checkLoginAttempts refuses a login while the account or the client ip is
+// locked, or while the account is inside the delay after its last failure.
+// A lockout that cannot be checked refuses the login, so redis being
+// unavailable does not lift the brute force protection.
+func (a *AccountDep) checkLoginAttempts(ctx *gin.Context, email string) error {
+	for _, subject := range []string{model.LoginSubjectForAccount(email), model.LoginSubjectForIP(ctx.ClientIP())} {
+		status, err := a.loginAttempt.Status(ctx, subject)
+		if err != nil {
+			return errormsg.WrapErr(svcerr.AccountSVCServiceUnavailable, err, "error check login attempts")
+		}
+
+		if status.LockedFor > 0 {
+			return errormsg.WrapErr(svcerr.AccountSVCAccountLocked, nil, "login locked for "+status.LockedFor.Round(time.Second).String())
+		}
+		if status.DelayedFor > 0 {
+			return errormsg.WrapErr(svcerr.AccountSVCTooManyRequests, nil, "login delayed for "+status.DelayedFor.Round(time.Millisecond).String())
+		}
+	}
+	return nil
+}
+
+// recordLoginFailure counts a failed login for the account and the client
+// ip. Every failure of an account doubles the delay before it may try again,
+// up to LoginMaxDelay. The ip gets no delay, since many users can share one,
+// but both are locked for LoginLockoutDuration once their threshold is hit.
+func (a *AccountDep) recordLoginFailure(ctx *gin.Context, email string) {
+	window := a.conf.LoginFailureWindow
+	if window == 0 {
+		window = model.DefaultLoginFailureWindow
+	}
+	lockout := a.conf.LoginLockoutDuration
+	if lockout == 0 {
+		lockout = model.DefaultLoginLockoutDuration
+	}
+	maxFailures := a.conf.LoginMaxFailures
+	if maxFailures == 0 {
+		maxFailures = model.DefaultLoginMaxFailures
+	}
+	maxIPFailures := a.conf.LoginMaxIPFailures
+	if maxIPFailures == 0 {
+		maxIPFailures = model.DefaultLoginMaxIPFailures
+	}
+
+	subject := model.LoginSubjectForAccount(email)
+	count, err := a.loginAttempt.RecordFailure(ctx, subject, window)
+	if err != nil {
+		a.log.Warn(ctx, err)
+	} else if count >= maxFailures {
+		a.lockLogin(ctx, subject, lockout)
+	} else if err = a.loginAttempt.Delay(ctx, subject, a.loginDelay(count)); err != nil {
+		a.log.Warn(ctx, err)
+	}
+
+	subject = model.LoginSubjectForIP(ctx.ClientIP())
+	count, err = a.loginAttempt.RecordFailure(ctx, subject, window)
+	if err != nil {
+		a.log.Warn(ctx, err)
+	} else if count >= maxIPFailures {
+		a.lockLogin(ctx, subject, lockout)
+	}
+}
+
+// resetLoginFailures forgets the failures of an account after a successful
+// login. The ip count is kept, so one valid account cannot be used to hide
+// credential stuffing from the same ip.
+func (a *AccountDep) resetLoginFailures(ctx *gin.Context, email string) {
+	if err := a.loginAttempt.Reset(ctx, model.LoginSubjectForAccount(email)); err != nil {
+		a.log.Warn(ctx, err)
+	}
+}
+
+func (a *AccountDep) lockLogin(ctx *gin.Context, subject string, lockout time.Duration) {
+	err := a.loginAttempt.Lock(ctx, subject, lockout)
+	if err != nil {
+		a.log.Warn(ctx, err)
+		return
+	}
+	a.log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCAccountLocked, nil, "login locked for "+subject))
+}
+
+func (a *AccountDep) loginDelay(failures int64) time.Duration {
+	step := a.conf.LoginDelayStep
+	if step == 0 {
+		step = model.DefaultLoginDelayStep
+	}
+	maxDelay := a.conf.LoginMaxDelay
+	if maxDelay == 0 {
+		maxDelay = model.DefaultLoginMaxDelay
+	}
+
+	delay := step
+	for i := int64(1); i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
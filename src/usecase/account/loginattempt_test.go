@@ -0,0 +1,226 @@
+package account
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mock_account "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/account"
+	mock_accountrole "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/accountrole"
+	mock_apikey "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/apikey"
+	mock_authcode "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/authcode"
+	mock_loginattempt "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/loginattempt"
+	mock_mailer "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/mailer"
+	mock_passwordhistory "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/passwordhistory"
+	mock_passwordreset "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/passwordreset"
+	mock_ratelimit "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/ratelimit"
+	mock_refreshtoken "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/refreshtoken"
+	mock_role "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/role"
+	mock_rolepermission "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/rolepermission"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	mock_passwordhash "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/passwordhash"
+	mock_passwordpolicy "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/passwordpolicy"
+	mock_token "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/token"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+type testMocks struct {
+	account         *mock_account.MockAccountInterface
+	role            *mock_role.MockRoleInterface
+	accountRole     *mock_accountrole.MockAccountRoleInterface
+	refreshToken    *mock_refreshtoken.MockRefreshTokenInterface
+	authCode        *mock_authcode.MockAuthCodeInterface
+	token           *mock_token.MockTokenInterface
+	loginAttempt    *mock_loginattempt.MockLoginAttemptInterface
+	rolePermission  *mock_rolepermission.MockRolePermissionInterface
+	mailer          *mock_mailer.MockMailerInterface
+	rateLimit       *mock_ratelimit.MockRateLimitInterface
+	passwordReset   *mock_passwordreset.MockPasswordResetInterface
+	passwordPolicy  *mock_passwordpolicy.MockPasswordPolicyInterface
+	passwordHistory *mock_passwordhistory.MockPasswordHistoryInterface
+	passwordHash    *mock_passwordhash.MockPasswordHashInterface
+	apiKey          *mock_apikey.MockAPIKeyInterface
+}
+
+// newTestAccount returns the usecase on mocks of the domains the tests
+// reach, and a request context from testIP.
+func newTestAccount(t *testing.T, conf Conf) (*AccountDep, *testMocks, *gin.Context) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &testMocks{
+		account:         mock_account.NewMockAccountInterface(ctrl),
+		role:            mock_role.NewMockRoleInterface(ctrl),
+		accountRole:     mock_accountrole.NewMockAccountRoleInterface(ctrl),
+		refreshToken:    mock_refreshtoken.NewMockRefreshTokenInterface(ctrl),
+		authCode:        mock_authcode.NewMockAuthCodeInterface(ctrl),
+		token:           mock_token.NewMockTokenInterface(ctrl),
+		loginAttempt:    mock_loginattempt.NewMockLoginAttemptInterface(ctrl),
+		rolePermission:  mock_rolepermission.NewMockRolePermissionInterface(ctrl),
+		mailer:          mock_mailer.NewMockMailerInterface(ctrl),
+		rateLimit:       mock_ratelimit.NewMockRateLimitInterface(ctrl),
+		passwordReset:   mock_passwordreset.NewMockPasswordResetInterface(ctrl),
+		passwordPolicy:  mock_passwordpolicy.NewMockPasswordPolicyInterface(ctrl),
+		passwordHistory: mock_passwordhistory.NewMockPasswordHistoryInterface(ctrl),
+		passwordHash:    mock_passwordhash.NewMockPasswordHashInterface(ctrl),
+		apiKey:          mock_apikey.NewMockAPIKeyInterface(ctrl),
+	}
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	a := &AccountDep{
+		conf:            conf,
+		log:             log,
+		account:         m.account,
+		role:            m.role,
+		accountRole:     m.accountRole,
+		refreshToken:    m.refreshToken,
+		authCode:        m.authCode,
+		token:           m.token,
+		loginAttempt:    m.loginAttempt,
+		rolePermission:  m.rolePermission,
+		mailer:          m.mailer,
+		rateLimit:       m.rateLimit,
+		passwordReset:   m.passwordReset,
+		passwordPolicy:  m.passwordPolicy,
+		passwordHistory: m.passwordHistory,
+		passwordHash:    m.passwordHash,
+		apiKey:          m.apiKey,
+	}
+
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/oauth2", nil)
+	ctx.Request.RemoteAddr = testIP + ":1234"
+	return a, m, ctx
+}
+
+const (
+	testIP    = "192.0.2.1"
+	testEmail = "user@example.com"
+)
+
+func TestLoginDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		conf     Conf
+		failures int64
+		delay    time.Duration
+	}{
+		{"first failure", Conf{}, 1, time.Second},
+		{"doubles", Conf{}, 3, 4 * time.Second},
+		{"capped", Conf{}, 6, model.DefaultLoginMaxDelay},
+		{"stays capped", Conf{}, 100, model.DefaultLoginMaxDelay},
+		{"configured step", Conf{LoginDelayStep: 100 * time.Millisecond, LoginMaxDelay: time.Second}, 4, 800 * time.Millisecond},
+		{"configured cap", Conf{LoginDelayStep: 100 * time.Millisecond, LoginMaxDelay: time.Second}, 5, time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &AccountDep{conf: tt.conf}
+			if delay := a.loginDelay(tt.failures); delay != tt.delay {
+				t.Fatalf("got %s, want %s", delay, tt.delay)
+			}
+		})
+	}
+}
+
+func TestRecordLoginFailure(t *testing.T) {
+	accountSubject := model.LoginSubjectForAccount(testEmail)
+	ipSubject := model.LoginSubjectForIP(testIP)
+	tests := []struct {
+		name         string
+		conf         Conf
+		accountCount int64
+		accountErr   error
+		ipCount      int64
+		delay        time.Duration
+		lock         map[string]time.Duration
+	}{
+		{
+			name:         "below the thresholds",
+			accountCount: 1,
+			ipCount:      1,
+			delay:        time.Second,
+		},
+		{
+			name:         "account threshold",
+			accountCount: model.DefaultLoginMaxFailures,
+			ipCount:      1,
+			lock:         map[string]time.Duration{accountSubject: model.DefaultLoginLockoutDuration},
+		},
+		{
+			name:         "ip threshold",
+			accountCount: 2,
+			ipCount:      model.DefaultLoginMaxIPFailures,
+			delay:        2 * time.Second,
+			lock:         map[string]time.Duration{ipSubject: model.DefaultLoginLockoutDuration},
+		},
+		{
+			name:         "configured thresholds",
+			conf:         Conf{LoginMaxFailures: 3, LoginMaxIPFailures: 10, LoginLockoutDuration: time.Hour},
+			accountCount: 3,
+			ipCount:      10,
+			lock:         map[string]time.Duration{accountSubject: time.Hour, ipSubject: time.Hour},
+		},
+		{
+			name:       "account count unavailable",
+			accountErr: errors.New("redis down"),
+			ipCount:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, tt.conf)
+			m.loginAttempt.EXPECT().RecordFailure(ctx, accountSubject, model.DefaultLoginFailureWindow).Return(tt.accountCount, tt.accountErr)
+			m.loginAttempt.EXPECT().RecordFailure(ctx, ipSubject, model.DefaultLoginFailureWindow).Return(tt.ipCount, nil)
+			if tt.delay != 0 {
+				m.loginAttempt.EXPECT().Delay(ctx, accountSubject, tt.delay).Return(nil)
+			}
+			for subject, d := range tt.lock {
+				m.loginAttempt.EXPECT().Lock(ctx, subject, d).Return(nil)
+			}
+
+			a.recordLoginFailure(ctx, testEmail)
+		})
+	}
+}
+
+func TestCheckLoginAttempts(t *testing.T) {
+	tests := []struct {
+		name    string
+		account model.LoginAttemptStatus
+		ip      model.LoginAttemptStatus
+		err     error
+		code    int64
+	}{
+		{name: "allowed"},
+		{name: "account locked", account: model.LoginAttemptStatus{LockedFor: time.Minute}, code: svcerr.CodeAccountLocked},
+		{name: "account delayed", account: model.LoginAttemptStatus{DelayedFor: time.Second}, code: svcerr.CodeTooManyRequests},
+		{name: "ip locked", ip: model.LoginAttemptStatus{LockedFor: time.Minute}, code: svcerr.CodeAccountLocked},
+		{name: "status unavailable", err: errors.New("redis down"), code: svcerr.CodeServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{})
+			m.loginAttempt.EXPECT().Status(ctx, model.LoginSubjectForAccount(testEmail)).Return(tt.account, tt.err)
+			if tt.err == nil && tt.account == (model.LoginAttemptStatus{}) {
+				m.loginAttempt.EXPECT().Status(ctx, model.LoginSubjectForIP(testIP)).Return(tt.ip, nil)
+			}
+
+			err := a.checkLoginAttempts(ctx, testEmail)
+			if tt.code == 0 {
+				if err != nil {
+					t.Fatalf("got %v", err)
+				}
+				return
+			}
+			if code := errormsg.GetErrorCode(err); code != tt.code {
+				t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+			}
+		})
+	}
+}
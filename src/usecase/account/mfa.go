@@ -0,0 +1,365 @@
+package account
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/hash"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+)
+
+// EnrollMFA starts TOTP enrolment with a new secret. The secret is stored
+// encrypted but MFA stays off until ConfirmMFA proves the authenticator app
+// has it, so calling this again simply replaces a pending secret.
+func (a *AccountDep) EnrollMFA(ctx *gin.Context, id int64) (model.MFAEnrollment, error) {
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.MFAEnrollment{}, err
+	}
+
+	if account.MfaEnabledAt.Valid {
+		return model.MFAEnrollment{}, errormsg.WrapErr(svcerr.AccountSVCMFAAlreadyEnabled, nil, "mfa already enabled")
+	}
+
+	secret, err := common.GenerateTOTPSecret()
+	if err != nil {
+		return model.MFAEnrollment{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate totp secret")
+	}
+
+	account.MfaSecret, err = hash.EncAES(secret, a.conf.AESSecret)
+	if err != nil {
+		return model.MFAEnrollment{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error encrypt totp secret")
+	}
+	account.UpdatedBy = int(id)
+
+	err = a.account.Update(ctx, &account)
+	if err != nil {
+		return model.MFAEnrollment{}, err
+	}
+
+	return model.MFAEnrollment{
+		Secret:     secret,
+		OtpauthURI: common.TOTPURI(a.mfaIssuer(), account.Email, secret),
+	}, nil
+}
+
+// ConfirmMFA turns MFA on once code matches the pending secret and returns
+// the first set of recovery codes.
+func (a *AccountDep) ConfirmMFA(ctx *gin.Context, id int64, v model.MFACode) (model.RecoveryCodes, error) {
+	if err := v.Validate(); err != nil {
+		return model.RecoveryCodes{}, err
+	}
+
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.RecoveryCodes{}, err
+	}
+
+	if account.MfaEnabledAt.Valid {
+		return model.RecoveryCodes{}, errormsg.WrapErr(svcerr.AccountSVCMFAAlreadyEnabled, nil, "mfa already enabled")
+	}
+
+	if err = a.verifyTOTP(ctx, &account, v.Code); err != nil {
+		return model.RecoveryCodes{}, err
+	}
+
+	account.MfaEnabledAt = null.TimeFrom(time.Now())
+	account.UpdatedBy = int(id)
+	err = a.account.Update(ctx, &account)
+	if err != nil {
+		return model.RecoveryCodes{}, err
+	}
+	return a.replaceRecoveryCodes(ctx, &account)
+}
+
+// DisableMFA turns MFA off after checking a TOTP or recovery code. An
+// account holding a role that requires MFA will be asked to enrol again on
+// its next login.
+func (a *AccountDep) DisableMFA(ctx *gin.Context, id int64, v model.MFACode) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !account.MfaEnabledAt.Valid {
+		return errormsg.WrapErr(svcerr.AccountSVCMFANotEnrolled, nil, "mfa not enabled")
+	}
+
+	if err = a.verifyOTP(ctx, &account, v.Code); err != nil {
+		return err
+	}
+
+	account.MfaSecret = ""
+	account.MfaEnabledAt = null.Time{}
+	account.UpdatedBy = int(id)
+	err = a.account.Update(ctx, &account)
+	if err != nil {
+		return err
+	}
+	return a.recoveryCode.DeleteByAccount(ctx, account.ID)
+}
+
+// RegenerateRecoveryCodes replaces every recovery code of the account. Only
+// a TOTP code is accepted, so a leaked recovery code cannot renew the set.
+func (a *AccountDep) RegenerateRecoveryCodes(ctx *gin.Context, id int64, v model.MFACode) (model.RecoveryCodes, error) {
+	if err := v.Validate(); err != nil {
+		return model.RecoveryCodes{}, err
+	}
+
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.RecoveryCodes{}, err
+	}
+
+	if !account.MfaEnabledAt.Valid {
+		return model.RecoveryCodes{}, errormsg.WrapErr(svcerr.AccountSVCMFANotEnrolled, nil, "mfa not enabled")
+	}
+
+	if err = a.verifyTOTP(ctx, &account, v.Code); err != nil {
+		return model.RecoveryCodes{}, err
+	}
+	return a.replaceRecoveryCodes(ctx, &account)
+}
+
+// issueMFAToken returns the challenge a password login gets instead of
+// tokens when the account has MFA enabled. It is exchanged together with an
+// OTP on the mfa_otp grant or on the hosted login page. The requested scope
+// and organisation are kept for the tokens issued once it is answered.
+func (a *AccountDep) issueMFAToken(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role, scope string, organisationID int64) (string, error) {
+	now := time.Now()
+	return a.token.Sign(ctx, model.JWTTypeMFAChallenge, jwt.MapClaims{
+		"sub":       strconv.Itoa(account.ID),
+		"client_id": role.Cid,
+		"scope":     scope,
+		"org_id":    organisationID,
+		"auth_time": now.Unix(),
+		"exp":       now.Add(a.mfaTokenTimeout()).Unix(),
+	})
+}
+
+// answerMFAChallenge checks an OTP against the account a challenge was
+// issued for. The challenge is consumed only on success, and the number of
+// wrong answers per challenge is capped to stop guessing.
+func (a *AccountDep) answerMFAChallenge(ctx *gin.Context, role *psqlmodel.Role, mfaToken, otp string) (psqlmodel.Account, jwt.MapClaims, error) {
+	var account psqlmodel.Account
+	claims, err := a.token.Verify(ctx, model.JWTTypeMFAChallenge, mfaToken)
+	if err != nil {
+		return account, nil, errormsg.WrapErr(svcerr.AccountSVCInvalidMFAToken, err, "invalid mfa token")
+	}
+
+	if cid, _ := claims["client_id"].(string); cid != role.Cid {
+		return account, nil, errormsg.WrapErr(svcerr.AccountSVCInvalidMFAToken, nil, "mfa token issued to another client")
+	}
+
+	sub, _ := claims["sub"].(string)
+	id, err := strconv.ParseInt(sub, 10, 64)
+	if err != nil {
+		return account, nil, errormsg.WrapErr(svcerr.AccountSVCInvalidMFAToken, err, "invalid subject")
+	}
+
+	maxAttempts := a.conf.MFAMaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = model.DefaultMFAMaxAttempts
+	}
+	jti, _ := claims["jti"].(string)
+	allowed, err := a.rateLimit.Allow(ctx, fmt.Sprintf(model.MFAAttemptKey, jti), maxAttempts, a.mfaTokenTimeout())
+	if err != nil {
+		return account, nil, err
+	}
+	if !allowed {
+		return account, nil, errormsg.WrapErr(svcerr.AccountSVCInvalidMFAToken, nil, "too many mfa attempts")
+	}
+
+	account, err = a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return account, nil, errormsg.WrapErr(svcerr.AccountSVCInvalidMFAToken, err, "account not found")
+	}
+
+	if err = a.checkLoginAttempts(ctx, account.Email); err != nil {
+		return account, nil, err
+	}
+
+	if err = a.verifyOTP(ctx, &account, otp); err != nil {
+		a.recordLoginFailure(ctx, account.Email)
+		return account, nil, err
+	}
+
+	if err = a.token.Consume(ctx, claims); err != nil {
+		return account, nil, errormsg.WrapErr(svcerr.AccountSVCInvalidMFAToken, err, "mfa token already used")
+	}
+	a.resetLoginFailures(ctx, account.Email)
+	return account, claims, nil
+}
+
+// mfaOTPGrant finishes a password login that was answered with an MFA
+// challenge and issues the tokens the password grant would have.
+func (a *AccountDep) mfaOTPGrant(ctx *gin.Context, v model.Login, role psqlmodel.Role) (model.Auth, error) {
+	account, claims, err := a.answerMFAChallenge(ctx, &role, v.MFAToken, v.OTP)
+	if err != nil {
+		return model.Auth{}, err
+	}
+
+	if a.passwordExpired(&account, &role) {
+		return a.issuePasswordExpiredToken(ctx, &account, &role)
+	}
+
+	scope, _ := claims["scope"].(string)
+	organisationID, _ := claims["org_id"].(float64)
+	auth, err := a.generateAccessToken(ctx, &account, &role, scope, int64(organisationID))
+	if err != nil {
+		return auth, err
+	}
+
+	if model.HasScope(scope, model.ScopeOpenID) {
+		authTime, _ := claims["auth_time"].(float64)
+		auth.IDToken, err = a.issueIDToken(ctx, &account, &role, scope, "", time.Unix(int64(authTime), 0))
+		if err != nil {
+			return model.Auth{}, err
+		}
+	}
+
+	auth.RefreshToken, err = a.issueRefreshToken(ctx, &account, &role, auth, uuid.NewString())
+	if err != nil {
+		return model.Auth{}, err
+	}
+	return auth, nil
+}
+
+// issueRestrictedToken gives an account that must still act before it can
+// log in, such as enrolling MFA, a short-lived token limited to the routes
+// in model.RestrictedRoutes for restriction. No refresh token is issued. The
+// token carries no scope or permissions and its own typ, so services that
+// verify access tokens through the JWKS do not accept it either.
+func (a *AccountDep) issueRestrictedToken(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role, restriction string) (model.Auth, error) {
+	expired := time.Now().Add(model.DefaultRestrictedTokenExpiration)
+	t, err := a.token.Sign(ctx, model.JWTTypeRestrictedToken, jwt.MapClaims{
+		"id":          account.ID,
+		"sub":         strconv.Itoa(account.ID),
+		"username":    account.Email,
+		"client_id":   role.Cid,
+		"restriction": restriction,
+		"scope":       "",
+		"permissions": []string{},
+		"exp":         expired.Unix(),
+	})
+	if err != nil {
+		return model.Auth{}, err
+	}
+
+	return model.Auth{
+		AccessToken: t,
+		Exp:         &expired,
+		TokenType:   model.TokenTypeBearer,
+		Restriction: restriction,
+	}, nil
+}
+
+// verifyOTP accepts either a TOTP code or an unused recovery code.
+func (a *AccountDep) verifyOTP(ctx *gin.Context, account *psqlmodel.Account, otp string) error {
+	if len(otp) == common.TOTPDigits {
+		return a.verifyTOTP(ctx, account, otp)
+	}
+	return a.recoveryCode.Use(ctx, account.ID, common.HashToken(model.NormalizeRecoveryCode(otp)))
+}
+
+// verifyTOTP checks code against the account's secret. A code is accepted
+// once, so one observed code cannot be replayed within its validity window.
+func (a *AccountDep) verifyTOTP(ctx *gin.Context, account *psqlmodel.Account, code string) error {
+	if account.MfaSecret == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCMFANotEnrolled, nil, "mfa not enrolled")
+	}
+
+	secret, err := hash.DecAES(account.MfaSecret, a.conf.AESSecret)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error decrypt totp secret")
+	}
+
+	step, ok := common.ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidOTP, nil, "invalid totp code")
+	}
+
+	window := time.Duration(common.TOTPPeriod*(2*common.TOTPSkew+1)) * time.Second
+	fresh, err := a.rateLimit.Allow(ctx, fmt.Sprintf(model.TOTPUsedKey, account.ID, step), 1, window)
+	if err != nil {
+		return err
+	}
+	if !fresh {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidOTP, nil, "totp code already used")
+	}
+	return nil
+}
+
+func (a *AccountDep) replaceRecoveryCodes(ctx *gin.Context, account *psqlmodel.Account) (model.RecoveryCodes, error) {
+	var res model.RecoveryCodes
+	var data psqlmodel.RecoveryCodeSlice
+	for i := 0; i < model.RecoveryCodeCount; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return res, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate recovery code")
+		}
+		res.Codes = append(res.Codes, code)
+		data = append(data, &psqlmodel.RecoveryCode{
+			AccountID: account.ID,
+			CodeHash:  common.HashToken(model.NormalizeRecoveryCode(code)),
+			CreatedBy: account.ID,
+			UpdatedBy: account.ID,
+		})
+	}
+
+	if err := a.recoveryCode.Replace(ctx, account.ID, data); err != nil {
+		return model.RecoveryCodes{}, err
+	}
+	return res, nil
+}
+
+func (a *AccountDep) mfaTokenTimeout() time.Duration {
+	if a.conf.MFATokenTimeout != 0 {
+		return a.conf.MFATokenTimeout
+	}
+	return model.DefaultMFATokenExpiration
+}
+
+func (a *AccountDep) mfaIssuer() string {
+	if a.conf.MFAIssuer != "" {
+		return a.conf.MFAIssuer
+	}
+	return model.DefaultMFAIssuer
+}
+
+// generateRecoveryCode returns a code like "abcde-fghij". 50 random bits
+// are plenty for a single-use code that is rate limited per challenge.
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	s := strings.ToLower(base32.StdEncoding.EncodeToString(b))[:10]
+	return s[:5] + "-" + s[5:], nil
+}
@@ -0,0 +1,52 @@
+package account
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+)
+
+// tokenOrganisation returns the membership an account logs in through role
+// with, when role was granted to it within an organisation. An account can
+// hold role in several organisations, organisationID picks one of them.
+// Without it a grant that is not scoped to an organisation is preferred,
+// for which the result is nil, then the oldest one.
+func (a *AccountDep) tokenOrganisation(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role, organisationID int64) (*psqlmodel.OrganisationMember, error) {
+	param := &model.GetAccountRolesByParam{
+		GetAccountRoleByParam: model.GetAccountRoleByParam{
+			AccountID: null.NewInt64(int64(account.ID), true),
+			RoleID:    null.NewInt64(int64(role.ID), true),
+		},
+		ActiveOnly: true,
+		PageParam: model.PageParam{
+			OrderBy: null.NewString("organisation_id nulls first,id", true),
+			Limit:   1,
+		},
+	}
+	if organisationID != 0 {
+		param.OrganisationID = null.NewInt64(organisationID, true)
+	}
+
+	accountRoles, _, err := a.accountRole.GetByParam(ctx, model.MustRevalidate, param)
+	if err != nil {
+		return nil, err
+	}
+	if len(accountRoles) == 0 {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCNotOrganisationMember, nil, "role not granted within the organisation")
+	}
+	if !accountRoles[0].OrganisationID.Valid {
+		return nil, nil
+	}
+
+	member, err := a.organisationMember.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrganisationMemberByParam{
+		OrganisationID: null.NewInt64(int64(accountRoles[0].OrganisationID.Int), true),
+		AccountID:      null.NewInt64(int64(account.ID), true),
+	})
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCNotOrganisationMember, err, "account is not a member of the organisation")
+	}
+	return &member, nil
+}
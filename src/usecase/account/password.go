@@ -0,0 +1,205 @@
+package account
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+)
+
+const passwordResetTokenSize = 32
+
+// ForgotPassword mails a reset link when the email belongs to an account.
+// The result is the same whether it does or not, and the account is only
+// looked up once the request is answered, so neither the result nor the
+// response time tells which emails are registered.
+func (a *AccountDep) ForgotPassword(ctx *gin.Context, v model.ForgotPassword) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	interval := a.conf.PasswordForgotInterval
+	if interval == 0 {
+		interval = model.DefaultPasswordForgotInterval
+	}
+	allowed, err := a.rateLimit.Allow(ctx, fmt.Sprintf(model.PasswordForgotKey, v.Email), 1, interval)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errormsg.WrapErr(svcerr.AccountSVCTooManyRequests, nil, "password forgot throttled")
+	}
+
+	a.mailInBackground(ctx, func(ctx *gin.Context) error {
+		account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+			Email: null.NewString(v.Email, true),
+		})
+		if err != nil {
+			return nil
+		}
+		return a.sendPasswordReset(ctx, &account)
+	})
+	return nil
+}
+
+func (a *AccountDep) sendPasswordReset(ctx *gin.Context, account *psqlmodel.Account) error {
+	token, err := common.GenerateRandomToken(passwordResetTokenSize)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate password reset token")
+	}
+
+	err = a.passwordReset.Insert(ctx, common.HashToken(token), &model.PasswordReset{
+		AccountID: account.ID,
+		Email:     account.Email,
+		IssuedAt:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.mailer.Send(ctx, model.Mail{
+		To:      account.Email,
+		Subject: "Reset your CarRent password",
+		Body: fmt.Sprintf("Hi %s,\n\nWe received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\nIf you did not ask for this, you can ignore this email.\n",
+			account.Name, fmt.Sprintf(a.conf.PasswordResetURL, token)),
+	})
+}
+
+// ResetPassword sets a new password with a token from ForgotPassword and
+// ends every session of the account. The new password is checked before
+// the token is used up, so a rejected password does not cost the user the
+// reset link. A token issued before the password last changed, by an
+// earlier reset or otherwise, no longer works.
+func (a *AccountDep) ResetPassword(ctx *gin.Context, v model.ResetPassword) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	tokenHash := common.HashToken(v.Token)
+	data, err := a.passwordReset.Get(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(int64(data.AccountID), true),
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidResetToken, err, "account not found")
+	}
+
+	if account.Email != data.Email {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidResetToken, nil, "email changed since token was issued")
+	}
+
+	if data.IssuedAt.Before(account.PasswordChangedAt) {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidResetToken, nil, "password changed since token was issued")
+	}
+
+	err = a.passwordPolicy.Validate(model.PasswordCandidate{
+		Password: v.Password,
+		Email:    account.Email,
+		Name:     account.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = a.checkPasswordReuse(ctx, &account, v.Password)
+	if err != nil {
+		return err
+	}
+
+	// taking the token makes it single-use, of two resets racing with the
+	// same token only one gets here
+	if _, err = a.passwordReset.Take(ctx, tokenHash); err != nil {
+		return err
+	}
+	return a.storePassword(ctx, &account, v.Password, int64(account.ID))
+}
+
+// checkPasswordReuse rejects password when it is the current password or
+// one of the last PasswordHistorySize ones.
+func (a *AccountDep) checkPasswordReuse(ctx *gin.Context, account *psqlmodel.Account, password string) error {
+	if a.passwordHash.Compare(account.Password, password) == nil {
+		return errormsg.WrapErr(svcerr.AccountSVCPasswordReused, nil, "password is the current password")
+	}
+
+	history, err := a.passwordHistory.GetRecent(ctx, account.ID, a.passwordHistorySize())
+	if err != nil {
+		return err
+	}
+
+	for _, v := range history {
+		if a.passwordHash.Compare(v.Password, password) == nil {
+			return errormsg.WrapErr(svcerr.AccountSVCPasswordReused, nil, "password found in history")
+		}
+	}
+	return nil
+}
+
+// RecordPasswordHistory keeps the current password of account so it cannot
+// be chosen again for the next PasswordHistorySize changes.
+func (a *AccountDep) RecordPasswordHistory(ctx *gin.Context, account *psqlmodel.Account) error {
+	return a.passwordHistory.Insert(ctx, &psqlmodel.PasswordHistory{
+		AccountID: account.ID,
+		Password:  account.Password,
+		CreatedBy: account.UpdatedBy,
+		UpdatedBy: account.UpdatedBy,
+	}, a.passwordHistorySize())
+}
+
+// passwordExpired reports whether the password of account is older than
+// the maximum age set on role. A zero maximum age never expires.
+func (a *AccountDep) passwordExpired(account *psqlmodel.Account, role *psqlmodel.Role) bool {
+	if role.PasswordMaxAgeDays <= 0 {
+		return false
+	}
+	maxAge := time.Duration(role.PasswordMaxAgeDays) * 24 * time.Hour
+	return time.Since(account.PasswordChangedAt) > maxAge
+}
+
+// issuePasswordExpiredToken answers a login with an expired password with a
+// restricted token that can only change the password.
+func (a *AccountDep) issuePasswordExpiredToken(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role) (model.Auth, error) {
+	auth, err := a.issueRestrictedToken(ctx, account, role, model.RestrictionPasswordExpired)
+	if err != nil {
+		return auth, err
+	}
+	auth.PasswordExpired = true
+	return auth, nil
+}
+
+func (a *AccountDep) passwordHistorySize() int {
+	if a.conf.PasswordHistorySize != 0 {
+		return a.conf.PasswordHistorySize
+	}
+	return model.DefaultPasswordHistorySize
+}
+
+// rehashPassword replaces a stored hash made with an older algorithm or
+// weaker parameters, while the plain password is known after a successful
+// login. The password itself does not change, so neither does its age nor
+// the password history. Errors are logged, the old hash keeps working.
+func (a *AccountDep) rehashPassword(ctx *gin.Context, account *psqlmodel.Account, password string) {
+	if !a.passwordHash.NeedsRehash(account.Password) {
+		return
+	}
+
+	pwd, err := a.passwordHash.Hash(password)
+	if err != nil {
+		a.log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error rehash password"))
+		return
+	}
+
+	account.Password = pwd
+	if err = a.account.Update(ctx, account); err != nil {
+		a.log.Warn(ctx, err)
+	}
+}
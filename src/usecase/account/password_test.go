@@ -0,0 +1,276 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/golang/mock/gomock"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestForgotPassword(t *testing.T) {
+	tests := []struct {
+		name      string
+		throttled bool
+		findErr   error
+		insertErr error
+		mailed    bool
+		code      int64
+	}{
+		{
+			name:   "registered email",
+			mailed: true,
+		},
+		{
+			name:    "unknown email",
+			findErr: errors.New("not found"),
+		},
+		{
+			// the caller cannot tell a failed insert from an unknown email
+			name:      "insert failure",
+			insertErr: errors.New("redis down"),
+		},
+		{
+			name:      "throttled",
+			throttled: true,
+			code:      svcerr.CodeTooManyRequests,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{PasswordResetURL: "https://example.com/reset?token=%s"})
+			m.rateLimit.EXPECT().Allow(ctx, fmt.Sprintf(model.PasswordForgotKey, testEmail), int64(1), model.DefaultPasswordForgotInterval).Return(!tt.throttled, nil)
+			if !tt.throttled {
+				// the lookup runs after the request is answered, on a copy
+				// of the request context
+				m.account.EXPECT().GetSingleByParam(gomock.Any(), model.MustRevalidate, &model.GetAccountByParam{Email: null.NewString(testEmail, true)}).Return(psqlmodel.Account{ID: 7, Email: testEmail}, tt.findErr)
+			}
+			var tokenHash string
+			if !tt.throttled && tt.findErr == nil {
+				m.passwordReset.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, hash string, v *model.PasswordReset) error {
+					tokenHash = hash
+					if v.AccountID != 7 || v.Email != testEmail || time.Since(v.IssuedAt) > time.Minute {
+						t.Errorf("got %+v", v)
+					}
+					return tt.insertErr
+				})
+			}
+			if tt.mailed {
+				m.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, mail model.Mail) error {
+					// only the hash of the mailed token is stored
+					_, token, _ := strings.Cut(mail.Body, "https://example.com/reset?token=")
+					token, _, _ = strings.Cut(token, "\n")
+					if mail.To != testEmail || common.HashToken(token) != tokenHash {
+						t.Errorf("got mail %+v", mail)
+					}
+					return nil
+				})
+			}
+
+			err := a.ForgotPassword(ctx, model.ForgotPassword{Email: testEmail})
+			a.mailing.Wait()
+			if tt.code != 0 {
+				if code := errormsg.GetErrorCode(err); code != tt.code {
+					t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	const newPassword = "N3w-passw0rd!"
+	changedAt := time.Now().Add(-time.Hour)
+	account := psqlmodel.Account{ID: 7, Email: testEmail, Name: "user", Password: "old-hash", PasswordChangedAt: changedAt}
+	reset := model.PasswordReset{AccountID: 7, Email: testEmail, IssuedAt: time.Now().Add(-time.Minute)}
+	tokenHash := common.HashToken("token")
+
+	tests := []struct {
+		name      string
+		getErr    error
+		reset     model.PasswordReset
+		policyErr error
+		reused    bool
+		takeErr   error
+		// take is set when the checks pass and the token is used up
+		take bool
+		code int64
+	}{
+		{
+			name:  "reset",
+			reset: reset,
+			take:  true,
+		},
+		{
+			name:   "unknown, used or expired token",
+			getErr: errormsg.WrapErr(svcerr.AccountSVCInvalidResetToken, nil, "password reset token not found"),
+			code:   svcerr.CodeInvalidResetToken,
+		},
+		{
+			name:  "email changed since",
+			reset: model.PasswordReset{AccountID: 7, Email: "other@example.com", IssuedAt: reset.IssuedAt},
+			code:  svcerr.CodeInvalidResetToken,
+		},
+		{
+			name:  "password changed since",
+			reset: model.PasswordReset{AccountID: 7, Email: testEmail, IssuedAt: changedAt.Add(-time.Minute)},
+			code:  svcerr.CodeInvalidResetToken,
+		},
+		{
+			name:      "weak password keeps the token",
+			reset:     reset,
+			policyErr: errormsg.WrapErr(svcerr.AccountSVCPasswordContainsPersonalInfo, nil, "password contains the email"),
+			code:      svcerr.CodePasswordContainsPersonalInfo,
+		},
+		{
+			name:   "reused password keeps the token",
+			reset:  reset,
+			reused: true,
+			code:   svcerr.CodePasswordReused,
+		},
+		{
+			name:    "token taken concurrently",
+			reset:   reset,
+			takeErr: errormsg.WrapErr(svcerr.AccountSVCInvalidResetToken, nil, "password reset token not found"),
+			take:    true,
+			code:    svcerr.CodeInvalidResetToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{TokenTimeout: time.Hour})
+			m.passwordReset.EXPECT().Get(ctx, tokenHash).Return(tt.reset, tt.getErr)
+			if tt.getErr == nil {
+				m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{ID: null.NewInt64(7, true)}).Return(account, nil)
+			}
+			checked := tt.reset.Email == testEmail && !tt.reset.IssuedAt.Before(changedAt)
+			if checked {
+				m.passwordPolicy.EXPECT().Validate(model.PasswordCandidate{Password: newPassword, Email: testEmail, Name: "user"}).Return(tt.policyErr)
+			}
+			if checked && tt.policyErr == nil {
+				m.passwordHash.EXPECT().Compare("old-hash", newPassword).Return(errors.New("mismatch"))
+				history := psqlmodel.PasswordHistorySlice{{Password: "older-hash"}}
+				m.passwordHistory.EXPECT().GetRecent(ctx, 7, model.DefaultPasswordHistorySize).Return(history, nil)
+				var compareErr error
+				if !tt.reused {
+					compareErr = errors.New("mismatch")
+				}
+				m.passwordHash.EXPECT().Compare("older-hash", newPassword).Return(compareErr)
+			}
+			if tt.take {
+				m.passwordReset.EXPECT().Take(ctx, tokenHash).Return(tt.reset, tt.takeErr)
+			}
+			if tt.take && tt.takeErr == nil {
+				m.passwordHash.EXPECT().Hash(newPassword).Return("new-hash", nil)
+				m.account.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ interface{}, v *psqlmodel.Account) error {
+					if v.Password != "new-hash" || !v.PasswordChangedAt.After(changedAt) {
+						t.Errorf("got %+v", v)
+					}
+					return nil
+				})
+				m.passwordHistory.EXPECT().Insert(ctx, gomock.Any(), model.DefaultPasswordHistorySize).Return(nil)
+				// every session and API key opened with the old password ends
+				m.token.EXPECT().RevokeAccount(ctx, int64(7), time.Hour).Return(nil)
+				m.refreshToken.EXPECT().RevokeByAccount(ctx, 7).Return(nil)
+				m.apiKey.EXPECT().RevokeByAccount(ctx, 7, int64(7)).Return(nil)
+			}
+
+			err := a.ResetPassword(ctx, model.ResetPassword{Token: "token", Password: newPassword, ConfirmPassword: newPassword})
+			if tt.code != 0 {
+				if code := errormsg.GetErrorCode(err); code != tt.code {
+					t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestCheckPasswordReuse(t *testing.T) {
+	// earlier passwords of the account, newest first
+	previous := []string{"p1", "p2", "p3", "p4", "p5", "p6", "p7"}
+
+	tests := []struct {
+		name     string
+		size     int
+		password string
+		reused   bool
+	}{
+		{name: "current password", password: "p0", reused: true},
+		{name: "newest of the default depth", password: "p1", reused: true},
+		{name: "oldest of the default depth", password: "p5", reused: true},
+		{name: "past the default depth", password: "p6"},
+		{name: "oldest of a configured depth", size: 3, password: "p3", reused: true},
+		{name: "past a configured depth", size: 3, password: "p4"},
+		{name: "never used", password: "fresh"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{PasswordHistorySize: tt.size})
+			m.passwordHash.EXPECT().Compare(gomock.Any(), tt.password).DoAndReturn(func(encoded, password string) error {
+				if encoded != "hash-"+password {
+					return errors.New("mismatch")
+				}
+				return nil
+			}).AnyTimes()
+			m.passwordHistory.EXPECT().GetRecent(ctx, 7, gomock.Any()).DoAndReturn(func(_ interface{}, _ int, limit int) (psqlmodel.PasswordHistorySlice, error) {
+				var res psqlmodel.PasswordHistorySlice
+				for _, p := range previous[:limit] {
+					res = append(res, &psqlmodel.PasswordHistory{AccountID: 7, Password: "hash-" + p})
+				}
+				return res, nil
+			}).MaxTimes(1)
+
+			err := a.checkPasswordReuse(ctx, &psqlmodel.Account{ID: 7, Password: "hash-p0"}, tt.password)
+			if !tt.reused {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if code := errormsg.GetErrorCode(err); code != svcerr.CodePasswordReused {
+				t.Fatalf("got code %d (%v), want %d", code, err, svcerr.CodePasswordReused)
+			}
+		})
+	}
+}
+
+func TestPasswordExpired(t *testing.T) {
+	day := 24 * time.Hour
+	tests := []struct {
+		name       string
+		maxAgeDays int
+		age        time.Duration
+		want       bool
+	}{
+		{name: "no maximum age", age: 1000 * day},
+		{name: "just within", maxAgeDays: 30, age: 30*day - time.Minute},
+		{name: "just past", maxAgeDays: 30, age: 30*day + time.Minute, want: true},
+		{name: "new password", maxAgeDays: 1, age: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _, _ := newTestAccount(t, Conf{})
+			account := psqlmodel.Account{PasswordChangedAt: time.Now().Add(-tt.age)}
+			role := psqlmodel.Role{PasswordMaxAgeDays: tt.maxAgeDays}
+			if got := a.passwordExpired(&account, &role); got != tt.want {
+				t.Fatalf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
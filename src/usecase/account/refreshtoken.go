@@ -0,0 +1,153 @@
+package account
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+)
+
+const refreshTokenSize = 48
+
+// refreshTokenGrant exchanges a refresh token for a new access token and
+// rotates the refresh token. Presenting a token that was already rotated
+// means it leaked, so the whole family is revoked.
+func (a *AccountDep) refreshTokenGrant(ctx *gin.Context, v model.Login, role psqlmodel.Role) (model.Auth, error) {
+	var auth model.Auth
+	current, err := a.refreshToken.GetSingleByParam(ctx, &model.GetRefreshTokenByParam{
+		TokenHash: null.NewString(common.HashToken(v.RefreshToken), true),
+	})
+	if err != nil {
+		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, err, "refresh token not found")
+	}
+
+	if current.RoleID != role.ID {
+		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, nil, "refresh token issued to another client")
+	}
+
+	if current.RevokedAt.Valid {
+		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, nil, "refresh token revoked")
+	}
+
+	if current.RotatedAt.Valid {
+		return auth, a.revokeRefreshTokenFamily(ctx, current.Family)
+	}
+
+	if time.Now().After(current.ExpiredAt) {
+		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, nil, "refresh token expired")
+	}
+
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(int64(current.AccountID), true),
+	})
+	if err != nil {
+		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, err, "account not found")
+	}
+
+	_, err = a.accountRole.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountRoleByParam{
+		AccountID: null.NewInt64(int64(account.ID), true),
+		RoleID:    null.NewInt64(int64(role.ID), true),
+	})
+	if err != nil {
+		return auth, errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, err, "account role not found")
+	}
+
+	// an expired password ends the session, the next login gets the
+	// restricted token to change it
+	if a.passwordExpired(&account, &role) {
+		return auth, errormsg.WrapErr(svcerr.AccountSVCPasswordExpired, nil, "password expired")
+	}
+
+	// tokens stored before scopes were recorded carry the client role only
+	scope := current.Scope
+	if scope == "" {
+		scope = role.Scope
+	}
+	if a.conf.MultiScopeTokens {
+		scope, err = narrowScope(scope, v.Scope)
+		if err != nil {
+			return auth, err
+		}
+	}
+
+	auth, err = a.generateAccessToken(ctx, &account, &role, scope, int64(current.OrganisationID.Int))
+	if err != nil {
+		return auth, err
+	}
+
+	token, next, err := a.newRefreshToken(&account, &role, auth, current.Family)
+	if err != nil {
+		return model.Auth{}, err
+	}
+
+	err = a.refreshToken.Rotate(ctx, &current, next)
+	if err != nil {
+		if errormsg.GetErrorCode(err) == svcerr.CodeInvalidRefreshToken {
+			return model.Auth{}, a.revokeRefreshTokenFamily(ctx, current.Family)
+		}
+		return model.Auth{}, err
+	}
+	auth.RefreshToken = token
+
+	if model.HasScope(v.Scope, model.ScopeOpenID) {
+		auth.IDToken, err = a.issueIDToken(ctx, &account, &role, v.Scope, "", time.Time{})
+		if err != nil {
+			return model.Auth{}, err
+		}
+	}
+
+	return auth, nil
+}
+
+func (a *AccountDep) issueRefreshToken(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role, auth model.Auth, family string) (string, error) {
+	token, data, err := a.newRefreshToken(account, role, auth, family)
+	if err != nil {
+		return "", err
+	}
+
+	err = a.refreshToken.Insert(ctx, data)
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// newRefreshToken builds a refresh token that renews access tokens like
+// auth, the access token it is issued with, keeping its scope and
+// organisation.
+func (a *AccountDep) newRefreshToken(account *psqlmodel.Account, role *psqlmodel.Role, auth model.Auth, family string) (string, *psqlmodel.RefreshToken, error) {
+	token, err := common.GenerateRandomToken(refreshTokenSize)
+	if err != nil {
+		return "", nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate refresh token")
+	}
+
+	timeout := a.conf.RefreshTokenTimeout
+	if timeout == 0 {
+		timeout = model.DefaultRefreshTokenExpiration
+	}
+
+	return token, &psqlmodel.RefreshToken{
+		AccountID:      account.ID,
+		RoleID:         role.ID,
+		Family:         family,
+		Scope:          auth.Scope,
+		OrganisationID: null.NewInt(int(auth.OrganisationID), auth.OrganisationID != 0),
+		TokenHash:      common.HashToken(token),
+		ExpiredAt:      time.Now().Add(timeout),
+		CreatedBy:      account.ID,
+		UpdatedBy:      account.ID,
+	}, nil
+}
+
+func (a *AccountDep) revokeRefreshTokenFamily(ctx *gin.Context, family string) error {
+	a.log.Warn(ctx, "refresh token reuse detected, revoking family "+family)
+	if err := a.refreshToken.RevokeFamily(ctx, family); err != nil {
+		return err
+	}
+	return errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, nil, "refresh token reused")
+}
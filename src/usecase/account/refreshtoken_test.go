@@ -0,0 +1,142 @@
+package account
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/golang/mock/gomock"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestRefreshTokenGrant(t *testing.T) {
+	const presented = "presented-refresh-token"
+	role := psqlmodel.Role{ID: 1, Cid: "cid", Scope: model.CustomerScope}
+	valid := psqlmodel.RefreshToken{
+		ID:        10,
+		AccountID: 7,
+		RoleID:    role.ID,
+		Family:    "family",
+		TokenHash: common.HashToken(presented),
+		ExpiredAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name    string
+		current func(psqlmodel.RefreshToken) psqlmodel.RefreshToken
+		findErr error
+		// issue is set when the token gets as far as being rotated
+		issue     bool
+		rotateErr error
+		revoked   bool
+		code      int64
+	}{
+		{
+			name:    "unknown token",
+			findErr: errors.New("not found"),
+			code:    svcerr.CodeInvalidRefreshToken,
+		},
+		{
+			name: "issued to another client",
+			current: func(v psqlmodel.RefreshToken) psqlmodel.RefreshToken {
+				v.RoleID = 2
+				return v
+			},
+			code: svcerr.CodeInvalidRefreshToken,
+		},
+		{
+			name: "revoked",
+			current: func(v psqlmodel.RefreshToken) psqlmodel.RefreshToken {
+				v.RevokedAt = null.TimeFrom(time.Now())
+				return v
+			},
+			code: svcerr.CodeInvalidRefreshToken,
+		},
+		{
+			name: "expired",
+			current: func(v psqlmodel.RefreshToken) psqlmodel.RefreshToken {
+				v.ExpiredAt = time.Now().Add(-time.Second)
+				return v
+			},
+			code: svcerr.CodeInvalidRefreshToken,
+		},
+		{
+			name: "reused after rotation",
+			current: func(v psqlmodel.RefreshToken) psqlmodel.RefreshToken {
+				v.RotatedAt = null.TimeFrom(time.Now())
+				return v
+			},
+			revoked: true,
+			code:    svcerr.CodeInvalidRefreshToken,
+		},
+		{
+			name:      "rotated concurrently",
+			issue:     true,
+			rotateErr: errormsg.WrapErr(svcerr.AccountSVCInvalidRefreshToken, nil, "refresh token already rotated"),
+			revoked:   true,
+			code:      svcerr.CodeInvalidRefreshToken,
+		},
+		{
+			name:  "rotated",
+			issue: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{TokenTimeout: time.Minute})
+			current := valid
+			if tt.current != nil {
+				current = tt.current(current)
+			}
+			m.refreshToken.EXPECT().GetSingleByParam(ctx, &model.GetRefreshTokenByParam{
+				TokenHash: null.NewString(current.TokenHash, true),
+			}).Return(current, tt.findErr)
+
+			var next *psqlmodel.RefreshToken
+			if tt.issue {
+				m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, gomock.Any()).Return(psqlmodel.Account{ID: current.AccountID, Email: testEmail}, nil)
+				m.accountRole.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, gomock.Any()).Return(psqlmodel.AccountRole{AccountID: current.AccountID, RoleID: role.ID}, nil)
+				m.accountRole.EXPECT().GetByParam(ctx, model.MustRevalidate, gomock.Any()).Return(psqlmodel.AccountRoleSlice{{AccountID: current.AccountID, RoleID: role.ID}}, model.Pagination{}, nil)
+				m.rolePermission.EXPECT().GetPermissionNames(ctx, "", int64(role.ID)).Return([]string{model.PermissionAccountRead}, nil)
+				m.token.EXPECT().Sign(ctx, model.JWTTypeAccessToken, gomock.Any()).Return("access-token", nil)
+				m.refreshToken.EXPECT().Rotate(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ interface{}, v *psqlmodel.RefreshToken) error {
+					next = v
+					return tt.rotateErr
+				})
+			}
+			if tt.revoked {
+				m.refreshToken.EXPECT().RevokeFamily(ctx, current.Family).Return(nil)
+			}
+
+			auth, err := a.refreshTokenGrant(ctx, model.Login{RefreshToken: presented}, role)
+			if tt.code != 0 {
+				if code := errormsg.GetErrorCode(err); code != tt.code {
+					t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+				}
+				if auth.AccessToken != "" || auth.RefreshToken != "" {
+					t.Fatalf("tokens issued with an error: %+v", auth)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if auth.AccessToken != "access-token" || auth.RefreshToken == "" || auth.RefreshToken == presented {
+				t.Fatalf("got %+v", auth)
+			}
+			// the next token stays in the family so reusing either revokes both
+			if next.Family != current.Family || next.TokenHash != common.HashToken(auth.RefreshToken) {
+				t.Fatalf("next token %+v", next)
+			}
+			if next.Scope != role.Scope || next.AccountID != current.AccountID || next.RoleID != role.ID {
+				t.Fatalf("next token %+v", next)
+			}
+		})
+	}
+}
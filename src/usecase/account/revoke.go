@@ -0,0 +1,164 @@
+package account
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+)
+
+// Revoke implements RFC 7009. Unknown, expired or malformed tokens are not
+// an error since the client's goal, an unusable token, is already met.
+func (a *AccountDep) Revoke(ctx *gin.Context, v model.TokenRequest) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	role, err := a.authenticateClient(ctx, v.ClientID, v.ClientSecret)
+	if err != nil {
+		return err
+	}
+
+	if isRefreshToken(v) {
+		current, err := a.refreshToken.GetSingleByParam(ctx, &model.GetRefreshTokenByParam{
+			TokenHash: null.NewString(common.HashToken(v.Token), true),
+		})
+		if err != nil {
+			return nil
+		}
+
+		if current.RoleID != role.ID {
+			return errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "refresh token issued to another client")
+		}
+		return a.refreshToken.RevokeFamily(ctx, current.Family)
+	}
+
+	claims, err := a.token.Parse(ctx, v.Token)
+	if err != nil {
+		return nil
+	}
+
+	if clientID, _ := claims["client_id"].(string); clientID != role.Cid {
+		return errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "access token issued to another client")
+	}
+	return a.token.Revoke(ctx, claims)
+}
+
+// Introspect implements RFC 7662 for any authenticated client, typically
+// a resource server that cannot verify tokens itself.
+func (a *AccountDep) Introspect(ctx *gin.Context, v model.TokenRequest) (model.Introspection, error) {
+	var result model.Introspection
+	if err := v.Validate(); err != nil {
+		return result, err
+	}
+
+	_, err := a.authenticateClient(ctx, v.ClientID, v.ClientSecret)
+	if err != nil {
+		return result, err
+	}
+
+	if isRefreshToken(v) {
+		return a.introspectRefreshToken(ctx, v.Token), nil
+	}
+
+	claims, err := a.token.Parse(ctx, v.Token)
+	if err != nil {
+		return result, nil
+	}
+
+	// a restricted token is only good for its own routes of this service,
+	// a resource server must not take it for an access token
+	if _, ok := claims["restriction"]; ok {
+		return result, nil
+	}
+
+	result = model.Introspection{
+		Active:    true,
+		TokenType: model.TokenTypeBearer,
+	}
+	result.Scope, _ = claims["scope"].(string)
+	result.ClientID, _ = claims["client_id"].(string)
+	result.Username, _ = claims["username"].(string)
+	result.Sub, _ = claims["sub"].(string)
+	result.Iss, _ = claims["iss"].(string)
+	result.Jti, _ = claims["jti"].(string)
+	if exp, ok := claims["exp"].(float64); ok {
+		result.Exp = int64(exp)
+	}
+	if iat, ok := claims["iat"].(float64); ok {
+		result.Iat = int64(iat)
+	}
+	return result, nil
+}
+
+func (a *AccountDep) introspectRefreshToken(ctx *gin.Context, token string) model.Introspection {
+	var result model.Introspection
+	current, err := a.refreshToken.GetSingleByParam(ctx, &model.GetRefreshTokenByParam{
+		TokenHash: null.NewString(common.HashToken(token), true),
+	})
+	if err != nil || current.RevokedAt.Valid || current.RotatedAt.Valid || time.Now().After(current.ExpiredAt) {
+		return result
+	}
+
+	role, err := a.role.GetSingleByParam(ctx, "", &model.GetRoleByParam{
+		ID: null.NewInt64(int64(current.RoleID), true),
+	})
+	if err != nil {
+		return result
+	}
+
+	scope := current.Scope
+	if scope == "" {
+		scope = role.Scope
+	}
+
+	return model.Introspection{
+		Active:    true,
+		Scope:     scope,
+		ClientID:  role.Cid,
+		TokenType: model.TokenTypeHintRefreshToken,
+		Exp:       current.ExpiredAt.Unix(),
+		Iat:       current.CreatedAt.Unix(),
+		Sub:       strconv.Itoa(current.AccountID),
+	}
+}
+
+// RevokeAccountTokens kills every access and refresh token of the account,
+// used when one of its role grants expires. API keys survive since their
+// roles are re-checked on every request.
+func (a *AccountDep) RevokeAccountTokens(ctx context.Context, accountID int) error {
+	err := a.token.RevokeAccount(ctx, int64(accountID), a.conf.TokenTimeout)
+	if err != nil {
+		return err
+	}
+	return a.refreshToken.RevokeByAccount(ctx, accountID)
+}
+
+// revokeAccountCredentials kills every token and every API key of the
+// account, used when its password changes or it is deleted.
+func (a *AccountDep) revokeAccountCredentials(ctx context.Context, accountID int, revokedBy int64) error {
+	err := a.RevokeAccountTokens(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	return a.apiKey.RevokeByAccount(ctx, accountID, revokedBy)
+}
+
+// isRefreshToken follows token_type_hint when given. Without a hint, JWTs
+// are recognised by their three dot-separated segments.
+func isRefreshToken(v model.TokenRequest) bool {
+	switch v.TokenTypeHint {
+	case model.TokenTypeHintRefreshToken:
+		return true
+	case model.TokenTypeHintAccessToken:
+		return false
+	}
+	return strings.Count(v.Token, ".") != 2
+}
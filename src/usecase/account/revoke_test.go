@@ -0,0 +1,83 @@
+package account
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-lib/pkg/hash"
+	"github.com/golang-jwt/jwt"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestIntrospect(t *testing.T) {
+	const secret = "client-secret"
+	conf := Conf{AESSecret: "0123456789abcdef0123456789abcdef"}
+	sec, err := hash.EncAES(secret, conf.AESSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	role := psqlmodel.Role{ID: 1, Cid: "cid", Sec: sec}
+
+	tests := []struct {
+		name     string
+		claims   jwt.MapClaims
+		parseErr error
+		want     model.Introspection
+	}{
+		{
+			name:     "invalid token",
+			parseErr: errors.New("invalid token"),
+		},
+		{
+			name: "restricted token",
+			claims: jwt.MapClaims{
+				"sub":         "7",
+				"client_id":   role.Cid,
+				"scope":       model.CustomerScope,
+				"restriction": model.RestrictionMFAEnrollment,
+			},
+		},
+		{
+			name: "access token",
+			claims: jwt.MapClaims{
+				"sub":       "7",
+				"client_id": role.Cid,
+				"scope":     model.CustomerScope,
+				"exp":       float64(2000),
+				"iat":       float64(1000),
+			},
+			want: model.Introspection{
+				Active:    true,
+				Scope:     model.CustomerScope,
+				ClientID:  role.Cid,
+				TokenType: model.TokenTypeBearer,
+				Sub:       "7",
+				Exp:       2000,
+				Iat:       1000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, conf)
+			m.role.EXPECT().GetSingleByParam(ctx, "", &model.GetRoleByParam{
+				Cid: null.NewString(role.Cid, true),
+			}).Return(role, nil)
+			m.token.EXPECT().Parse(ctx, "a.b.c").Return(tt.claims, tt.parseErr)
+
+			res, err := a.Introspect(ctx, model.TokenRequest{
+				Token:        "a.b.c",
+				ClientID:     role.Cid,
+				ClientSecret: secret,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res != tt.want {
+				t.Fatalf("got %+v, want %+v", res, tt.want)
+			}
+		})
+	}
+}
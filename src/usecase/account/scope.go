@@ -0,0 +1,156 @@
+package account
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+)
+
+// tokenRoles returns the roles whose scopes an access token carries. With
+// multi_scope_tokens off that is only the role of the client. Otherwise it
+// is every role granted to the account, narrowed to the role scopes named
+// in scope if there are any. Requested scopes that are not granted are
+// dropped, but a request left with nothing is refused. Grants scoped to an
+// organisation other than organisationID, the organisation of the token or
+// 0, are left out, and so are roles whose policy the login did not meet:
+// one requiring MFA for an account without it, or one whose password
+// maximum age the account's password is past. The caller has already
+// applied those policies for role itself.
+func (a *AccountDep) tokenRoles(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role, scope string, organisationID int64) ([]psqlmodel.Role, error) {
+	if !a.conf.MultiScopeTokens {
+		return []psqlmodel.Role{*role}, nil
+	}
+
+	accountRoles, _, err := a.accountRole.GetByParam(ctx, model.MustRevalidate, &model.GetAccountRolesByParam{
+		GetAccountRoleByParam: model.GetAccountRoleByParam{
+			AccountID: null.NewInt64(int64(account.ID), true),
+		},
+		ActiveOnly: true,
+		Within:     null.NewInt64(organisationID, true),
+		PageParam:  model.PageParam{Limit: model.MaxTokenRoles},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := []psqlmodel.Role{*role}
+	for _, accountRole := range accountRoles {
+		if accountRole.RoleID == role.ID {
+			continue
+		}
+		r, err := a.role.GetSingleByParam(ctx, "", &model.GetRoleByParam{
+			ID: null.NewInt64(int64(accountRole.RoleID), true),
+		})
+		if err != nil {
+			a.log.Warn(ctx, err)
+			continue
+		}
+		// an account with MFA enabled cannot log in without the challenge
+		if r.MfaRequired && !account.MfaEnabledAt.Valid || a.passwordExpired(account, &r) {
+			continue
+		}
+		roles = append(roles, r)
+	}
+
+	requested := model.RoleScopes(scope)
+	if len(requested) == 0 {
+		return roles, nil
+	}
+
+	var res []psqlmodel.Role
+	for _, r := range roles {
+		if common.FindStrInSlice(r.Scope, requested) {
+			res = append(res, r)
+		}
+	}
+	if len(res) == 0 {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCInvalidScope, nil, "requested scope not granted")
+	}
+	return res, nil
+}
+
+// GrantedRoles returns the roles of the active grants of account, in the
+// order they were granted. within narrows the grants as Within does on
+// model.GetAccountRolesByParam. Every page of grants is read, so a caller
+// refusing on some role sees all of them. A role that cannot be read is
+// logged and left out.
+func (a *AccountDep) GrantedRoles(ctx *gin.Context, accountID int64, within null.Int64) ([]psqlmodel.Role, error) {
+	var res []psqlmodel.Role
+	for page := int64(1); ; page++ {
+		accountRoles, _, err := a.accountRole.GetByParam(ctx, model.MustRevalidate, &model.GetAccountRolesByParam{
+			GetAccountRoleByParam: model.GetAccountRoleByParam{
+				AccountID: null.NewInt64(accountID, true),
+			},
+			ActiveOnly: true,
+			Within:     within,
+			PageParam: model.PageParam{
+				OrderBy: null.NewString("id", true),
+				Limit:   model.MaxTokenRoles,
+				Page:    page,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, accountRole := range accountRoles {
+			r, err := a.role.GetSingleByParam(ctx, "", &model.GetRoleByParam{
+				ID: null.NewInt64(int64(accountRole.RoleID), true),
+			})
+			if err != nil {
+				a.log.Warn(ctx, err)
+				continue
+			}
+			res = append(res, r)
+		}
+
+		if int64(len(accountRoles)) < model.MaxTokenRoles {
+			return res, nil
+		}
+	}
+}
+
+// narrowScope keeps the role scopes of requested that are also in granted,
+// so a refresh can only ask for less than the refresh token was issued for.
+func narrowScope(granted, requested string) (string, error) {
+	scopes := model.RoleScopes(requested)
+	if len(scopes) == 0 {
+		return granted, nil
+	}
+
+	var res []string
+	for _, s := range scopes {
+		if model.HasScope(granted, s) && !common.FindStrInSlice(s, res) {
+			res = append(res, s)
+		}
+	}
+	if len(res) == 0 {
+		return "", errormsg.WrapErr(svcerr.AccountSVCInvalidScope, nil, "requested scope not granted")
+	}
+	return strings.Join(res, " "), nil
+}
+
+// RolesPermissions returns the sorted union of the permissions of roles.
+func (a *AccountDep) RolesPermissions(ctx *gin.Context, roles []psqlmodel.Role) ([]string, error) {
+	res := []string{}
+	for _, r := range roles {
+		permissions, err := a.rolePermission.GetPermissionNames(ctx, "", int64(r.ID))
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range permissions {
+			if !common.FindStrInSlice(p, res) {
+				res = append(res, p)
+			}
+		}
+	}
+	sort.Strings(res)
+	return res, nil
+}
@@ -0,0 +1,165 @@
+package account
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestGrantedRoles(t *testing.T) {
+	userRole := psqlmodel.Role{ID: 2, Scope: "usr"}
+	supRole := psqlmodel.Role{ID: 4, Scope: model.SuperAdminScope}
+
+	fullPage := make(psqlmodel.AccountRoleSlice, model.MaxTokenRoles)
+	var fullPageRoles []psqlmodel.Role
+	for n := range fullPage {
+		fullPage[n] = &psqlmodel.AccountRole{AccountID: 5, RoleID: userRole.ID}
+		fullPageRoles = append(fullPageRoles, userRole)
+	}
+
+	tests := []struct {
+		name   string
+		within null.Int64
+		pages  []psqlmodel.AccountRoleSlice
+		lost   map[int]bool
+		want   []psqlmodel.Role
+	}{
+		{
+			name:   "global grants",
+			within: null.NewInt64(0, true),
+			pages:  []psqlmodel.AccountRoleSlice{{{AccountID: 5, RoleID: userRole.ID}, {AccountID: 5, RoleID: supRole.ID}}},
+			want:   []psqlmodel.Role{userRole, supRole},
+		},
+		{
+			name:  "every page",
+			pages: []psqlmodel.AccountRoleSlice{fullPage, {{AccountID: 5, RoleID: supRole.ID}}},
+			want:  append(append([]psqlmodel.Role{}, fullPageRoles...), supRole),
+		},
+		{
+			name:  "full last page",
+			pages: []psqlmodel.AccountRoleSlice{fullPage, {}},
+			want:  fullPageRoles,
+		},
+		{
+			name:  "unreadable role left out",
+			pages: []psqlmodel.AccountRoleSlice{{{AccountID: 5, RoleID: userRole.ID}, {AccountID: 5, RoleID: supRole.ID}}},
+			lost:  map[int]bool{supRole.ID: true},
+			want:  []psqlmodel.Role{userRole},
+		},
+		{
+			name:  "no grant",
+			pages: []psqlmodel.AccountRoleSlice{{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{})
+			m.accountRole.EXPECT().GetByParam(ctx, model.MustRevalidate, gomock.Any()).DoAndReturn(func(_ *gin.Context, _ string, v *model.GetAccountRolesByParam) (psqlmodel.AccountRoleSlice, model.Pagination, error) {
+				if v.AccountID != null.NewInt64(5, true) || !v.ActiveOnly || v.Within != tt.within || v.Limit != model.MaxTokenRoles {
+					t.Fatalf("got param %+v", v)
+				}
+				return tt.pages[v.Page-1], model.Pagination{}, nil
+			}).Times(len(tt.pages))
+			m.role.EXPECT().GetSingleByParam(ctx, "", gomock.Any()).DoAndReturn(func(_ *gin.Context, _ string, v *model.GetRoleByParam) (psqlmodel.Role, error) {
+				id := int(v.ID.Int64)
+				if tt.lost[id] {
+					return psqlmodel.Role{}, errors.New("not found")
+				}
+				if id == supRole.ID {
+					return supRole, nil
+				}
+				return userRole, nil
+			}).AnyTimes()
+
+			got, err := a.GrantedRoles(ctx, 5, tt.within)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %d roles %v, want %d", len(got), got, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestGrantedRolesLookupFailure(t *testing.T) {
+	a, m, ctx := newTestAccount(t, Conf{})
+	m.accountRole.EXPECT().GetByParam(ctx, model.MustRevalidate, gomock.Any()).Return(nil, model.Pagination{}, errors.New("db down"))
+
+	if _, err := a.GrantedRoles(ctx, 5, null.Int64{}); err == nil {
+		t.Fatal("roles returned without the grants")
+	}
+}
+
+func TestTokenRolesPolicy(t *testing.T) {
+	cusRole := psqlmodel.Role{ID: 2, Scope: "cus"}
+	mfaRole := psqlmodel.Role{ID: 4, Scope: model.SuperAdminScope, MfaRequired: true}
+	ageRole := psqlmodel.Role{ID: 6, Scope: "adm", PasswordMaxAgeDays: 30}
+	fresh := time.Now().Add(-24 * time.Hour)
+	stale := time.Now().Add(-31 * 24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		account psqlmodel.Account
+		scope   string
+		want    []psqlmodel.Role
+		code    int64
+	}{
+		{
+			name:    "every policy met",
+			account: psqlmodel.Account{ID: 5, MfaEnabledAt: null.TimeFrom(fresh), PasswordChangedAt: fresh},
+			want:    []psqlmodel.Role{cusRole, mfaRole, ageRole},
+		},
+		{
+			name:    "password only login",
+			account: psqlmodel.Account{ID: 5, PasswordChangedAt: fresh},
+			want:    []psqlmodel.Role{cusRole, ageRole},
+		},
+		{
+			name:    "password past a role's maximum age",
+			account: psqlmodel.Account{ID: 5, MfaEnabledAt: null.TimeFrom(fresh), PasswordChangedAt: stale},
+			want:    []psqlmodel.Role{cusRole, mfaRole},
+		},
+		{
+			name:    "requested role left out",
+			account: psqlmodel.Account{ID: 5, PasswordChangedAt: stale},
+			scope:   model.SuperAdminScope,
+			code:    svcerr.CodeInvalidScope,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{MultiScopeTokens: true})
+			m.accountRole.EXPECT().GetByParam(ctx, model.MustRevalidate, gomock.Any()).Return(psqlmodel.AccountRoleSlice{
+				{AccountID: 5, RoleID: cusRole.ID},
+				{AccountID: 5, RoleID: mfaRole.ID},
+				{AccountID: 5, RoleID: ageRole.ID},
+			}, model.Pagination{}, nil)
+			m.role.EXPECT().GetSingleByParam(ctx, "", &model.GetRoleByParam{ID: null.NewInt64(int64(mfaRole.ID), true)}).Return(mfaRole, nil)
+			m.role.EXPECT().GetSingleByParam(ctx, "", &model.GetRoleByParam{ID: null.NewInt64(int64(ageRole.ID), true)}).Return(ageRole, nil)
+
+			got, err := a.tokenRoles(ctx, &tt.account, &cusRole, tt.scope, 0)
+			if tt.code != 0 {
+				if code := errormsg.GetErrorCode(err); code != tt.code {
+					t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package account
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/volatiletech/null/v8"
+)
+
+// VerifyEmail marks the account's email as verified. The token is bound to
+// the email it was sent to and can only be used once.
+func (a *AccountDep) VerifyEmail(ctx *gin.Context, v model.VerifyEmail) (model.Account, error) {
+	if err := v.Validate(); err != nil {
+		return model.Account{}, err
+	}
+
+	claims, err := a.token.Verify(ctx, model.JWTTypeEmailVerification, v.Token)
+	if err != nil {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCInvalidVerificationToken, err, "invalid verification token")
+	}
+
+	sub, _ := claims["sub"].(string)
+	id, err := strconv.ParseInt(sub, 10, 64)
+	if err != nil {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCInvalidVerificationToken, err, "invalid subject")
+	}
+
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCInvalidVerificationToken, err, "account not found")
+	}
+
+	if email, _ := claims["email"].(string); email != account.Email {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCInvalidVerificationToken, nil, "email changed since token was issued")
+	}
+
+	if account.EmailVerifiedAt.Valid {
+		return model.TransformPSQLSingleAccount(&account), nil
+	}
+
+	if err = a.token.Consume(ctx, claims); err != nil {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCInvalidVerificationToken, err, "verification token already used")
+	}
+
+	account.EmailVerifiedAt = null.TimeFrom(time.Now())
+	err = a.account.Update(ctx, &account)
+	if err != nil {
+		return model.Account{}, err
+	}
+	return model.TransformPSQLSingleAccount(&account), nil
+}
+
+// ResendVerification sends a new verification mail. It succeeds for unknown
+// and already verified emails as well, and the account is only looked up
+// once the request is answered, so neither the result nor the response
+// time tells which emails are registered.
+func (a *AccountDep) ResendVerification(ctx *gin.Context, v model.ResendVerification) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	interval := a.conf.VerificationResendInterval
+	if interval == 0 {
+		interval = model.DefaultEmailVerificationResendInterval
+	}
+	allowed, err := a.rateLimit.Allow(ctx, fmt.Sprintf(model.EmailVerificationResendKey, v.Email), 1, interval)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errormsg.WrapErr(svcerr.AccountSVCTooManyRequests, nil, "verification resend throttled")
+	}
+
+	a.mailInBackground(ctx, func(ctx *gin.Context) error {
+		account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+			Email: null.NewString(v.Email, true),
+		})
+		if err != nil || account.EmailVerifiedAt.Valid {
+			return nil
+		}
+		return a.sendVerification(ctx, &account)
+	})
+	return nil
+}
+
+func (a *AccountDep) sendVerification(ctx *gin.Context, account *psqlmodel.Account) error {
+	timeout := a.conf.EmailVerificationTimeout
+	if timeout == 0 {
+		timeout = model.DefaultEmailVerificationExpiration
+	}
+
+	token, err := a.token.Sign(ctx, model.JWTTypeEmailVerification, jwt.MapClaims{
+		"sub":   strconv.Itoa(account.ID),
+		"email": account.Email,
+		"exp":   time.Now().Add(timeout).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.mailer.Send(ctx, model.Mail{
+		To:      account.Email,
+		Subject: "Verify your CarRent account",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease verify your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			account.Name, fmt.Sprintf(a.conf.EmailVerificationURL, token), timeout),
+	})
+}
+
+// mailInBackground runs send, which looks an account up and mails it, once
+// the request is answered, on a copy of ctx as gin requires. Errors are
+// logged since the caller has already been told the request succeeded.
+func (a *AccountDep) mailInBackground(ctx *gin.Context, send func(ctx *gin.Context) error) {
+	a.mailing.Add(1)
+	go func(ctx *gin.Context) {
+		defer a.mailing.Done()
+		if err := send(ctx); err != nil {
+			a.log.Warn(ctx, err)
+		}
+	}(ctx.Copy())
+}
@@ -0,0 +1,178 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestVerifyEmail(t *testing.T) {
+	claims := jwt.MapClaims{"sub": "7", "email": testEmail, "jti": "jti"}
+	unverified := psqlmodel.Account{ID: 7, Email: testEmail}
+
+	tests := []struct {
+		name      string
+		verifyErr error
+		account   psqlmodel.Account
+		findErr   error
+		consume   bool
+		usedUp    bool
+		code      int64
+	}{
+		{
+			name:    "verified",
+			account: unverified,
+			consume: true,
+		},
+		{
+			name:      "expired or forged",
+			verifyErr: errors.New("token is expired"),
+			code:      svcerr.CodeInvalidVerificationToken,
+		},
+		{
+			name:    "account gone",
+			findErr: errors.New("not found"),
+			code:    svcerr.CodeInvalidVerificationToken,
+		},
+		{
+			name:    "email changed since",
+			account: psqlmodel.Account{ID: 7, Email: "other@example.com"},
+			code:    svcerr.CodeInvalidVerificationToken,
+		},
+		{
+			name:    "already verified",
+			account: psqlmodel.Account{ID: 7, Email: testEmail, EmailVerifiedAt: null.TimeFrom(time.Now())},
+		},
+		{
+			name:    "token used before",
+			account: unverified,
+			consume: true,
+			usedUp:  true,
+			code:    svcerr.CodeInvalidVerificationToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{})
+			m.token.EXPECT().Verify(ctx, model.JWTTypeEmailVerification, "token").Return(claims, tt.verifyErr)
+			if tt.verifyErr == nil {
+				m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{ID: null.NewInt64(7, true)}).Return(tt.account, tt.findErr)
+			}
+			if tt.consume {
+				var consumeErr error
+				if tt.usedUp {
+					consumeErr = errors.New("token revoked")
+				}
+				m.token.EXPECT().Consume(ctx, claims).Return(consumeErr)
+			}
+			if tt.consume && !tt.usedUp {
+				m.account.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ interface{}, v *psqlmodel.Account) error {
+					if !v.EmailVerifiedAt.Valid {
+						t.Error("email not marked verified")
+					}
+					return nil
+				})
+			}
+
+			res, err := a.VerifyEmail(ctx, model.VerifyEmail{Token: "token"})
+			if tt.code != 0 {
+				if code := errormsg.GetErrorCode(err); code != tt.code {
+					t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Email != testEmail {
+				t.Fatalf("got %+v", res)
+			}
+		})
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	tests := []struct {
+		name      string
+		throttled bool
+		account   psqlmodel.Account
+		findErr   error
+		mailed    bool
+		code      int64
+	}{
+		{
+			name:    "unverified",
+			account: psqlmodel.Account{ID: 7, Email: testEmail},
+			mailed:  true,
+		},
+		{
+			name:    "unknown email",
+			findErr: errors.New("not found"),
+		},
+		{
+			name:    "already verified",
+			account: psqlmodel.Account{ID: 7, Email: testEmail, EmailVerifiedAt: null.TimeFrom(time.Now())},
+		},
+		{
+			name:      "throttled",
+			throttled: true,
+			code:      svcerr.CodeTooManyRequests,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAccount(t, Conf{EmailVerificationURL: "https://example.com/verify?token=%s"})
+			m.rateLimit.EXPECT().Allow(ctx, fmt.Sprintf(model.EmailVerificationResendKey, testEmail), int64(1), model.DefaultEmailVerificationResendInterval).Return(!tt.throttled, nil)
+			if !tt.throttled {
+				// the lookup runs after the request is answered, on a copy
+				// of the request context
+				m.account.EXPECT().GetSingleByParam(gomock.Any(), model.MustRevalidate, &model.GetAccountByParam{Email: null.NewString(testEmail, true)}).Return(tt.account, tt.findErr)
+			}
+			if tt.mailed {
+				m.token.EXPECT().Sign(gomock.Any(), model.JWTTypeEmailVerification, gomock.Any()).Return("signed", nil)
+				m.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, mail model.Mail) error {
+					if mail.To != testEmail || !strings.Contains(mail.Body, "https://example.com/verify?token=signed") {
+						t.Errorf("got mail %+v", mail)
+					}
+					return nil
+				})
+			}
+
+			err := a.ResendVerification(ctx, model.ResendVerification{Email: testEmail})
+			a.mailing.Wait()
+			if tt.code != 0 {
+				if code := errormsg.GetErrorCode(err); code != tt.code {
+					t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestResendVerificationMailFailure(t *testing.T) {
+	a, m, ctx := newTestAccount(t, Conf{})
+	m.rateLimit.EXPECT().Allow(ctx, gomock.Any(), int64(1), gomock.Any()).Return(true, nil)
+	m.account.EXPECT().GetSingleByParam(gomock.Any(), model.MustRevalidate, gomock.Any()).Return(psqlmodel.Account{ID: 7, Email: testEmail}, nil)
+	m.token.EXPECT().Sign(gomock.Any(), model.JWTTypeEmailVerification, gomock.Any()).Return("signed", nil)
+	m.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("smtp down"))
+
+	// the caller cannot tell a failed mail from an unknown email
+	if err := a.ResendVerification(ctx, model.ResendVerification{Email: testEmail}); err != nil {
+		t.Fatal(err)
+	}
+	a.mailing.Wait()
+}
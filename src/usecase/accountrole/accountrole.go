@@ -2,6 +2,8 @@ package accountrole
 
 import (
 	"github.com/achwanyusuf/carrent-accountsvc/src/domain/accountrole"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/organisationmember"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/role"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
@@ -12,9 +14,11 @@ import (
 )
 
 type AccountRoleDep struct {
-	log         logger.Logger
-	conf        Conf
-	accountRole accountrole.AccountRoleInterface
+	log                logger.Logger
+	conf               Conf
+	accountRole        accountrole.AccountRoleInterface
+	role               role.RoleInterface
+	organisationMember organisationmember.OrganisationMemberInterface
 }
 
 type Conf struct{}
@@ -26,11 +30,13 @@ type AccountRoleInterface interface {
 	DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error
 }
 
-func New(conf Conf, logger *logger.Logger, accountRole accountrole.AccountRoleInterface) AccountRoleInterface {
+func New(conf Conf, logger *logger.Logger, accountRole accountrole.AccountRoleInterface, role role.RoleInterface, organisationMember organisationmember.OrganisationMemberInterface) AccountRoleInterface {
 	return &AccountRoleDep{
-		conf:        conf,
-		log:         *logger,
-		accountRole: accountRole,
+		conf:               conf,
+		log:                *logger,
+		accountRole:        accountRole,
+		role:               role,
+		organisationMember: organisationMember,
 	}
 }
 
@@ -41,19 +47,28 @@ func (a *AccountRoleDep) Create(ctx *gin.Context, v model.CreateAccountRole) (mo
 		return result, err
 	}
 
-	role := &psqlmodel.AccountRole{
-		AccountID: int(v.AccountID),
-		RoleID:    int(v.RoleID),
-		CreatedBy: int(v.CreatedBy),
-		UpdatedBy: int(v.CreatedBy),
+	if v.OrganisationID != 0 {
+		if err = a.validateOrganisationGrant(ctx, v); err != nil {
+			return result, err
+		}
 	}
 
-	err = a.accountRole.Insert(ctx, role)
+	accountRole := &psqlmodel.AccountRole{
+		AccountID:      int(v.AccountID),
+		RoleID:         int(v.RoleID),
+		OrganisationID: null.NewInt(int(v.OrganisationID), v.OrganisationID != 0),
+		ValidFrom:      v.ValidFrom,
+		ValidUntil:     v.ValidUntil,
+		CreatedBy:      int(v.CreatedBy),
+		UpdatedBy:      int(v.CreatedBy),
+	}
+
+	err = a.accountRole.Insert(ctx, accountRole)
 	if err != nil {
 		return result, err
 	}
 
-	return model.TransformPSQLSingleAccountRole(role), nil
+	return model.TransformPSQLSingleAccountRole(accountRole), nil
 }
 
 func (a *AccountRoleDep) GetByParam(ctx *gin.Context, cacheControl string, v model.GetAccountRolesByParam) ([]model.AccountRole, model.Pagination, error) {
@@ -66,7 +81,8 @@ func (a *AccountRoleDep) GetByParam(ctx *gin.Context, cacheControl string, v mod
 
 func (a *AccountRoleDep) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.AccountRole, error) {
 	accountRole, err := a.accountRole.GetSingleByParam(ctx, cacheControl, &model.GetAccountRoleByParam{
-		ID: null.NewInt64(id, true),
+		ID:              null.NewInt64(id, true),
+		IncludeInactive: true,
 	})
 	if err != nil {
 		return model.AccountRole{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "data not found")
@@ -76,10 +92,35 @@ func (a *AccountRoleDep) GetByID(ctx *gin.Context, cacheControl string, id int64
 
 func (a *AccountRoleDep) DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error {
 	accountRole, err := a.accountRole.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountRoleByParam{
-		ID: null.NewInt64(vid, true),
+		ID:              null.NewInt64(vid, true),
+		IncludeInactive: true,
 	})
 	if err != nil {
 		return err
 	}
 	return a.accountRole.Delete(ctx, &accountRole, id, isHardDelete)
 }
+
+// validateOrganisationGrant checks a role granted within an organisation
+// goes to one of its members. Only store roles can be granted this way, the
+// permissions of a role are not limited to the organisation.
+func (a *AccountRoleDep) validateOrganisationGrant(ctx *gin.Context, v model.CreateAccountRole) error {
+	role, err := a.role.GetSingleByParam(ctx, model.MustRevalidate, &model.GetRoleByParam{
+		ID: null.NewInt64(v.RoleID, true),
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "role not found")
+	}
+	if role.Scope != model.StoreScope {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidOrganisation, nil, "only store roles can be granted within an organisation")
+	}
+
+	_, err = a.organisationMember.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrganisationMemberByParam{
+		OrganisationID: null.NewInt64(v.OrganisationID, true),
+		AccountID:      null.NewInt64(v.AccountID, true),
+	})
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCNotOrganisationMember, err, "account is not a member of the organisation")
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+package apikey
+
+import (
+	"crypto/subtle"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/account"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/apikey"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	accountusecase "github.com/achwanyusuf/carrent-accountsvc/src/usecase/account"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/volatiletech/null/v8"
+)
+
+type APIKeyDep struct {
+	log            logger.Logger
+	conf           Conf
+	account        account.AccountInterface
+	apiKey         apikey.APIKeyInterface
+	accountUsecase accountusecase.AccountInterface
+}
+
+type Conf struct {
+	Expiration time.Duration `mapstructure:"expiration"`
+}
+
+// APIKeyInterface manages the API keys of accounts and authenticates the
+// requests made with them.
+type APIKeyInterface interface {
+	Create(ctx *gin.Context, v model.CreateAPIKey) (model.CreatedAPIKey, error)
+	GetByParam(ctx *gin.Context, id int64, v model.GetAPIKeysByParam) ([]model.APIKey, model.Pagination, error)
+	RevokeByID(ctx *gin.Context, id int64, keyID int64) error
+	ParseAPIKey(ctx *gin.Context, key string) (jwt.MapClaims, error)
+}
+
+func New(conf Conf, logger *logger.Logger, account account.AccountInterface, apiKey apikey.APIKeyInterface, accountUsecase accountusecase.AccountInterface) APIKeyInterface {
+	return &APIKeyDep{
+		conf:           conf,
+		log:            *logger,
+		account:        account,
+		apiKey:         apiKey,
+		accountUsecase: accountUsecase,
+	}
+}
+
+// Create creates an API key of the account v.AccountID. The key is only
+// returned here, just its hash and lookup prefix are stored. Its scope must
+// be granted to the account when the key is created, and the key keeps
+// working only for the roles that are still granted when it is used.
+func (a *APIKeyDep) Create(ctx *gin.Context, v model.CreateAPIKey) (model.CreatedAPIKey, error) {
+	if err := v.Validate(); err != nil {
+		return model.CreatedAPIKey{}, err
+	}
+
+	count, err := a.apiKey.Count(ctx, &model.GetAPIKeyByParam{
+		AccountID: null.NewInt64(v.AccountID, true),
+	})
+	if err != nil {
+		return model.CreatedAPIKey{}, err
+	}
+	if count >= model.MaxAPIKeysPerAccount {
+		return model.CreatedAPIKey{}, errormsg.WrapErr(svcerr.AccountSVCInvalidAPIKey, nil, "too many api keys")
+	}
+
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(v.AccountID, true),
+	})
+	if err != nil {
+		return model.CreatedAPIKey{}, errormsg.WrapErr(svcerr.AccountSVCNotFound, err, "account not found")
+	}
+
+	scope := strings.Join(model.RoleScopes(v.Scope), " ")
+	roles, err := a.apiKeyRoles(ctx, &account, scope)
+	if err != nil {
+		return model.CreatedAPIKey{}, err
+	}
+	granted := model.RolesScope(roles)
+	for _, s := range model.RoleScopes(scope) {
+		if !model.HasScope(granted, s) {
+			return model.CreatedAPIKey{}, errormsg.WrapErr(svcerr.AccountSVCInvalidScope, nil, "requested scope not granted")
+		}
+	}
+
+	lookup, err := common.GenerateRandomToken(model.APIKeyLookupSize)
+	if err != nil {
+		return model.CreatedAPIKey{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate api key")
+	}
+	secret, err := common.GenerateRandomToken(model.APIKeySecretSize)
+	if err != nil {
+		return model.CreatedAPIKey{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate api key")
+	}
+	key := model.APIKeyPrefix + lookup + secret
+
+	expiredAt := v.ExpiredAt.Time
+	if !v.ExpiredAt.Valid {
+		expiration := a.conf.Expiration
+		if expiration == 0 {
+			expiration = model.DefaultAPIKeyExpiration
+		}
+		expiredAt = time.Now().Add(expiration)
+	}
+
+	apiKey := &psqlmodel.APIKey{
+		AccountID: account.ID,
+		Name:      v.Name,
+		Prefix:    lookup,
+		KeyHash:   common.HashToken(key),
+		Scope:     scope,
+		ExpiredAt: expiredAt,
+		CreatedBy: account.ID,
+		UpdatedBy: account.ID,
+	}
+	if err = a.apiKey.Insert(ctx, apiKey); err != nil {
+		return model.CreatedAPIKey{}, err
+	}
+
+	return model.CreatedAPIKey{
+		APIKey: model.TransformPSQLSingleAPIKey(apiKey),
+		Key:    key,
+	}, nil
+}
+
+// GetByParam lists the API keys of the account id, revoked keys are left
+// out.
+func (a *APIKeyDep) GetByParam(ctx *gin.Context, id int64, v model.GetAPIKeysByParam) ([]model.APIKey, model.Pagination, error) {
+	v.AccountID = null.NewInt64(id, true)
+	if !v.OrderBy.Valid {
+		v.OrderBy = null.NewString("id desc", true)
+	}
+
+	apiKeys, pagination, err := a.apiKey.GetByParam(ctx, &v)
+	if err != nil {
+		return []model.APIKey{}, model.Pagination{}, err
+	}
+	return model.TransformPSQLAPIKey(&apiKeys), pagination, nil
+}
+
+// RevokeByID revokes the API key keyID of the account id, it stops working
+// on the next request.
+func (a *APIKeyDep) RevokeByID(ctx *gin.Context, id int64, keyID int64) error {
+	apiKey, err := a.apiKey.GetSingleByParam(ctx, &model.GetAPIKeyByParam{
+		ID:        null.NewInt64(keyID, true),
+		AccountID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return err
+	}
+
+	apiKey.UpdatedBy = int(id)
+	return a.apiKey.Delete(ctx, &apiKey, id)
+}
+
+// ParseAPIKey authenticates a request made with an API key and returns the
+// claims an access token of the account would carry. The roles are looked
+// up on every request, so a role revoked from the account is revoked from
+// its keys too.
+func (a *APIKeyDep) ParseAPIKey(ctx *gin.Context, key string) (jwt.MapClaims, error) {
+	lookup, ok := model.APIKeyLookup(key)
+	if !ok {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCInvalidAPIKey, nil, "malformed api key")
+	}
+
+	apiKey, err := a.apiKey.GetSingleByParam(ctx, &model.GetAPIKeyByParam{
+		Prefix: null.NewString(lookup, true),
+	})
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCInvalidAPIKey, err, "api key not found")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(apiKey.KeyHash), []byte(common.HashToken(key))) != 1 {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCInvalidAPIKey, nil, "api key mismatch")
+	}
+
+	now := time.Now()
+	if !apiKey.ExpiredAt.After(now) {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCInvalidAPIKey, nil, "api key expired")
+	}
+
+	// read past the cache so the keys of a deleted account stop at once
+	account, err := a.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(int64(apiKey.AccountID), true),
+	})
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCInvalidAPIKey, err, "account not found")
+	}
+
+	roles, err := a.apiKeyRoles(ctx, &account, apiKey.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := a.accountUsecase.RolesPermissions(ctx, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	// last_used_at is informational, it is written at most once per
+	// resolution to keep a busy key from writing on every request
+	if !apiKey.LastUsedAt.Valid || now.Sub(apiKey.LastUsedAt.Time) >= model.APIKeyLastUsedResolution {
+		apiKey.LastUsedAt = null.TimeFrom(now)
+		if err = a.apiKey.Update(ctx, &apiKey); err != nil {
+			a.log.Warn(ctx, err)
+		}
+	}
+
+	claimPermissions := make([]interface{}, 0, len(permissions))
+	for _, p := range permissions {
+		claimPermissions = append(claimPermissions, p)
+	}
+	return jwt.MapClaims{
+		"id":          float64(account.ID),
+		"sub":         strconv.Itoa(account.ID),
+		"username":    account.Email,
+		"client_id":   roles[0].Cid,
+		"scope":       model.RolesScope(roles),
+		"permissions": claimPermissions,
+		"api_key_id":  float64(apiKey.ID),
+	}, nil
+}
+
+// apiKeyRoles returns the global roles granted to account whose scope is in
+// scope, every granted global role when scope is empty. API keys carry no
+// organisation, so grants scoped to an organisation are left out.
+func (a *APIKeyDep) apiKeyRoles(ctx *gin.Context, account *psqlmodel.Account, scope string) ([]psqlmodel.Role, error) {
+	roles, err := a.accountUsecase.GrantedRoles(ctx, int64(account.ID), null.NewInt64(0, true))
+	if err != nil {
+		return nil, err
+	}
+
+	requested := model.RoleScopes(scope)
+	var res []psqlmodel.Role
+	for _, r := range roles {
+		if len(requested) == 0 || common.FindStrInSlice(r.Scope, requested) {
+			res = append(res, r)
+		}
+	}
+
+	if len(res) == 0 {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCInvalidScope, nil, "requested scope not granted")
+	}
+	if int64(len(res)) > model.MaxTokenRoles {
+		res = res[:model.MaxTokenRoles]
+	}
+	return res, nil
+}
@@ -0,0 +1,220 @@
+package apikey
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	mock_account "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/account"
+	mock_apikey "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/apikey"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	mock_accountusecase "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/account"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	testLookup = "abcdefgh"
+	testKey    = "crk_" + testLookup + "secretsecretsecret"
+)
+
+type testMocks struct {
+	account        *mock_account.MockAccountInterface
+	apiKey         *mock_apikey.MockAPIKeyInterface
+	accountUsecase *mock_accountusecase.MockAccountInterface
+}
+
+func newTestAPIKey(t *testing.T) (*APIKeyDep, *testMocks, *gin.Context) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &testMocks{
+		account:        mock_account.NewMockAccountInterface(ctrl),
+		apiKey:         mock_apikey.NewMockAPIKeyInterface(ctrl),
+		accountUsecase: mock_accountusecase.NewMockAccountInterface(ctrl),
+	}
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	a := New(Conf{}, &log, m.account, m.apiKey, m.accountUsecase).(*APIKeyDep)
+
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/account", nil)
+	return a, m, ctx
+}
+
+var (
+	userRole   = psqlmodel.Role{ID: 2, Scope: "usr", Cid: "usr-cid"}
+	driverRole = psqlmodel.Role{ID: 3, Scope: "drv", Cid: "drv-cid"}
+)
+
+func storedKey() psqlmodel.APIKey {
+	return psqlmodel.APIKey{
+		ID:        9,
+		AccountID: 5,
+		Prefix:    testLookup,
+		KeyHash:   common.HashToken(testKey),
+		Scope:     "usr",
+		ExpiredAt: time.Now().Add(time.Hour),
+	}
+}
+
+func TestParseAPIKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		lastUsedAt null.Time
+		updated    bool
+	}{
+		{name: "first use", updated: true},
+		{name: "used a while ago", lastUsedAt: null.TimeFrom(time.Now().Add(-time.Hour)), updated: true},
+		{name: "used just now", lastUsedAt: null.TimeFrom(time.Now())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAPIKey(t)
+			apiKey := storedKey()
+			apiKey.LastUsedAt = tt.lastUsedAt
+			m.apiKey.EXPECT().GetSingleByParam(ctx, &model.GetAPIKeyByParam{Prefix: null.NewString(testLookup, true)}).Return(apiKey, nil)
+			m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{ID: null.NewInt64(5, true)}).Return(psqlmodel.Account{ID: 5, Email: "user@example.com"}, nil)
+			// the key keeps the scope it was made with, drv is granted since
+			m.accountUsecase.EXPECT().GrantedRoles(ctx, int64(5), null.NewInt64(0, true)).Return([]psqlmodel.Role{userRole, driverRole}, nil)
+			m.accountUsecase.EXPECT().RolesPermissions(ctx, []psqlmodel.Role{userRole}).Return([]string{"car:read"}, nil)
+			if tt.updated {
+				m.apiKey.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ *gin.Context, v *psqlmodel.APIKey) error {
+					if time.Since(v.LastUsedAt.Time) > time.Minute {
+						t.Errorf("last used at %v", v.LastUsedAt)
+					}
+					return errors.New("only warned")
+				})
+			}
+
+			claims, err := a.ParseAPIKey(ctx, testKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := jwt.MapClaims{
+				"id":          float64(5),
+				"sub":         "5",
+				"username":    "user@example.com",
+				"client_id":   "usr-cid",
+				"scope":       "usr",
+				"permissions": []interface{}{"car:read"},
+				"api_key_id":  float64(9),
+			}
+			if !reflect.DeepEqual(claims, want) {
+				t.Fatalf("got %v, want %v", claims, want)
+			}
+		})
+	}
+}
+
+func TestParseAPIKeyRejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		apiKey   func(k *psqlmodel.APIKey)
+		notFound bool
+		deleted  bool
+		roles    []psqlmodel.Role
+		code     int64
+	}{
+		{name: "malformed", key: "crk_short", code: svcerr.CodeInvalidAPIKey},
+		{name: "not an api key", key: "Bearer " + testKey, code: svcerr.CodeInvalidAPIKey},
+		{name: "unknown", key: testKey, notFound: true, code: svcerr.CodeInvalidAPIKey},
+		{name: "secret mismatch", key: "crk_" + testLookup + "guessed", code: svcerr.CodeInvalidAPIKey},
+		{name: "expired", key: testKey, apiKey: func(k *psqlmodel.APIKey) { k.ExpiredAt = time.Now().Add(-time.Second) }, code: svcerr.CodeInvalidAPIKey},
+		{name: "account deleted", key: testKey, deleted: true, code: svcerr.CodeInvalidAPIKey},
+		{name: "role revoked", key: testKey, roles: []psqlmodel.Role{driverRole}, code: svcerr.CodeInvalidScope},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAPIKey(t)
+			apiKey := storedKey()
+			if tt.apiKey != nil {
+				tt.apiKey(&apiKey)
+			}
+			var lookupErr error
+			if tt.notFound {
+				lookupErr = errors.New("not found")
+			}
+			m.apiKey.EXPECT().GetSingleByParam(ctx, gomock.Any()).Return(apiKey, lookupErr).MaxTimes(1)
+			var accountErr error
+			if tt.deleted {
+				accountErr = errors.New("not found")
+			}
+			m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, gomock.Any()).Return(psqlmodel.Account{ID: 5}, accountErr).MaxTimes(1)
+			if tt.roles != nil {
+				m.accountUsecase.EXPECT().GrantedRoles(ctx, int64(5), null.NewInt64(0, true)).Return(tt.roles, nil)
+			}
+
+			// nothing is written for a rejected key
+			_, err := a.ParseAPIKey(ctx, tt.key)
+			if code := errormsg.GetErrorCode(err); code != tt.code {
+				t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	a, m, ctx := newTestAPIKey(t)
+	m.apiKey.EXPECT().Count(ctx, gomock.Any()).Return(int64(0), nil)
+	m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, gomock.Any()).Return(psqlmodel.Account{ID: 5}, nil)
+	m.accountUsecase.EXPECT().GrantedRoles(ctx, int64(5), null.NewInt64(0, true)).Return([]psqlmodel.Role{userRole}, nil)
+
+	var stored *psqlmodel.APIKey
+	m.apiKey.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(func(_ *gin.Context, v *psqlmodel.APIKey) error {
+		stored = v
+		return nil
+	})
+
+	created, err := a.Create(ctx, model.CreateAPIKey{AccountID: 5, Name: "ci", Scope: "usr"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// only the hash and the lookup prefix of the returned key are stored
+	lookup, ok := model.APIKeyLookup(created.Key)
+	if !ok || stored.Prefix != lookup || stored.KeyHash != common.HashToken(created.Key) || stored.KeyHash == created.Key {
+		t.Fatalf("stored %+v for key %s", stored, created.Key)
+	}
+	if d := time.Until(stored.ExpiredAt); d <= model.DefaultAPIKeyExpiration-time.Minute || d > model.DefaultAPIKeyExpiration {
+		t.Fatalf("expires in %s", d)
+	}
+}
+
+func TestCreateRejected(t *testing.T) {
+	tests := []struct {
+		name  string
+		count int64
+		roles []psqlmodel.Role
+		code  int64
+	}{
+		{name: "too many keys", count: model.MaxAPIKeysPerAccount, code: svcerr.CodeInvalidAPIKey},
+		{name: "scope not granted", roles: []psqlmodel.Role{driverRole}, code: svcerr.CodeInvalidScope},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, m, ctx := newTestAPIKey(t)
+			m.apiKey.EXPECT().Count(ctx, gomock.Any()).Return(tt.count, nil)
+			m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, gomock.Any()).Return(psqlmodel.Account{ID: 5}, nil).MaxTimes(1)
+			if tt.roles != nil {
+				m.accountUsecase.EXPECT().GrantedRoles(ctx, int64(5), null.NewInt64(0, true)).Return(tt.roles, nil)
+			}
+
+			// no key is stored
+			_, err := a.Create(ctx, model.CreateAPIKey{AccountID: 5, Name: "ci", Scope: "usr drv"})
+			if code := errormsg.GetErrorCode(err); code != tt.code {
+				t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+			}
+		})
+	}
+}
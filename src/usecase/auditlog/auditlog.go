@@ -0,0 +1,40 @@
+package auditlog
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/auditlog"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditLogDep struct {
+	log      logger.Logger
+	conf     Conf
+	auditLog auditlog.AuditLogInterface
+}
+
+type Conf struct{}
+
+// AuditLogInterface reads the audit log, entries are written by the
+// usecases performing the audited actions.
+type AuditLogInterface interface {
+	GetByParam(ctx *gin.Context, v model.GetAuditLogsByParam) ([]model.AuditLog, model.Pagination, error)
+}
+
+func New(conf Conf, logger *logger.Logger, auditLog auditlog.AuditLogInterface) AuditLogInterface {
+	return &AuditLogDep{
+		conf:     conf,
+		log:      *logger,
+		auditLog: auditLog,
+	}
+}
+
+func (a *AuditLogDep) GetByParam(ctx *gin.Context, v model.GetAuditLogsByParam) ([]model.AuditLog, model.Pagination, error) {
+	auditLogSlice, pagination, err := a.auditLog.GetByParam(ctx, &v)
+	if err != nil {
+		return []model.AuditLog{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get by param")
+	}
+	return model.TransformPSQLAuditLog(&auditLogSlice), pagination, nil
+}
@@ -0,0 +1,88 @@
+package grantexpiry
+
+import (
+	"context"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/accountrole"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	accountusecase "github.com/achwanyusuf/carrent-accountsvc/src/usecase/account"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+)
+
+type GrantExpiryDep struct {
+	log            logger.Logger
+	conf           Conf
+	accountRole    accountrole.AccountRoleInterface
+	accountUsecase accountusecase.AccountInterface
+}
+
+type Conf struct {
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// GrantExpiryInterface expires role grants whose validity window ended.
+type GrantExpiryInterface interface {
+	Run(ctx context.Context)
+}
+
+func New(conf Conf, logger *logger.Logger, accountRole accountrole.AccountRoleInterface, accountUsecase accountusecase.AccountInterface) GrantExpiryInterface {
+	return &GrantExpiryDep{
+		conf:           conf,
+		log:            *logger,
+		accountRole:    accountRole,
+		accountUsecase: accountUsecase,
+	}
+}
+
+// Run periodically expires role grants whose validity window ended. Tokens
+// carry every scope granted to the account, so all tokens of an account
+// losing a grant are revoked and the next login only gets the grants that
+// are left. API keys are kept, their roles are re-read on every request.
+func (g *GrantExpiryDep) Run(ctx context.Context) {
+	interval := g.conf.Interval
+	if interval == 0 {
+		interval = model.DefaultGrantExpiryInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := g.expireGrants(ctx); err != nil {
+			g.log.Error(ctx, errormsg.WriteErr(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (g *GrantExpiryDep) expireGrants(ctx context.Context) error {
+	for {
+		accountRoles, err := g.accountRole.Expire(ctx, time.Now(), model.GrantExpiryBatchSize)
+		if err != nil {
+			return err
+		}
+
+		revoked := map[int]bool{}
+		for _, accountRole := range accountRoles {
+			if revoked[accountRole.AccountID] {
+				continue
+			}
+			revoked[accountRole.AccountID] = true
+			// the grant is already gone, a failed revocation only leaves
+			// the tokens valid until they expire
+			if err = g.accountUsecase.RevokeAccountTokens(ctx, accountRole.AccountID); err != nil {
+				g.log.Warn(ctx, err)
+			}
+		}
+
+		if len(accountRoles) < model.GrantExpiryBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package grantexpiry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_accountrole "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/accountrole"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	mock_accountusecase "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/account"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/golang/mock/gomock"
+)
+
+type testMocks struct {
+	accountRole    *mock_accountrole.MockAccountRoleInterface
+	accountUsecase *mock_accountusecase.MockAccountInterface
+}
+
+func newTestGrantExpiry(t *testing.T, conf Conf) (*GrantExpiryDep, *testMocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &testMocks{
+		accountRole:    mock_accountrole.NewMockAccountRoleInterface(ctrl),
+		accountUsecase: mock_accountusecase.NewMockAccountInterface(ctrl),
+	}
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	return New(conf, &log, m.accountRole, m.accountUsecase).(*GrantExpiryDep), m
+}
+
+// grants returns an expired grant of each account, in order.
+func grants(accountIDs ...int) psqlmodel.AccountRoleSlice {
+	var res psqlmodel.AccountRoleSlice
+	for i, id := range accountIDs {
+		res = append(res, &psqlmodel.AccountRole{ID: i + 1, AccountID: id})
+	}
+	return res
+}
+
+func TestExpireGrants(t *testing.T) {
+	g, m := newTestGrantExpiry(t, Conf{})
+	ctx := context.Background()
+
+	// a full batch of two grants per account, then what is left
+	var full []int
+	for i := 0; i < model.GrantExpiryBatchSize; i++ {
+		full = append(full, i/2+1)
+	}
+	last := model.GrantExpiryBatchSize/2 + 1
+	gomock.InOrder(
+		m.accountRole.EXPECT().Expire(ctx, gomock.Any(), model.GrantExpiryBatchSize).Return(grants(full...), nil),
+		m.accountRole.EXPECT().Expire(ctx, gomock.Any(), model.GrantExpiryBatchSize).Return(grants(last, last), nil),
+	)
+	// the tokens of every account losing a grant are revoked once, a failed
+	// revocation does not hold up the others
+	for id := 1; id <= last; id++ {
+		var err error
+		if id == 2 {
+			err = errors.New("redis down")
+		}
+		m.accountUsecase.EXPECT().RevokeAccountTokens(ctx, id).Return(err)
+	}
+
+	if err := g.expireGrants(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExpireGrantsFailure(t *testing.T) {
+	g, m := newTestGrantExpiry(t, Conf{})
+	ctx := context.Background()
+	m.accountRole.EXPECT().Expire(ctx, gomock.Any(), model.GrantExpiryBatchSize).Return(nil, errors.New("db down"))
+
+	// nothing expired, nothing revoked
+	if err := g.expireGrants(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRun(t *testing.T) {
+	g, m := newTestGrantExpiry(t, Conf{Interval: time.Hour})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// grants are expired on start, not an interval later
+	m.accountRole.EXPECT().Expire(ctx, gomock.Any(), model.GrantExpiryBatchSize).DoAndReturn(func(context.Context, time.Time, int) (psqlmodel.AccountRoleSlice, error) {
+		cancel()
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, errors.New("db down"), "error expire grants")
+	})
+
+	done := make(chan struct{})
+	go func() {
+		g.Run(ctx)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("run did not stop")
+	}
+}
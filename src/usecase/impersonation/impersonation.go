@@ -0,0 +1,190 @@
+package impersonation
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/account"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/auditlog"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	accountusecase "github.com/achwanyusuf/carrent-accountsvc/src/usecase/account"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/token"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+)
+
+type ImpersonationDep struct {
+	log            logger.Logger
+	conf           Conf
+	account        account.AccountInterface
+	auditLog       auditlog.AuditLogInterface
+	token          token.TokenInterface
+	accountUsecase accountusecase.AccountInterface
+}
+
+type Conf struct {
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// ImpersonationInterface lets admins holding the account:impersonate
+// permission act as another account. The
+// tokens are signed by the account usecase, every start and stop is
+// written to the audit log.
+type ImpersonationInterface interface {
+	Impersonate(ctx *gin.Context, id int64, v model.Impersonate) (model.Auth, error)
+	StopImpersonation(ctx *gin.Context, token string) error
+}
+
+func New(conf Conf, logger *logger.Logger, account account.AccountInterface, auditLog auditlog.AuditLogInterface, token token.TokenInterface, accountUsecase accountusecase.AccountInterface) ImpersonationInterface {
+	return &ImpersonationDep{
+		conf:           conf,
+		log:            *logger,
+		account:        account,
+		auditLog:       auditLog,
+		token:          token,
+		accountUsecase: accountUsecase,
+	}
+}
+
+// Impersonate issues a short-lived access token of account id to the admin
+// v.ActorID. The token carries the admin in an RFC 8693 "act" claim and no
+// refresh token comes with it. The start is written to the audit log
+// before the token is signed, so no impersonation goes unrecorded. Super
+// admins and accounts that may impersonate cannot be impersonated.
+func (i *ImpersonationDep) Impersonate(ctx *gin.Context, id int64, v model.Impersonate) (model.Auth, error) {
+	if err := v.Validate(); err != nil {
+		return model.Auth{}, err
+	}
+
+	if id == v.ActorID {
+		return model.Auth{}, errormsg.WrapErr(svcerr.AccountSVCInvalidImpersonation, nil, "cannot impersonate yourself")
+	}
+
+	account, err := i.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.Auth{}, errormsg.WrapErr(svcerr.AccountSVCNotFound, err, "account not found")
+	}
+
+	role, err := i.impersonationRole(ctx, &account, v.Scope)
+	if err != nil {
+		return model.Auth{}, err
+	}
+
+	// a version 7 jti carries the issue time to the millisecond, so the
+	// token does not survive a revocation of the account within its second
+	id7, err := uuid.NewV7()
+	if err != nil {
+		return model.Auth{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate jti")
+	}
+	jti := id7.String()
+	err = i.auditLog.Insert(ctx, &psqlmodel.AuditLog{
+		Action:    model.AuditActionImpersonationStart,
+		ActorID:   int(v.ActorID),
+		SubjectID: account.ID,
+		TokenID:   jti,
+		Reason:    v.Reason,
+		IPAddress: ctx.ClientIP(),
+		CreatedBy: int(v.ActorID),
+		UpdatedBy: int(v.ActorID),
+	})
+	if err != nil {
+		return model.Auth{}, err
+	}
+
+	timeout := i.conf.Timeout
+	if timeout == 0 {
+		timeout = model.DefaultImpersonationExpiration
+	}
+	return i.accountUsecase.AccountAccessToken(ctx, &account, role, v.Scope, 0, jwt.MapClaims{
+		"jti": jti,
+		"exp": time.Now().Add(timeout).Unix(),
+		"act": map[string]interface{}{
+			"sub": strconv.FormatInt(v.ActorID, 10),
+		},
+	})
+}
+
+// StopImpersonation revokes an impersonation token before it expires and
+// writes the stop to the audit log.
+func (i *ImpersonationDep) StopImpersonation(ctx *gin.Context, token string) error {
+	claims, err := i.token.Parse(ctx, token)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, err, "invalid token")
+	}
+
+	actor, err := strconv.ParseInt(impersonationActor(claims), 10, 64)
+	if err != nil {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidImpersonation, err, "token is not an impersonation")
+	}
+
+	if err = i.token.Revoke(ctx, claims); err != nil {
+		return err
+	}
+
+	id, _ := claims["id"].(float64)
+	jti, _ := claims["jti"].(string)
+	return i.auditLog.Insert(ctx, &psqlmodel.AuditLog{
+		Action:    model.AuditActionImpersonationStop,
+		ActorID:   int(actor),
+		SubjectID: int(id),
+		TokenID:   jti,
+		IPAddress: ctx.ClientIP(),
+		CreatedBy: int(actor),
+		UpdatedBy: int(actor),
+	})
+}
+
+// impersonationRole picks the role of account the impersonation token is
+// issued for: the first granted role matching the role scopes in scope, or
+// the first granted role when scope names none.
+func (i *ImpersonationDep) impersonationRole(ctx *gin.Context, account *psqlmodel.Account, scope string) (*psqlmodel.Role, error) {
+	// every grant is read, a blocking role past the first page must still
+	// deny
+	roles, err := i.accountUsecase.GrantedRoles(ctx, int64(account.ID), null.Int64{})
+	if err != nil {
+		return nil, err
+	}
+
+	requested := model.RoleScopes(scope)
+	var res *psqlmodel.Role
+	for n, r := range roles {
+		// every role is checked, an admin must not borrow the rights of
+		// another admin through a lesser role
+		if r.Scope == model.SuperAdminScope {
+			return nil, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "cannot impersonate a super admin")
+		}
+		if res == nil && (len(requested) == 0 || common.FindStrInSlice(r.Scope, requested)) {
+			res = &roles[n]
+		}
+	}
+
+	permissions, err := i.accountUsecase.RolesPermissions(ctx, roles)
+	if err != nil {
+		return nil, err
+	}
+	if common.FindStrInSlice(model.PermissionAccountImpersonate, permissions) {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCPermissionDenied, nil, "cannot impersonate an account that may impersonate")
+	}
+
+	if res == nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCInvalidScope, nil, "requested scope not granted")
+	}
+	return res, nil
+}
+
+// impersonationActor returns the subject of the "act" claim, empty when
+// claims do not belong to an impersonation token.
+func impersonationActor(claims jwt.MapClaims) string {
+	act, _ := claims["act"].(map[string]interface{})
+	sub, _ := act["sub"].(string)
+	return sub
+}
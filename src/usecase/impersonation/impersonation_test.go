@@ -0,0 +1,238 @@
+package impersonation
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mock_account "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/account"
+	mock_auditlog "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/auditlog"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	mock_accountusecase "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/account"
+	mock_token "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/token"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	testIP      = "192.0.2.1"
+	testActorID = 1
+	testID      = 5
+)
+
+type testMocks struct {
+	account        *mock_account.MockAccountInterface
+	auditLog       *mock_auditlog.MockAuditLogInterface
+	token          *mock_token.MockTokenInterface
+	accountUsecase *mock_accountusecase.MockAccountInterface
+}
+
+// newTestImpersonation returns the usecase on mocks and a request context
+// from testIP.
+func newTestImpersonation(t *testing.T, conf Conf) (*ImpersonationDep, *testMocks, *gin.Context) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &testMocks{
+		account:        mock_account.NewMockAccountInterface(ctrl),
+		auditLog:       mock_auditlog.NewMockAuditLogInterface(ctrl),
+		token:          mock_token.NewMockTokenInterface(ctrl),
+		accountUsecase: mock_accountusecase.NewMockAccountInterface(ctrl),
+	}
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	i := New(conf, &log, m.account, m.auditLog, m.token, m.accountUsecase).(*ImpersonationDep)
+
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/account/5/impersonate", nil)
+	ctx.Request.RemoteAddr = testIP + ":1234"
+	return i, m, ctx
+}
+
+// expectTarget finds the target account holding the roles, in order.
+func (m *testMocks) expectTarget(ctx *gin.Context, roles ...psqlmodel.Role) {
+	m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{ID: null.NewInt64(testID, true)}).Return(psqlmodel.Account{ID: testID, Email: "user@example.com"}, nil)
+	m.accountUsecase.EXPECT().GrantedRoles(ctx, int64(testID), null.Int64{}).Return(roles, nil)
+}
+
+var (
+	userRole    = psqlmodel.Role{ID: 2, Scope: "usr", Cid: "usr-cid"}
+	driverRole  = psqlmodel.Role{ID: 3, Scope: "drv", Cid: "drv-cid"}
+	supRole     = psqlmodel.Role{ID: 4, Scope: model.SuperAdminScope, Cid: "sup-cid"}
+	supportRole = psqlmodel.Role{ID: 6, Scope: "spt", Cid: "spt-cid"}
+)
+
+func TestImpersonate(t *testing.T) {
+	i, m, ctx := newTestImpersonation(t, Conf{Timeout: 10 * time.Minute})
+	m.expectTarget(ctx, userRole, driverRole)
+	m.accountUsecase.EXPECT().RolesPermissions(ctx, []psqlmodel.Role{userRole, driverRole}).Return([]string{"car:read"}, nil)
+
+	var jti string
+	m.auditLog.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(func(_ *gin.Context, log *psqlmodel.AuditLog) error {
+		if log.Action != model.AuditActionImpersonationStart || log.ActorID != testActorID || log.SubjectID != testID || log.Reason != "ticket 42" || log.IPAddress != testIP || log.TokenID == "" {
+			t.Errorf("audit log %+v", log)
+		}
+		jti = log.TokenID
+		// account revocations compare against the issue time in the jti
+		if id, err := uuid.Parse(jti); err != nil || id.Version() != 7 {
+			t.Errorf("jti %s is not a version 7 uuid", jti)
+		}
+		return nil
+	})
+	m.accountUsecase.EXPECT().AccountAccessToken(ctx, gomock.Any(), &driverRole, "drv", int64(0), gomock.Any()).DoAndReturn(func(_ *gin.Context, _ *psqlmodel.Account, _ *psqlmodel.Role, _ string, _ int64, claims jwt.MapClaims) (model.Auth, error) {
+		// the token is the one audited, short-lived and names the admin
+		if claims["jti"] != jti {
+			t.Errorf("token %v, audited %s", claims["jti"], jti)
+		}
+		exp, _ := claims["exp"].(int64)
+		if d := time.Until(time.Unix(exp, 0)); d <= 9*time.Minute || d > 10*time.Minute {
+			t.Errorf("expires in %s", d)
+		}
+		act, _ := claims["act"].(map[string]interface{})
+		if act["sub"] != "1" {
+			t.Errorf("act %v", claims["act"])
+		}
+		return model.Auth{AccessToken: "token"}, nil
+	})
+
+	auth, err := i.Impersonate(ctx, testID, model.Impersonate{Scope: "drv", Reason: "ticket 42", ActorID: testActorID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.AccessToken != "token" {
+		t.Fatalf("got %+v", auth)
+	}
+}
+
+func TestImpersonateRejected(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		v           model.Impersonate
+		notFound    bool
+		roles       []psqlmodel.Role
+		permissions []string
+		code        int64
+	}{
+		{
+			name: "no reason",
+			id:   testID,
+			v:    model.Impersonate{ActorID: testActorID},
+			code: svcerr.CodeInvalidImpersonation,
+		},
+		{
+			name: "yourself",
+			id:   testActorID,
+			v:    model.Impersonate{Reason: "r", ActorID: testActorID},
+			code: svcerr.CodeInvalidImpersonation,
+		},
+		{
+			name:     "unknown account",
+			id:       testID,
+			v:        model.Impersonate{Reason: "r", ActorID: testActorID},
+			notFound: true,
+			code:     svcerr.CodeNotFound,
+		},
+		{
+			name:  "super admin behind a lesser role",
+			id:    testID,
+			v:     model.Impersonate{Scope: "usr", Reason: "r", ActorID: testActorID},
+			roles: []psqlmodel.Role{userRole, supRole},
+			code:  svcerr.CodePermissionDenied,
+		},
+		{
+			name:        "account that may impersonate",
+			id:          testID,
+			v:           model.Impersonate{Reason: "r", ActorID: testActorID},
+			roles:       []psqlmodel.Role{userRole, supportRole},
+			permissions: []string{model.PermissionAccountImpersonate},
+			code:        svcerr.CodePermissionDenied,
+		},
+		{
+			name:  "scope not granted",
+			id:    testID,
+			v:     model.Impersonate{Scope: "drv", Reason: "r", ActorID: testActorID},
+			roles: []psqlmodel.Role{userRole},
+			code:  svcerr.CodeInvalidScope,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, m, ctx := newTestImpersonation(t, Conf{})
+			switch {
+			case tt.notFound:
+				m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, gomock.Any()).Return(psqlmodel.Account{}, errors.New("not found"))
+			case tt.roles != nil:
+				m.expectTarget(ctx, tt.roles...)
+				m.accountUsecase.EXPECT().RolesPermissions(ctx, gomock.Any()).Return(tt.permissions, nil).MaxTimes(1)
+			}
+
+			// nothing is audited or signed
+			_, err := i.Impersonate(ctx, tt.id, tt.v)
+			if code := errormsg.GetErrorCode(err); code != tt.code {
+				t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+			}
+		})
+	}
+}
+
+func TestImpersonateAuditFailure(t *testing.T) {
+	i, m, ctx := newTestImpersonation(t, Conf{})
+	m.expectTarget(ctx, userRole)
+	m.accountUsecase.EXPECT().RolesPermissions(ctx, gomock.Any()).Return(nil, nil)
+	m.auditLog.EXPECT().Insert(ctx, gomock.Any()).Return(errors.New("db down"))
+
+	// no token goes out unrecorded
+	if _, err := i.Impersonate(ctx, testID, model.Impersonate{Reason: "r", ActorID: testActorID}); err == nil {
+		t.Fatal("impersonated without audit")
+	}
+}
+
+func TestStopImpersonation(t *testing.T) {
+	claims := jwt.MapClaims{"id": float64(testID), "jti": "jti", "act": map[string]interface{}{"sub": "1"}}
+	tests := []struct {
+		name     string
+		claims   jwt.MapClaims
+		parseErr error
+		code     int64
+	}{
+		{name: "stopped", claims: claims},
+		{name: "invalid token", parseErr: errors.New("expired"), code: svcerr.CodeNotAuthorized},
+		{name: "not an impersonation", claims: jwt.MapClaims{"id": float64(testID), "jti": "jti"}, code: svcerr.CodeInvalidImpersonation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, m, ctx := newTestImpersonation(t, Conf{})
+			m.token.EXPECT().Parse(ctx, "token").Return(tt.claims, tt.parseErr)
+			if tt.code == 0 {
+				m.token.EXPECT().Revoke(ctx, tt.claims).Return(nil)
+				m.auditLog.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(func(_ *gin.Context, log *psqlmodel.AuditLog) error {
+					if log.Action != model.AuditActionImpersonationStop || log.ActorID != testActorID || log.SubjectID != testID || log.TokenID != "jti" {
+						t.Errorf("audit log %+v", log)
+					}
+					return nil
+				})
+			}
+
+			err := i.StopImpersonation(ctx, "token")
+			if tt.code == 0 {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if code := errormsg.GetErrorCode(err); code != tt.code {
+				t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package invitation
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/account"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/invitation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/mailer"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/organisation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/role"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	accountusecase "github.com/achwanyusuf/carrent-accountsvc/src/usecase/account"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/passwordhash"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/passwordpolicy"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/token"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/volatiletech/null/v8"
+)
+
+type InvitationDep struct {
+	log            logger.Logger
+	conf           Conf
+	account        account.AccountInterface
+	role           role.RoleInterface
+	organisation   organisation.OrganisationInterface
+	invitation     invitation.InvitationInterface
+	token          token.TokenInterface
+	mailer         mailer.MailerInterface
+	passwordPolicy passwordpolicy.PasswordPolicyInterface
+	passwordHash   passwordhash.PasswordHashInterface
+	accountUsecase accountusecase.AccountInterface
+}
+
+// Conf sets up the invitation mail. URL is formatted with the signed
+// invitation token and should point at the page where the invitee sets
+// their name and password.
+type Conf struct {
+	URL        string        `mapstructure:"url"`
+	Expiration time.Duration `mapstructure:"expiration"`
+}
+
+// InvitationInterface invites staff to a role, optionally within an
+// organisation, and creates the account of the invitee accepting.
+type InvitationInterface interface {
+	Create(ctx *gin.Context, v model.CreateInvitation) (model.Invitation, error)
+	GetByParam(ctx *gin.Context, cacheControl string, v model.GetInvitationsByParam) ([]model.Invitation, model.Pagination, error)
+	GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Invitation, error)
+	RevokeByID(ctx *gin.Context, id int64, revokedBy int64) (model.Invitation, error)
+	Accept(ctx *gin.Context, v model.AcceptInvitation) (model.Account, error)
+}
+
+func New(conf Conf, logger *logger.Logger, account account.AccountInterface, role role.RoleInterface, organisation organisation.OrganisationInterface, invitation invitation.InvitationInterface, token token.TokenInterface, mailer mailer.MailerInterface, passwordPolicy passwordpolicy.PasswordPolicyInterface, passwordHash passwordhash.PasswordHashInterface, accountUsecase accountusecase.AccountInterface) InvitationInterface {
+	return &InvitationDep{
+		conf:           conf,
+		log:            *logger,
+		account:        account,
+		role:           role,
+		organisation:   organisation,
+		invitation:     invitation,
+		token:          token,
+		mailer:         mailer,
+		passwordPolicy: passwordPolicy,
+		passwordHash:   passwordHash,
+		accountUsecase: accountUsecase,
+	}
+}
+
+func (i *InvitationDep) Create(ctx *gin.Context, v model.CreateInvitation) (model.Invitation, error) {
+	var result model.Invitation
+	err := v.Validate()
+	if err != nil {
+		return result, err
+	}
+
+	role, err := i.role.GetSingleByParam(ctx, model.MustRevalidate, &model.GetRoleByParam{
+		ID: null.NewInt64(v.RoleID, true),
+	})
+	if err != nil {
+		return result, errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, err, "role not found")
+	}
+
+	var organisationID null.Int
+	if v.OrganisationID != 0 {
+		if role.Scope != model.StoreScope {
+			return result, errormsg.WrapErr(svcerr.AccountSVCInvalidOrganisation, nil, "only store roles can be granted within an organisation")
+		}
+
+		_, err = i.organisation.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrganisationByParam{
+			ID: null.NewInt64(v.OrganisationID, true),
+		})
+		if err != nil {
+			return result, errormsg.WrapErr(svcerr.AccountSVCInvalidOrganisation, err, "organisation not found")
+		}
+		organisationID = null.IntFrom(int(v.OrganisationID))
+	}
+
+	_, err = i.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		Email: null.NewString(v.Email, true),
+	})
+	if err == nil {
+		return result, errormsg.WrapErr(svcerr.AccountSVCEmailAlreadyRegistered, nil, "email already registered")
+	}
+
+	expiredAt := v.ExpiredAt.Time
+	if !v.ExpiredAt.Valid {
+		expiration := i.conf.Expiration
+		if expiration == 0 {
+			expiration = model.DefaultInvitationExpiration
+		}
+		expiredAt = time.Now().Add(expiration)
+	}
+
+	invitation := &psqlmodel.Invitation{
+		Email:            v.Email,
+		RoleID:           role.ID,
+		OrganisationID:   organisationID,
+		OrganisationRole: v.OrganisationRole,
+		ExpiredAt:        expiredAt,
+		CreatedBy:        int(v.CreatedBy),
+		UpdatedBy:        int(v.CreatedBy),
+	}
+
+	err = i.invitation.Insert(ctx, invitation)
+	if err != nil {
+		return result, err
+	}
+
+	// the invitation exists at this point, the inviter can revoke it and
+	// invite again if the mail does not arrive
+	if err = i.sendInvitation(ctx, invitation); err != nil {
+		i.log.Warn(ctx, err)
+	}
+
+	return model.TransformPSQLSingleInvitation(invitation), nil
+}
+
+func (i *InvitationDep) GetByParam(ctx *gin.Context, cacheControl string, v model.GetInvitationsByParam) ([]model.Invitation, model.Pagination, error) {
+	if err := v.Validate(); err != nil {
+		return []model.Invitation{}, model.Pagination{}, err
+	}
+
+	invitationSlice, pagination, err := i.invitation.GetByParam(ctx, cacheControl, &v)
+	if err != nil {
+		return []model.Invitation{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get by param")
+	}
+	return model.TransformPSQLInvitation(&invitationSlice), pagination, nil
+}
+
+func (i *InvitationDep) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Invitation, error) {
+	invitation, err := i.invitation.GetSingleByParam(ctx, cacheControl, &model.GetInvitationByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.Invitation{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "data not found")
+	}
+	return model.TransformPSQLSingleInvitation(&invitation), nil
+}
+
+// RevokeByID revokes a pending invitation, its token stops working right
+// away. Accepted, revoked and expired invitations are left as they are.
+func (i *InvitationDep) RevokeByID(ctx *gin.Context, id int64, revokedBy int64) (model.Invitation, error) {
+	invitation, err := i.invitation.GetSingleByParam(ctx, model.MustRevalidate, &model.GetInvitationByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.Invitation{}, err
+	}
+
+	if status := model.InvitationStatus(&invitation, time.Now()); status != model.InvitationStatusPending {
+		return model.Invitation{}, errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, nil, "invitation is "+status)
+	}
+
+	invitation.RevokedAt = null.TimeFrom(time.Now())
+	invitation.UpdatedBy = int(revokedBy)
+	err = i.invitation.Update(ctx, &invitation)
+	if err != nil {
+		return model.Invitation{}, err
+	}
+	return model.TransformPSQLSingleInvitation(&invitation), nil
+}
+
+// Accept creates the account of an invitee with the password they chose.
+// The account, its role grant and its organisation membership are created
+// together, so a failed acceptance leaves the invitation pending. The email
+// counts as verified since the token was mailed to it.
+func (i *InvitationDep) Accept(ctx *gin.Context, v model.AcceptInvitation) (model.Account, error) {
+	if err := v.Validate(); err != nil {
+		return model.Account{}, err
+	}
+
+	claims, err := i.token.Verify(ctx, model.JWTTypeInvitation, v.Token)
+	if err != nil {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, err, "invalid invitation token")
+	}
+
+	sub, _ := claims["sub"].(string)
+	id, err := strconv.ParseInt(sub, 10, 64)
+	if err != nil {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, err, "invalid subject")
+	}
+
+	invitation, err := i.invitation.GetSingleByParam(ctx, model.MustRevalidate, &model.GetInvitationByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, err, "invitation not found")
+	}
+
+	if email, _ := claims["email"].(string); email != invitation.Email {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, nil, "email changed since token was issued")
+	}
+
+	now := time.Now()
+	if status := model.InvitationStatus(&invitation, now); status != model.InvitationStatusPending {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCInvalidInvitation, nil, "invitation is "+status)
+	}
+
+	_, err = i.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		Email: null.NewString(invitation.Email, true),
+	})
+	if err == nil {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCEmailAlreadyRegistered, nil, "email already registered")
+	}
+
+	err = i.passwordPolicy.Validate(model.PasswordCandidate{
+		Password: v.Password,
+		Email:    invitation.Email,
+		Name:     v.Name,
+	})
+	if err != nil {
+		return model.Account{}, err
+	}
+
+	pwd, err := i.passwordHash.Hash(v.Password)
+	if err != nil {
+		return model.Account{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error hash password")
+	}
+
+	account := &psqlmodel.Account{
+		Name:            v.Name,
+		Email:           invitation.Email,
+		Password:        pwd,
+		EmailVerifiedAt: null.TimeFrom(now),
+		CreatedBy:       invitation.CreatedBy,
+		UpdatedBy:       invitation.CreatedBy,
+	}
+	accountRole := &psqlmodel.AccountRole{
+		RoleID:         invitation.RoleID,
+		OrganisationID: invitation.OrganisationID,
+		CreatedBy:      invitation.CreatedBy,
+		UpdatedBy:      invitation.CreatedBy,
+	}
+	var member *psqlmodel.OrganisationMember
+	if invitation.OrganisationID.Valid {
+		member = &psqlmodel.OrganisationMember{
+			OrganisationID: invitation.OrganisationID.Int,
+			Role:           invitation.OrganisationRole,
+			CreatedBy:      invitation.CreatedBy,
+			UpdatedBy:      invitation.CreatedBy,
+		}
+	}
+
+	err = i.invitation.Accept(ctx, &invitation, account, accountRole, member)
+	if err != nil {
+		return model.Account{}, err
+	}
+
+	if err = i.accountUsecase.RecordPasswordHistory(ctx, account); err != nil {
+		i.log.Warn(ctx, err)
+	}
+
+	return model.TransformPSQLSingleAccount(account), nil
+}
+
+func (i *InvitationDep) sendInvitation(ctx *gin.Context, invitation *psqlmodel.Invitation) error {
+	token, err := i.token.Sign(ctx, model.JWTTypeInvitation, jwt.MapClaims{
+		"sub":   strconv.Itoa(invitation.ID),
+		"email": invitation.Email,
+		"exp":   invitation.ExpiredAt.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return i.mailer.Send(ctx, model.Mail{
+		To:      invitation.Email,
+		Subject: "You are invited to CarRent",
+		Body: fmt.Sprintf("Hi,\n\nYou are invited to join the CarRent staff. Set up your account by opening the link below:\n\n%s\n\nThe link expires at %s.\n",
+			fmt.Sprintf(i.conf.URL, token), invitation.ExpiredAt.Format(time.RFC1123)),
+	})
+}
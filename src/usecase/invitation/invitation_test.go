@@ -0,0 +1,240 @@
+package invitation
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mock_account "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/account"
+	mock_invitation "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/invitation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	mock_accountusecase "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/account"
+	mock_passwordhash "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/passwordhash"
+	mock_token "github.com/achwanyusuf/carrent-accountsvc/src/usecase/mock/token"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/passwordpolicy"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	testToken    = "invitation-token"
+	testEmail    = "staff@example.com"
+	testPassword = "Correct-Horse-9"
+)
+
+type testMocks struct {
+	account        *mock_account.MockAccountInterface
+	invitation     *mock_invitation.MockInvitationInterface
+	token          *mock_token.MockTokenInterface
+	passwordHash   *mock_passwordhash.MockPasswordHashInterface
+	accountUsecase *mock_accountusecase.MockAccountInterface
+}
+
+// newTestInvitation returns the usecase on mocks of what Accept reaches,
+// with the default password policy.
+func newTestInvitation(t *testing.T) (*InvitationDep, *testMocks, *gin.Context) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &testMocks{
+		account:        mock_account.NewMockAccountInterface(ctrl),
+		invitation:     mock_invitation.NewMockInvitationInterface(ctrl),
+		token:          mock_token.NewMockTokenInterface(ctrl),
+		passwordHash:   mock_passwordhash.NewMockPasswordHashInterface(ctrl),
+		accountUsecase: mock_accountusecase.NewMockAccountInterface(ctrl),
+	}
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	i := &InvitationDep{
+		log:            log,
+		account:        m.account,
+		invitation:     m.invitation,
+		token:          m.token,
+		passwordPolicy: passwordpolicy.New(passwordpolicy.Conf{PersonalInfoCheck: true}, &log),
+		passwordHash:   m.passwordHash,
+		accountUsecase: m.accountUsecase,
+	}
+
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/invitation/accept", nil)
+	return i, m, ctx
+}
+
+func pendingInvitation() psqlmodel.Invitation {
+	return psqlmodel.Invitation{
+		ID:               7,
+		Email:            testEmail,
+		RoleID:           2,
+		OrganisationID:   null.IntFrom(3),
+		OrganisationRole: model.OrganisationRoleAdmin,
+		ExpiredAt:        time.Now().Add(time.Hour),
+		CreatedBy:        1,
+	}
+}
+
+func acceptRequest() model.AcceptInvitation {
+	return model.AcceptInvitation{
+		Token:           testToken,
+		Name:            "Staff Member",
+		Password:        testPassword,
+		ConfirmPassword: testPassword,
+	}
+}
+
+func TestAccept(t *testing.T) {
+	i, m, ctx := newTestInvitation(t)
+	invitation := pendingInvitation()
+	m.token.EXPECT().Verify(ctx, model.JWTTypeInvitation, testToken).Return(jwt.MapClaims{"sub": "7", "email": testEmail}, nil)
+	m.invitation.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, &model.GetInvitationByParam{ID: null.NewInt64(7, true)}).Return(invitation, nil)
+	m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{Email: null.NewString(testEmail, true)}).Return(psqlmodel.Account{}, errormsg.WrapErr(svcerr.AccountSVCNotFound, nil, "not found"))
+	m.passwordHash.EXPECT().Hash(testPassword).Return("hash", nil)
+	m.invitation.EXPECT().Accept(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ *gin.Context, inv *psqlmodel.Invitation, account *psqlmodel.Account, accountRole *psqlmodel.AccountRole, member *psqlmodel.OrganisationMember) error {
+		if inv.ID != 7 {
+			t.Errorf("accepted invitation %d", inv.ID)
+		}
+		if account.Email != testEmail || account.Name != "Staff Member" || account.Password != "hash" || !account.EmailVerifiedAt.Valid || account.CreatedBy != 1 {
+			t.Errorf("account %+v", account)
+		}
+		if accountRole.RoleID != 2 || accountRole.OrganisationID != null.IntFrom(3) || accountRole.CreatedBy != 1 {
+			t.Errorf("account role %+v", accountRole)
+		}
+		if member == nil || member.OrganisationID != 3 || member.Role != model.OrganisationRoleAdmin {
+			t.Errorf("member %+v", member)
+		}
+		account.ID = 11
+		return nil
+	})
+	m.accountUsecase.EXPECT().RecordPasswordHistory(ctx, gomock.Any()).Return(errors.New("history unavailable"))
+
+	// the account exists once accepted, a failed history record only warns
+	account, err := i.Accept(ctx, acceptRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.ID != 11 || account.Email != testEmail {
+		t.Fatalf("got account %+v", account)
+	}
+}
+
+func TestAcceptWithoutOrganisation(t *testing.T) {
+	i, m, ctx := newTestInvitation(t)
+	invitation := pendingInvitation()
+	invitation.OrganisationID = null.Int{}
+	invitation.OrganisationRole = ""
+	m.token.EXPECT().Verify(ctx, model.JWTTypeInvitation, testToken).Return(jwt.MapClaims{"sub": "7", "email": testEmail}, nil)
+	m.invitation.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, gomock.Any()).Return(invitation, nil)
+	m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, gomock.Any()).Return(psqlmodel.Account{}, errormsg.WrapErr(svcerr.AccountSVCNotFound, nil, "not found"))
+	m.passwordHash.EXPECT().Hash(testPassword).Return("hash", nil)
+	m.invitation.EXPECT().Accept(ctx, gomock.Any(), gomock.Any(), gomock.Any(), nil).DoAndReturn(func(_ *gin.Context, _ *psqlmodel.Invitation, _ *psqlmodel.Account, accountRole *psqlmodel.AccountRole, _ *psqlmodel.OrganisationMember) error {
+		if accountRole.OrganisationID.Valid {
+			t.Errorf("global invitation granted within organisation %d", accountRole.OrganisationID.Int)
+		}
+		return nil
+	})
+	m.accountUsecase.EXPECT().RecordPasswordHistory(ctx, gomock.Any()).Return(nil)
+
+	if _, err := i.Accept(ctx, acceptRequest()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAcceptRejected(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		request    func(v *model.AcceptInvitation)
+		verifyErr  error
+		claims     jwt.MapClaims
+		invitation func(i *psqlmodel.Invitation)
+		registered bool
+		code       int64
+	}{
+		{
+			name:    "password confirmation",
+			request: func(v *model.AcceptInvitation) { v.ConfirmPassword = "other" },
+			code:    svcerr.CodeInvalidPasswordConfirmation,
+		},
+		{
+			name:      "invalid token",
+			verifyErr: errors.New("expired"),
+			code:      svcerr.CodeInvalidInvitation,
+		},
+		{
+			name:   "invalid subject",
+			claims: jwt.MapClaims{"sub": "x", "email": testEmail},
+			code:   svcerr.CodeInvalidInvitation,
+		},
+		{
+			name:   "email changed",
+			claims: jwt.MapClaims{"sub": "7", "email": "old@example.com"},
+			code:   svcerr.CodeInvalidInvitation,
+		},
+		{
+			name:       "accepted",
+			invitation: func(i *psqlmodel.Invitation) { i.AcceptedAt = null.TimeFrom(now) },
+			code:       svcerr.CodeInvalidInvitation,
+		},
+		{
+			name:       "revoked",
+			invitation: func(i *psqlmodel.Invitation) { i.RevokedAt = null.TimeFrom(now) },
+			code:       svcerr.CodeInvalidInvitation,
+		},
+		{
+			name:       "expired",
+			invitation: func(i *psqlmodel.Invitation) { i.ExpiredAt = now.Add(-time.Minute) },
+			code:       svcerr.CodeInvalidInvitation,
+		},
+		{
+			name:       "email registered",
+			registered: true,
+			code:       svcerr.CodeEmailAlreadyRegistered,
+		},
+		{
+			name: "password policy",
+			request: func(v *model.AcceptInvitation) {
+				v.Password = "staff-Password-9"
+				v.ConfirmPassword = v.Password
+			},
+			code: svcerr.CodePasswordContainsPersonalInfo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, m, ctx := newTestInvitation(t)
+			v := acceptRequest()
+			if tt.request != nil {
+				tt.request(&v)
+			}
+			invitation := pendingInvitation()
+			if tt.invitation != nil {
+				tt.invitation(&invitation)
+			}
+			claims := tt.claims
+			if claims == nil {
+				claims = jwt.MapClaims{"sub": "7", "email": testEmail}
+			}
+			accountErr := errormsg.WrapErr(svcerr.AccountSVCNotFound, nil, "not found")
+			if tt.registered {
+				accountErr = nil
+			}
+			// only the lookups up to the rejection happen, nothing is
+			// hashed or written
+			m.token.EXPECT().Verify(ctx, model.JWTTypeInvitation, testToken).Return(claims, tt.verifyErr).MaxTimes(1)
+			m.invitation.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, gomock.Any()).Return(invitation, nil).MaxTimes(1)
+			m.account.EXPECT().GetSingleByParam(ctx, model.MustRevalidate, gomock.Any()).Return(psqlmodel.Account{}, accountErr).MaxTimes(1)
+
+			_, err := i.Accept(ctx, v)
+			if code := errormsg.GetErrorCode(err); code != tt.code {
+				t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+			}
+		})
+	}
+}
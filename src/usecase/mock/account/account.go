@@ -5,11 +5,15 @@
 package mock_account
 
 import (
+	context "context"
 	reflect "reflect"
 
 	model "github.com/achwanyusuf/carrent-accountsvc/src/model"
+	psqlmodel "github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
 	gin "github.com/gin-gonic/gin"
+	jwt "github.com/golang-jwt/jwt"
 	gomock "github.com/golang/mock/gomock"
+	null "github.com/volatiletech/null/v8"
 )
 
 // MockAccountInterface is a mock of AccountInterface interface.
@@ -35,6 +39,51 @@ func (m *MockAccountInterface) EXPECT() *MockAccountInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AccountAccessToken mocks base method.
+func (m *MockAccountInterface) AccountAccessToken(ctx *gin.Context, account *psqlmodel.Account, role *psqlmodel.Role, scope string, organisationID int64, claims jwt.MapClaims) (model.Auth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountAccessToken", ctx, account, role, scope, organisationID, claims)
+	ret0, _ := ret[0].(model.Auth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountAccessToken indicates an expected call of AccountAccessToken.
+func (mr *MockAccountInterfaceMockRecorder) AccountAccessToken(ctx, account, role, scope, organisationID, claims interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountAccessToken", reflect.TypeOf((*MockAccountInterface)(nil).AccountAccessToken), ctx, account, role, scope, organisationID, claims)
+}
+
+// Authorize mocks base method.
+func (m *MockAccountInterface) Authorize(ctx *gin.Context, v model.Authorize) (model.AuthorizeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, v)
+	ret0, _ := ret[0].(model.AuthorizeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockAccountInterfaceMockRecorder) Authorize(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAccountInterface)(nil).Authorize), ctx, v)
+}
+
+// ConfirmMFA mocks base method.
+func (m *MockAccountInterface) ConfirmMFA(ctx *gin.Context, id int64, v model.MFACode) (model.RecoveryCodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmMFA", ctx, id, v)
+	ret0, _ := ret[0].(model.RecoveryCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmMFA indicates an expected call of ConfirmMFA.
+func (mr *MockAccountInterfaceMockRecorder) ConfirmMFA(ctx, id, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmMFA", reflect.TypeOf((*MockAccountInterface)(nil).ConfirmMFA), ctx, id, v)
+}
+
 // Create mocks base method.
 func (m *MockAccountInterface) Create(ctx *gin.Context, v model.Register) (model.Account, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +113,49 @@ func (mr *MockAccountInterfaceMockRecorder) DeleteByID(ctx, id, isHardDelete, vi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockAccountInterface)(nil).DeleteByID), ctx, id, isHardDelete, vid)
 }
 
+// DisableMFA mocks base method.
+func (m *MockAccountInterface) DisableMFA(ctx *gin.Context, id int64, v model.MFACode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableMFA", ctx, id, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableMFA indicates an expected call of DisableMFA.
+func (mr *MockAccountInterfaceMockRecorder) DisableMFA(ctx, id, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockAccountInterface)(nil).DisableMFA), ctx, id, v)
+}
+
+// EnrollMFA mocks base method.
+func (m *MockAccountInterface) EnrollMFA(ctx *gin.Context, id int64) (model.MFAEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMFA", ctx, id)
+	ret0, _ := ret[0].(model.MFAEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA.
+func (mr *MockAccountInterfaceMockRecorder) EnrollMFA(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockAccountInterface)(nil).EnrollMFA), ctx, id)
+}
+
+// ForgotPassword mocks base method.
+func (m *MockAccountInterface) ForgotPassword(ctx *gin.Context, v model.ForgotPassword) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPassword", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForgotPassword indicates an expected call of ForgotPassword.
+func (mr *MockAccountInterfaceMockRecorder) ForgotPassword(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPassword", reflect.TypeOf((*MockAccountInterface)(nil).ForgotPassword), ctx, v)
+}
+
 // GetByID mocks base method.
 func (m *MockAccountInterface) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Account, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +187,36 @@ func (mr *MockAccountInterfaceMockRecorder) GetByParam(ctx, cacheControl, v inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockAccountInterface)(nil).GetByParam), ctx, cacheControl, v)
 }
 
+// GrantedRoles mocks base method.
+func (m *MockAccountInterface) GrantedRoles(ctx *gin.Context, accountID int64, within null.Int64) ([]psqlmodel.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantedRoles", ctx, accountID, within)
+	ret0, _ := ret[0].([]psqlmodel.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantedRoles indicates an expected call of GrantedRoles.
+func (mr *MockAccountInterfaceMockRecorder) GrantedRoles(ctx, accountID, within interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantedRoles", reflect.TypeOf((*MockAccountInterface)(nil).GrantedRoles), ctx, accountID, within)
+}
+
+// Introspect mocks base method.
+func (m *MockAccountInterface) Introspect(ctx *gin.Context, v model.TokenRequest) (model.Introspection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Introspect", ctx, v)
+	ret0, _ := ret[0].(model.Introspection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Introspect indicates an expected call of Introspect.
+func (mr *MockAccountInterfaceMockRecorder) Introspect(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Introspect", reflect.TypeOf((*MockAccountInterface)(nil).Introspect), ctx, v)
+}
+
 // Oauth2 mocks base method.
 func (m *MockAccountInterface) Oauth2(ctx *gin.Context, v model.Login) (model.Auth, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +232,120 @@ func (mr *MockAccountInterfaceMockRecorder) Oauth2(ctx, v interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Oauth2", reflect.TypeOf((*MockAccountInterface)(nil).Oauth2), ctx, v)
 }
 
+// RecordPasswordHistory mocks base method.
+func (m *MockAccountInterface) RecordPasswordHistory(ctx *gin.Context, account *psqlmodel.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPasswordHistory", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPasswordHistory indicates an expected call of RecordPasswordHistory.
+func (mr *MockAccountInterfaceMockRecorder) RecordPasswordHistory(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPasswordHistory", reflect.TypeOf((*MockAccountInterface)(nil).RecordPasswordHistory), ctx, account)
+}
+
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockAccountInterface) RegenerateRecoveryCodes(ctx *gin.Context, id int64, v model.MFACode) (model.RecoveryCodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", ctx, id, v)
+	ret0, _ := ret[0].(model.RecoveryCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockAccountInterfaceMockRecorder) RegenerateRecoveryCodes(ctx, id, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockAccountInterface)(nil).RegenerateRecoveryCodes), ctx, id, v)
+}
+
+// ResendVerification mocks base method.
+func (m *MockAccountInterface) ResendVerification(ctx *gin.Context, v model.ResendVerification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendVerification", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendVerification indicates an expected call of ResendVerification.
+func (mr *MockAccountInterfaceMockRecorder) ResendVerification(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendVerification", reflect.TypeOf((*MockAccountInterface)(nil).ResendVerification), ctx, v)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAccountInterface) ResetPassword(ctx *gin.Context, v model.ResetPassword) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAccountInterfaceMockRecorder) ResetPassword(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAccountInterface)(nil).ResetPassword), ctx, v)
+}
+
+// Revoke mocks base method.
+func (m *MockAccountInterface) Revoke(ctx *gin.Context, v model.TokenRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAccountInterfaceMockRecorder) Revoke(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAccountInterface)(nil).Revoke), ctx, v)
+}
+
+// RevokeAccountTokens mocks base method.
+func (m *MockAccountInterface) RevokeAccountTokens(ctx context.Context, accountID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccountTokens", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccountTokens indicates an expected call of RevokeAccountTokens.
+func (mr *MockAccountInterfaceMockRecorder) RevokeAccountTokens(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccountTokens", reflect.TypeOf((*MockAccountInterface)(nil).RevokeAccountTokens), ctx, accountID)
+}
+
+// RolesPermissions mocks base method.
+func (m *MockAccountInterface) RolesPermissions(ctx *gin.Context, roles []psqlmodel.Role) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RolesPermissions", ctx, roles)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RolesPermissions indicates an expected call of RolesPermissions.
+func (mr *MockAccountInterfaceMockRecorder) RolesPermissions(ctx, roles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RolesPermissions", reflect.TypeOf((*MockAccountInterface)(nil).RolesPermissions), ctx, roles)
+}
+
+// Unlock mocks base method.
+func (m *MockAccountInterface) Unlock(ctx *gin.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockAccountInterfaceMockRecorder) Unlock(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockAccountInterface)(nil).Unlock), ctx, id)
+}
+
 // UpdateByID mocks base method.
 func (m *MockAccountInterface) UpdateByID(ctx *gin.Context, id int64, v model.UpdateAccountData) (model.Account, error) {
 	m.ctrl.T.Helper()
@@ -139,3 +375,33 @@ func (mr *MockAccountInterfaceMockRecorder) UpdatePasswordByID(ctx, id, v interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasswordByID", reflect.TypeOf((*MockAccountInterface)(nil).UpdatePasswordByID), ctx, id, v)
 }
+
+// ValidateAuthorize mocks base method.
+func (m *MockAccountInterface) ValidateAuthorize(ctx *gin.Context, v model.AuthorizeRequest) (model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAuthorize", ctx, v)
+	ret0, _ := ret[0].(model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAuthorize indicates an expected call of ValidateAuthorize.
+func (mr *MockAccountInterfaceMockRecorder) ValidateAuthorize(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAuthorize", reflect.TypeOf((*MockAccountInterface)(nil).ValidateAuthorize), ctx, v)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockAccountInterface) VerifyEmail(ctx *gin.Context, v model.VerifyEmail) (model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, v)
+	ret0, _ := ret[0].(model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAccountInterfaceMockRecorder) VerifyEmail(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAccountInterface)(nil).VerifyEmail), ctx, v)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/auditlog/auditlog.go
+
+// Package mock_auditlog is a generated GoMock package.
+package mock_auditlog
+
+import (
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/carrent-accountsvc/src/model"
+	gin "github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditLogInterface is a mock of AuditLogInterface interface.
+type MockAuditLogInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogInterfaceMockRecorder
+}
+
+// MockAuditLogInterfaceMockRecorder is the mock recorder for MockAuditLogInterface.
+type MockAuditLogInterfaceMockRecorder struct {
+	mock *MockAuditLogInterface
+}
+
+// NewMockAuditLogInterface creates a new mock instance.
+func NewMockAuditLogInterface(ctrl *gomock.Controller) *MockAuditLogInterface {
+	mock := &MockAuditLogInterface{ctrl: ctrl}
+	mock.recorder = &MockAuditLogInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogInterface) EXPECT() *MockAuditLogInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetByParam mocks base method.
+func (m *MockAuditLogInterface) GetByParam(ctx *gin.Context, v model.GetAuditLogsByParam) ([]model.AuditLog, model.Pagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParam", ctx, v)
+	ret0, _ := ret[0].([]model.AuditLog)
+	ret1, _ := ret[1].(model.Pagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByParam indicates an expected call of GetByParam.
+func (mr *MockAuditLogInterfaceMockRecorder) GetByParam(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParam", reflect.TypeOf((*MockAuditLogInterface)(nil).GetByParam), ctx, v)
+}
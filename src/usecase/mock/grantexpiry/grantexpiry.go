@@ -0,0 +1,47 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/grantexpiry/grantexpiry.go
+
+// Package mock_grantexpiry is a generated GoMock package.
+package mock_grantexpiry
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGrantExpiryInterface is a mock of GrantExpiryInterface interface.
+type MockGrantExpiryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGrantExpiryInterfaceMockRecorder
+}
+
+// MockGrantExpiryInterfaceMockRecorder is the mock recorder for MockGrantExpiryInterface.
+type MockGrantExpiryInterfaceMockRecorder struct {
+	mock *MockGrantExpiryInterface
+}
+
+// NewMockGrantExpiryInterface creates a new mock instance.
+func NewMockGrantExpiryInterface(ctrl *gomock.Controller) *MockGrantExpiryInterface {
+	mock := &MockGrantExpiryInterface{ctrl: ctrl}
+	mock.recorder = &MockGrantExpiryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGrantExpiryInterface) EXPECT() *MockGrantExpiryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockGrantExpiryInterface) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockGrantExpiryInterfaceMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockGrantExpiryInterface)(nil).Run), ctx)
+}
@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/passwordhash/passwordhash.go
+
+// Package mock_passwordhash is a generated GoMock package.
+package mock_passwordhash
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPasswordHashInterface is a mock of PasswordHashInterface interface.
+type MockPasswordHashInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordHashInterfaceMockRecorder
+}
+
+// MockPasswordHashInterfaceMockRecorder is the mock recorder for MockPasswordHashInterface.
+type MockPasswordHashInterfaceMockRecorder struct {
+	mock *MockPasswordHashInterface
+}
+
+// NewMockPasswordHashInterface creates a new mock instance.
+func NewMockPasswordHashInterface(ctrl *gomock.Controller) *MockPasswordHashInterface {
+	mock := &MockPasswordHashInterface{ctrl: ctrl}
+	mock.recorder = &MockPasswordHashInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordHashInterface) EXPECT() *MockPasswordHashInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Compare mocks base method.
+func (m *MockPasswordHashInterface) Compare(encoded, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compare", encoded, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Compare indicates an expected call of Compare.
+func (mr *MockPasswordHashInterfaceMockRecorder) Compare(encoded, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compare", reflect.TypeOf((*MockPasswordHashInterface)(nil).Compare), encoded, password)
+}
+
+// Hash mocks base method.
+func (m *MockPasswordHashInterface) Hash(password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockPasswordHashInterfaceMockRecorder) Hash(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockPasswordHashInterface)(nil).Hash), password)
+}
+
+// NeedsRehash mocks base method.
+func (m *MockPasswordHashInterface) NeedsRehash(encoded string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedsRehash", encoded)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// NeedsRehash indicates an expected call of NeedsRehash.
+func (mr *MockPasswordHashInterfaceMockRecorder) NeedsRehash(encoded interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsRehash", reflect.TypeOf((*MockPasswordHashInterface)(nil).NeedsRehash), encoded)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/usecase/passwordpolicy/passwordpolicy.go
+
+// Package mock_passwordpolicy is a generated GoMock package.
+package mock_passwordpolicy
+
+import (
+	reflect "reflect"
+
+	model "github.com/achwanyusuf/carrent-accountsvc/src/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPasswordPolicyInterface is a mock of PasswordPolicyInterface interface.
+type MockPasswordPolicyInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordPolicyInterfaceMockRecorder
+}
+
+// MockPasswordPolicyInterfaceMockRecorder is the mock recorder for MockPasswordPolicyInterface.
+type MockPasswordPolicyInterfaceMockRecorder struct {
+	mock *MockPasswordPolicyInterface
+}
+
+// NewMockPasswordPolicyInterface creates a new mock instance.
+func NewMockPasswordPolicyInterface(ctrl *gomock.Controller) *MockPasswordPolicyInterface {
+	mock := &MockPasswordPolicyInterface{ctrl: ctrl}
+	mock.recorder = &MockPasswordPolicyInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordPolicyInterface) EXPECT() *MockPasswordPolicyInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Validate mocks base method.
+func (m *MockPasswordPolicyInterface) Validate(v model.PasswordCandidate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockPasswordPolicyInterfaceMockRecorder) Validate(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockPasswordPolicyInterface)(nil).Validate), v)
+}
@@ -0,0 +1,144 @@
+package organisation
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/organisation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/organisationmember"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+)
+
+// memberDeleteBatch is how many members are deleted per query when an
+// organisation is soft deleted.
+const memberDeleteBatch = 100
+
+type OrganisationDep struct {
+	log                logger.Logger
+	conf               Conf
+	organisation       organisation.OrganisationInterface
+	organisationMember organisationmember.OrganisationMemberInterface
+}
+
+type Conf struct{}
+
+type OrganisationInterface interface {
+	Create(ctx *gin.Context, v model.CreateOrganisation) (model.Organisation, error)
+	GetByParam(ctx *gin.Context, cacheControl string, v model.GetOrganisationsByParam) ([]model.Organisation, model.Pagination, error)
+	GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Organisation, error)
+	UpdateByID(ctx *gin.Context, id int64, v model.UpdateOrganisation) (model.Organisation, error)
+	DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error
+}
+
+func New(conf Conf, logger *logger.Logger, organisation organisation.OrganisationInterface, organisationMember organisationmember.OrganisationMemberInterface) OrganisationInterface {
+	return &OrganisationDep{
+		conf:               conf,
+		log:                *logger,
+		organisation:       organisation,
+		organisationMember: organisationMember,
+	}
+}
+
+func (o *OrganisationDep) Create(ctx *gin.Context, v model.CreateOrganisation) (model.Organisation, error) {
+	var result model.Organisation
+	err := v.Validate()
+	if err != nil {
+		return result, err
+	}
+
+	organisation := &psqlmodel.Organisation{
+		Code:      v.Code,
+		Name:      v.Name,
+		CreatedBy: int(v.CreatedBy),
+		UpdatedBy: int(v.CreatedBy),
+	}
+
+	err = o.organisation.Insert(ctx, organisation)
+	if err != nil {
+		return result, err
+	}
+
+	return model.TransformPSQLSingleOrganisation(organisation), nil
+}
+
+func (o *OrganisationDep) GetByParam(ctx *gin.Context, cacheControl string, v model.GetOrganisationsByParam) ([]model.Organisation, model.Pagination, error) {
+	organisationSlice, pagination, err := o.organisation.GetByParam(ctx, cacheControl, &v)
+	if err != nil {
+		return []model.Organisation{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get by param")
+	}
+	return model.TransformPSQLOrganisation(&organisationSlice), pagination, nil
+}
+
+func (o *OrganisationDep) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Organisation, error) {
+	organisation, err := o.organisation.GetSingleByParam(ctx, cacheControl, &model.GetOrganisationByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.Organisation{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "data not found")
+	}
+	return model.TransformPSQLSingleOrganisation(&organisation), nil
+}
+
+func (o *OrganisationDep) UpdateByID(ctx *gin.Context, id int64, v model.UpdateOrganisation) (model.Organisation, error) {
+	organisation, err := o.organisation.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrganisationByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.Organisation{}, err
+	}
+
+	if !v.Code.Valid && !v.Name.Valid {
+		return model.TransformPSQLSingleOrganisation(&organisation), nil
+	}
+
+	if err = v.Validate(); err != nil {
+		return model.Organisation{}, err
+	}
+
+	v.FillEntity(&organisation)
+	organisation.UpdatedBy = int(v.UpdatedBy)
+
+	err = o.organisation.Update(ctx, &organisation)
+	if err != nil {
+		return model.Organisation{}, err
+	}
+
+	return model.TransformPSQLSingleOrganisation(&organisation), nil
+}
+
+// DeleteByID deletes an organisation. A hard delete cascades to its members
+// and role grants, a soft delete removes the members here so no more tokens
+// are issued for the organisation.
+func (o *OrganisationDep) DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error {
+	organisation, err := o.organisation.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrganisationByParam{
+		ID: null.NewInt64(vid, true),
+	})
+	if err != nil {
+		return err
+	}
+
+	for !isHardDelete {
+		members, _, err := o.organisationMember.GetByParam(ctx, model.MustRevalidate, &model.GetOrganisationMembersByParam{
+			GetOrganisationMemberByParam: model.GetOrganisationMemberByParam{
+				OrganisationID: null.NewInt64(vid, true),
+			},
+			PageParam: model.PageParam{Limit: memberDeleteBatch},
+		})
+		if err != nil {
+			return err
+		}
+		if len(members) == 0 {
+			break
+		}
+
+		for _, member := range members {
+			if err = o.organisationMember.Delete(ctx, member, id, isHardDelete); err != nil {
+				return err
+			}
+		}
+	}
+	return o.organisation.Delete(ctx, &organisation, id, isHardDelete)
+}
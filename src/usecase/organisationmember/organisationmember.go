@@ -0,0 +1,162 @@
+package organisationmember
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/account"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/accountrole"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/organisation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/organisationmember"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+)
+
+// maxMemberRoles caps the role grants of a member looked up when it leaves
+// its organisation.
+const maxMemberRoles = 100
+
+type OrganisationMemberDep struct {
+	log                logger.Logger
+	conf               Conf
+	account            account.AccountInterface
+	accountRole        accountrole.AccountRoleInterface
+	organisation       organisation.OrganisationInterface
+	organisationMember organisationmember.OrganisationMemberInterface
+}
+
+type Conf struct{}
+
+// OrganisationMemberInterface manages which accounts belong to which
+// organisation and with which organisation role.
+type OrganisationMemberInterface interface {
+	Create(ctx *gin.Context, v model.CreateOrganisationMember) (model.OrganisationMember, error)
+	GetByParam(ctx *gin.Context, cacheControl string, v model.GetOrganisationMembersByParam) ([]model.OrganisationMember, model.Pagination, error)
+	GetByID(ctx *gin.Context, cacheControl string, id int64) (model.OrganisationMember, error)
+	UpdateByID(ctx *gin.Context, id int64, v model.UpdateOrganisationMember) (model.OrganisationMember, error)
+	DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error
+}
+
+func New(conf Conf, logger *logger.Logger, account account.AccountInterface, accountRole accountrole.AccountRoleInterface, organisation organisation.OrganisationInterface, organisationMember organisationmember.OrganisationMemberInterface) OrganisationMemberInterface {
+	return &OrganisationMemberDep{
+		conf:               conf,
+		log:                *logger,
+		account:            account,
+		accountRole:        accountRole,
+		organisation:       organisation,
+		organisationMember: organisationMember,
+	}
+}
+
+func (o *OrganisationMemberDep) Create(ctx *gin.Context, v model.CreateOrganisationMember) (model.OrganisationMember, error) {
+	var result model.OrganisationMember
+	err := v.Validate()
+	if err != nil {
+		return result, err
+	}
+
+	_, err = o.organisation.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrganisationByParam{
+		ID: null.NewInt64(v.OrganisationID, true),
+	})
+	if err != nil {
+		return result, errormsg.WrapErr(svcerr.AccountSVCInvalidOrganisation, err, "organisation not found")
+	}
+
+	_, err = o.account.GetSingleByParam(ctx, model.MustRevalidate, &model.GetAccountByParam{
+		ID: null.NewInt64(v.AccountID, true),
+	})
+	if err != nil {
+		return result, errormsg.WrapErr(svcerr.AccountSVCNotFound, err, "account not found")
+	}
+
+	member := &psqlmodel.OrganisationMember{
+		OrganisationID: int(v.OrganisationID),
+		AccountID:      int(v.AccountID),
+		Role:           v.Role,
+		CreatedBy:      int(v.CreatedBy),
+		UpdatedBy:      int(v.CreatedBy),
+	}
+
+	err = o.organisationMember.Insert(ctx, member)
+	if err != nil {
+		return result, err
+	}
+
+	return model.TransformPSQLSingleOrganisationMember(member), nil
+}
+
+func (o *OrganisationMemberDep) GetByParam(ctx *gin.Context, cacheControl string, v model.GetOrganisationMembersByParam) ([]model.OrganisationMember, model.Pagination, error) {
+	memberSlice, pagination, err := o.organisationMember.GetByParam(ctx, cacheControl, &v)
+	if err != nil {
+		return []model.OrganisationMember{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get by param")
+	}
+	return model.TransformPSQLOrganisationMember(&memberSlice), pagination, nil
+}
+
+func (o *OrganisationMemberDep) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.OrganisationMember, error) {
+	member, err := o.organisationMember.GetSingleByParam(ctx, cacheControl, &model.GetOrganisationMemberByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.OrganisationMember{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "data not found")
+	}
+	return model.TransformPSQLSingleOrganisationMember(&member), nil
+}
+
+func (o *OrganisationMemberDep) UpdateByID(ctx *gin.Context, id int64, v model.UpdateOrganisationMember) (model.OrganisationMember, error) {
+	member, err := o.organisationMember.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrganisationMemberByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.OrganisationMember{}, err
+	}
+
+	if !v.Role.Valid {
+		return model.TransformPSQLSingleOrganisationMember(&member), nil
+	}
+
+	if err = v.Validate(); err != nil {
+		return model.OrganisationMember{}, err
+	}
+
+	v.FillEntity(&member)
+	member.UpdatedBy = int(v.UpdatedBy)
+
+	err = o.organisationMember.Update(ctx, &member)
+	if err != nil {
+		return model.OrganisationMember{}, err
+	}
+
+	return model.TransformPSQLSingleOrganisationMember(&member), nil
+}
+
+// DeleteByID removes an account from its organisation together with the
+// roles it was granted within it.
+func (o *OrganisationMemberDep) DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error {
+	member, err := o.organisationMember.GetSingleByParam(ctx, model.MustRevalidate, &model.GetOrganisationMemberByParam{
+		ID: null.NewInt64(vid, true),
+	})
+	if err != nil {
+		return err
+	}
+
+	accountRoles, _, err := o.accountRole.GetByParam(ctx, model.MustRevalidate, &model.GetAccountRolesByParam{
+		GetAccountRoleByParam: model.GetAccountRoleByParam{
+			AccountID:      null.NewInt64(int64(member.AccountID), true),
+			OrganisationID: null.NewInt64(int64(member.OrganisationID), true),
+		},
+		PageParam: model.PageParam{Limit: maxMemberRoles},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, accountRole := range accountRoles {
+		if err = o.accountRole.Delete(ctx, accountRole, id, isHardDelete); err != nil {
+			return err
+		}
+	}
+	return o.organisationMember.Delete(ctx, &member, id, isHardDelete)
+}
@@ -0,0 +1,55 @@
+package passwordhash
+
+import (
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// calibrationPassword is hashed while calibrating, its value does not
+// change the timing.
+const calibrationPassword = "calibration-password"
+
+// Calibrate benchmarks argon2id on the current host and returns conf with
+// the most iterations that still hash within target. Memory and parallelism
+// are kept as configured, they are bound by the host rather than by time.
+// When a single iteration already exceeds target the memory is halved until
+// it fits, down to 8 MiB.
+func Calibrate(conf Conf, target time.Duration) (Conf, time.Duration) {
+	const minMemory = 8 * 1024
+	conf = withDefaults(conf)
+	conf.Algorithm = model.PasswordHashArgon2id
+
+	salt := make([]byte, conf.Argon2SaltLength)
+	measure := func(iterations uint32) time.Duration {
+		// the first run warms up the allocator, take the fastest of three
+		best := time.Duration(0)
+		for i := 0; i < 3; i++ {
+			start := time.Now()
+			argon2.IDKey([]byte(calibrationPassword), salt, iterations, conf.Argon2Memory, conf.Argon2Parallelism, conf.Argon2KeyLength)
+			if d := time.Since(start); best == 0 || d < best {
+				best = d
+			}
+		}
+		return best
+	}
+
+	took := measure(1)
+	for took > target && conf.Argon2Memory/2 >= minMemory {
+		conf.Argon2Memory /= 2
+		took = measure(1)
+	}
+
+	conf.Argon2Iterations = 1
+	for {
+		next := measure(conf.Argon2Iterations + 1)
+		if next > target {
+			break
+		}
+		conf.Argon2Iterations++
+		took = next
+	}
+	return conf, took
+}
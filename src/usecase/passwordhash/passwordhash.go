@@ -0,0 +1,119 @@
+package passwordhash
+
+import (
+	"context"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+)
+
+type PasswordHashDep struct {
+	log     logger.Logger
+	conf    Conf
+	current Hasher
+	hashers []Hasher
+}
+
+type Conf struct {
+	Algorithm         string `mapstructure:"algorithm"`
+	Argon2Memory      uint32 `mapstructure:"argon2_memory"`
+	Argon2Iterations  uint32 `mapstructure:"argon2_iterations"`
+	Argon2Parallelism uint8  `mapstructure:"argon2_parallelism"`
+	Argon2SaltLength  uint32 `mapstructure:"argon2_salt_length"`
+	Argon2KeyLength   uint32 `mapstructure:"argon2_key_length"`
+	BcryptCost        int    `mapstructure:"bcrypt_cost"`
+}
+
+// PasswordHashInterface hashes passwords with the configured algorithm and
+// verifies hashes of every supported one. NeedsRehash reports hashes made
+// with another algorithm or weaker parameters, to be replaced on the next
+// successful login.
+type PasswordHashInterface interface {
+	Hash(password string) (string, error)
+	Compare(encoded, password string) error
+	NeedsRehash(encoded string) bool
+}
+
+func New(conf Conf, logger *logger.Logger) PasswordHashInterface {
+	log := *logger
+	conf = withDefaults(conf)
+
+	argon2id := &Argon2id{
+		Memory:      conf.Argon2Memory,
+		Iterations:  conf.Argon2Iterations,
+		Parallelism: conf.Argon2Parallelism,
+		SaltLength:  conf.Argon2SaltLength,
+		KeyLength:   conf.Argon2KeyLength,
+	}
+	bcrypt := &Bcrypt{Cost: conf.BcryptCost}
+
+	p := &PasswordHashDep{
+		log:     log,
+		conf:    conf,
+		current: argon2id,
+		hashers: []Hasher{argon2id, bcrypt},
+	}
+	switch conf.Algorithm {
+	case model.PasswordHashArgon2id:
+	case model.PasswordHashBcrypt:
+		p.current = bcrypt
+	default:
+		log.Warn(context.Background(), errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "unknown password hash algorithm "+conf.Algorithm+", using "+model.PasswordHashArgon2id))
+	}
+	return p
+}
+
+func (p *PasswordHashDep) Hash(password string) (string, error) {
+	return p.current.Hash(password)
+}
+
+func (p *PasswordHashDep) Compare(encoded, password string) error {
+	h := p.hasherOf(encoded)
+	if h == nil {
+		return ErrUnknownHash
+	}
+	return h.Compare(encoded, password)
+}
+
+func (p *PasswordHashDep) NeedsRehash(encoded string) bool {
+	if p.hasherOf(encoded) != p.current {
+		return true
+	}
+	return p.current.Weaker(encoded)
+}
+
+func (p *PasswordHashDep) hasherOf(encoded string) Hasher {
+	for _, h := range p.hashers {
+		if h.Identify(encoded) {
+			return h
+		}
+	}
+	return nil
+}
+
+func withDefaults(conf Conf) Conf {
+	if conf.Algorithm == "" {
+		conf.Algorithm = model.DefaultPasswordHashAlgorithm
+	}
+	if conf.Argon2Memory == 0 {
+		conf.Argon2Memory = model.DefaultArgon2Memory
+	}
+	if conf.Argon2Iterations == 0 {
+		conf.Argon2Iterations = model.DefaultArgon2Iterations
+	}
+	if conf.Argon2Parallelism == 0 {
+		conf.Argon2Parallelism = model.DefaultArgon2Parallelism
+	}
+	if conf.Argon2SaltLength == 0 {
+		conf.Argon2SaltLength = model.DefaultArgon2SaltLength
+	}
+	if conf.Argon2KeyLength == 0 {
+		conf.Argon2KeyLength = model.DefaultArgon2KeyLength
+	}
+	if conf.BcryptCost == 0 {
+		conf.BcryptCost = model.DefaultBcryptCost
+	}
+	return conf
+}
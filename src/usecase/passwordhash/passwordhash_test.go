@@ -0,0 +1,172 @@
+package passwordhash
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testConf keeps the hashes cheap, the parameters only matter relative to
+// each other here.
+var testConf = Conf{
+	Argon2Memory:      1024,
+	Argon2Iterations:  2,
+	Argon2Parallelism: 1,
+	Argon2SaltLength:  16,
+	Argon2KeyLength:   32,
+	BcryptCost:        bcrypt.MinCost + 1,
+}
+
+func newTestHash(t *testing.T, algorithm string, conf Conf) PasswordHashInterface {
+	t.Helper()
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	conf.Algorithm = algorithm
+	return New(conf, &log)
+}
+
+func TestHashAndCompare(t *testing.T) {
+	for _, algorithm := range []string{model.PasswordHashArgon2id, model.PasswordHashBcrypt} {
+		t.Run(algorithm, func(t *testing.T) {
+			p := newTestHash(t, algorithm, testConf)
+			encoded, err := p.Hash("s3cret-Password")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if algorithm == model.PasswordHashArgon2id && !strings.HasPrefix(encoded, "$argon2id$v=19$m=1024,t=2,p=1$") {
+				t.Fatalf("encoded %s", encoded)
+			}
+			if algorithm == model.PasswordHashBcrypt && !strings.HasPrefix(encoded, "$2a$05$") {
+				t.Fatalf("encoded %s", encoded)
+			}
+
+			if err = p.Compare(encoded, "s3cret-Password"); err != nil {
+				t.Fatalf("matching password got %v", err)
+			}
+			if err = p.Compare(encoded, "s3cret-password"); !errors.Is(err, ErrMismatch) {
+				t.Fatalf("other password got %v, want %v", err, ErrMismatch)
+			}
+
+			// salted, the same password never hashes the same twice
+			again, err := p.Hash("s3cret-Password")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again == encoded {
+				t.Fatal("hash not salted")
+			}
+		})
+	}
+}
+
+func TestCompareAcrossAlgorithms(t *testing.T) {
+	bcryptHash, err := newTestHash(t, model.PasswordHashBcrypt, testConf).Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// hashes written before the switch to argon2id still verify
+	p := newTestHash(t, model.PasswordHashArgon2id, testConf)
+	if err = p.Compare(bcryptHash, "password"); err != nil {
+		t.Fatalf("bcrypt hash got %v", err)
+	}
+	if err = p.Compare(bcryptHash, "other"); !errors.Is(err, ErrMismatch) {
+		t.Fatalf("bcrypt hash got %v, want %v", err, ErrMismatch)
+	}
+
+	for _, encoded := range []string{
+		"",
+		"plaintext",
+		"$argon2i$v=19$m=1024,t=2,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=2$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=2,p=1$!!$a2V5",
+		"$argon2id$v=19$m=1024,t=2,p=1$c2FsdA$",
+	} {
+		if err = p.Compare(encoded, "password"); !errors.Is(err, ErrUnknownHash) {
+			t.Errorf("%q got %v, want %v", encoded, err, ErrUnknownHash)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	weak := testConf
+	weak.Argon2Iterations = 1
+	weak.Argon2KeyLength = 16
+	weak.BcryptCost = bcrypt.MinCost
+	hash := func(algorithm string, conf Conf) string {
+		encoded, err := newTestHash(t, algorithm, conf).Hash("password")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return encoded
+	}
+	argon2idCurrent := hash(model.PasswordHashArgon2id, testConf)
+	argon2idWeak := hash(model.PasswordHashArgon2id, weak)
+	bcryptCurrent := hash(model.PasswordHashBcrypt, testConf)
+	bcryptWeak := hash(model.PasswordHashBcrypt, weak)
+
+	tests := []struct {
+		name      string
+		algorithm string
+		encoded   string
+		want      bool
+	}{
+		{"argon2id current", model.PasswordHashArgon2id, argon2idCurrent, false},
+		{"argon2id weaker", model.PasswordHashArgon2id, argon2idWeak, true},
+		{"argon2id from bcrypt", model.PasswordHashArgon2id, bcryptCurrent, true},
+		{"argon2id unknown", model.PasswordHashArgon2id, "plaintext", true},
+		{"bcrypt current", model.PasswordHashBcrypt, bcryptCurrent, false},
+		{"bcrypt weaker", model.PasswordHashBcrypt, bcryptWeak, true},
+		{"bcrypt from argon2id", model.PasswordHashBcrypt, argon2idCurrent, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newTestHash(t, tt.algorithm, testConf).NeedsRehash(tt.encoded); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeaker(t *testing.T) {
+	a := &Argon2id{Memory: 1024, Iterations: 2, Parallelism: 2, SaltLength: 16, KeyLength: 32}
+	tests := []struct {
+		name    string
+		encoded string
+		want    bool
+	}{
+		// salt of 16 bytes and key of 32 bytes
+		{"same", "$argon2id$v=19$m=1024,t=2,p=2$AAAAAAAAAAAAAAAAAAAAAA$AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", false},
+		{"stronger", "$argon2id$v=19$m=2048,t=3,p=4$AAAAAAAAAAAAAAAAAAAAAA$AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", false},
+		{"older version", "$argon2id$v=16$m=1024,t=2,p=2$AAAAAAAAAAAAAAAAAAAAAA$AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", true},
+		{"less memory", "$argon2id$v=19$m=512,t=2,p=2$AAAAAAAAAAAAAAAAAAAAAA$AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", true},
+		{"fewer iterations", "$argon2id$v=19$m=1024,t=1,p=2$AAAAAAAAAAAAAAAAAAAAAA$AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", true},
+		{"less parallelism", "$argon2id$v=19$m=1024,t=2,p=1$AAAAAAAAAAAAAAAAAAAAAA$AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", true},
+		{"shorter salt", "$argon2id$v=19$m=1024,t=2,p=2$AAAAAAAAAAA$AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", true},
+		{"shorter key", "$argon2id$v=19$m=1024,t=2,p=2$AAAAAAAAAAAAAAAAAAAAAA$AAAAAAAAAAAAAAAAAAAAAA", true},
+		{"malformed", "$argon2id$v=19$m=1024$AAAA$AAAA", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := a.Weaker(tt.encoded); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	b := &Bcrypt{Cost: bcrypt.MinCost + 1}
+	for cost, want := range map[int]bool{bcrypt.MinCost: true, bcrypt.MinCost + 1: false, bcrypt.MinCost + 2: false} {
+		encoded, err := bcrypt.GenerateFromPassword([]byte("password"), cost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := b.Weaker(string(encoded)); got != want {
+			t.Errorf("cost %d got %v, want %v", cost, got, want)
+		}
+	}
+	if !b.Weaker("$2a$malformed") {
+		t.Error("malformed bcrypt hash not weaker")
+	}
+}
@@ -0,0 +1,125 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"os"
+	"strings"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+)
+
+//go:embed common_passwords.txt
+var commonPasswords string
+
+type PasswordPolicyDep struct {
+	log   logger.Logger
+	conf  Conf
+	rules []Rule
+}
+
+type Conf struct {
+	MinLength           int    `mapstructure:"min_length"`
+	MaxLength           int    `mapstructure:"max_length"`
+	RequireUppercase    bool   `mapstructure:"require_uppercase"`
+	RequireLowercase    bool   `mapstructure:"require_lowercase"`
+	RequireDigit        bool   `mapstructure:"require_digit"`
+	RequireSymbol       bool   `mapstructure:"require_symbol"`
+	CommonPasswordCheck bool   `mapstructure:"common_password_check"`
+	CommonPasswordFile  string `mapstructure:"common_password_file"`
+	PersonalInfoCheck   bool   `mapstructure:"personal_info_check"`
+}
+
+// PasswordPolicyInterface checks new passwords. Validate runs every rule
+// and returns all violations at once, see model.PasswordViolations.
+type PasswordPolicyInterface interface {
+	Validate(v model.PasswordCandidate) error
+}
+
+// New builds the policy from conf. Extra rules are checked after the
+// configured ones, which is how a deployment plugs in its own checks.
+func New(conf Conf, logger *logger.Logger, extra ...Rule) PasswordPolicyInterface {
+	log := *logger
+	if conf.MinLength == 0 {
+		conf.MinLength = model.DefaultPasswordMinLength
+	}
+	if conf.MaxLength == 0 {
+		conf.MaxLength = model.DefaultPasswordMaxLength
+	}
+
+	rules := []Rule{
+		MinLength(conf.MinLength),
+		MaxLength(conf.MaxLength),
+	}
+	if conf.RequireUppercase {
+		rules = append(rules, Uppercase())
+	}
+	if conf.RequireLowercase {
+		rules = append(rules, Lowercase())
+	}
+	if conf.RequireDigit {
+		rules = append(rules, Digit())
+	}
+	if conf.RequireSymbol {
+		rules = append(rules, Symbol())
+	}
+	if conf.CommonPasswordCheck {
+		list := parseList(commonPasswords)
+		if conf.CommonPasswordFile != "" {
+			extraList, err := readList(conf.CommonPasswordFile)
+			if err != nil {
+				log.Warn(context.Background(), errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error read common password file"))
+			}
+			list = append(list, extraList...)
+		}
+		rules = append(rules, CommonPassword(list))
+	}
+	if conf.PersonalInfoCheck {
+		rules = append(rules, NoPersonalInfo())
+	}
+
+	return &PasswordPolicyDep{
+		log:   log,
+		conf:  conf,
+		rules: append(rules, extra...),
+	}
+}
+
+func (p *PasswordPolicyDep) Validate(v model.PasswordCandidate) error {
+	if v.Password == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCInvalidEmptyPassword, nil, "invalid empty password")
+	}
+
+	var violations model.PasswordViolations
+	for _, rule := range p.rules {
+		if violation := rule.Check(v); violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+	return violations.Err()
+}
+
+func readList(path string) ([]string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseList(string(b)), nil
+}
+
+func parseList(s string) []string {
+	var res []string
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		res = append(res, strings.ToLower(line))
+	}
+	return res
+}
@@ -0,0 +1,130 @@
+package passwordpolicy
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+)
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      Rule
+		candidate model.PasswordCandidate
+		violation *errormsg.Message
+	}{
+		{"min length", MinLength(8), model.PasswordCandidate{Password: "short"}, &svcerr.AccountSVCInvalidMinimumPassword},
+		{"min length counts characters", MinLength(4), model.PasswordCandidate{Password: "äöü"}, &svcerr.AccountSVCInvalidMinimumPassword},
+		{"min length met", MinLength(3), model.PasswordCandidate{Password: "äöü"}, nil},
+		{"max length", MaxLength(4), model.PasswordCandidate{Password: "toolong"}, &svcerr.AccountSVCInvalidMaximumPassword},
+		{"max length met", MaxLength(4), model.PasswordCandidate{Password: "äöüß"}, nil},
+		{"uppercase", Uppercase(), model.PasswordCandidate{Password: "lower1!"}, &svcerr.AccountSVCPasswordMissingUppercase},
+		{"uppercase met", Uppercase(), model.PasswordCandidate{Password: "lowerÄ"}, nil},
+		{"lowercase", Lowercase(), model.PasswordCandidate{Password: "UPPER1!"}, &svcerr.AccountSVCPasswordMissingLowercase},
+		{"digit", Digit(), model.PasswordCandidate{Password: "Letters!"}, &svcerr.AccountSVCPasswordMissingDigit},
+		{"digit met", Digit(), model.PasswordCandidate{Password: "Letters1"}, nil},
+		{"symbol", Symbol(), model.PasswordCandidate{Password: "Letters 1"}, &svcerr.AccountSVCPasswordMissingSymbol},
+		{"symbol met", Symbol(), model.PasswordCandidate{Password: "Letters_1"}, nil},
+		{"common", CommonPassword([]string{"password1"}), model.PasswordCandidate{Password: "PassWord1"}, &svcerr.AccountSVCPasswordTooCommon},
+		{"not common", CommonPassword([]string{"password1"}), model.PasswordCandidate{Password: "password12"}, nil},
+		{"email local part", NoPersonalInfo(), model.PasswordCandidate{Password: "xJohnDoe!9", Email: "johndoe@example.com"}, &svcerr.AccountSVCPasswordContainsPersonalInfo},
+		{"email domain allowed", NoPersonalInfo(), model.PasswordCandidate{Password: "example!9x", Email: "johndoe@example.com"}, nil},
+		{"name word", NoPersonalInfo(), model.PasswordCandidate{Password: "smith-Rules1", Name: "Anna Smith"}, &svcerr.AccountSVCPasswordContainsPersonalInfo},
+		{"short name word allowed", NoPersonalInfo(), model.PasswordCandidate{Password: "albatross1", Name: "Al B"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rule.Check(tt.candidate)
+			if tt.violation == nil {
+				if got != nil {
+					t.Fatalf("got violation %+v", got)
+				}
+				return
+			}
+			if got == nil || got.Code != tt.violation.Code {
+				t.Fatalf("got violation %+v, want code %d", got, tt.violation.Code)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	policy := New(Conf{
+		MinLength:           10,
+		RequireUppercase:    true,
+		RequireDigit:        true,
+		RequireSymbol:       true,
+		CommonPasswordCheck: true,
+		PersonalInfoCheck:   true,
+	}, &log)
+
+	err := policy.Validate(model.PasswordCandidate{})
+	if errormsg.GetErrorCode(err) != svcerr.CodeInvalidEmptyPassword {
+		t.Fatalf("empty password got %v", err)
+	}
+
+	if err = policy.Validate(model.PasswordCandidate{Password: "Correct-Horse-9", Email: "anna@example.com"}); err != nil {
+		t.Fatalf("valid password got %v", err)
+	}
+
+	// every violation is reported at once, in rule order, the first one
+	// sets the error code
+	err = policy.Validate(model.PasswordCandidate{Password: "anna", Email: "anna@example.com"})
+	var codes []int64
+	for _, v := range model.GetPasswordViolations(err) {
+		codes = append(codes, v.Code)
+	}
+	want := []int64{
+		svcerr.CodeInvalidMinimumPassword,
+		svcerr.CodePasswordMissingUppercase,
+		svcerr.CodePasswordMissingDigit,
+		svcerr.CodePasswordMissingSymbol,
+		svcerr.CodePasswordContainsPersonalInfo,
+	}
+	if !reflect.DeepEqual(codes, want) {
+		t.Fatalf("got violations %v, want %v", codes, want)
+	}
+	if errormsg.GetErrorCode(err) != svcerr.CodeInvalidMinimumPassword {
+		t.Fatalf("got code %d", errormsg.GetErrorCode(err))
+	}
+}
+
+func TestCommonPasswordFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "passwords.txt")
+	if err := os.WriteFile(path, []byte("# local list\nCarRent2024!\n\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	policy := New(Conf{CommonPasswordCheck: true, CommonPasswordFile: path}, &log)
+	for _, password := range []string{"carrent2024!", "123456789"} {
+		err := policy.Validate(model.PasswordCandidate{Password: password})
+		if errormsg.GetErrorCode(err) != svcerr.CodePasswordTooCommon {
+			t.Errorf("%s got %v", password, err)
+		}
+	}
+}
+
+func TestExtraRules(t *testing.T) {
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	noRepeat := RuleFunc(func(v model.PasswordCandidate) *model.PasswordViolation {
+		if v.Password == "aaaaaaaaaa" {
+			return violation(svcerr.AccountSVCPasswordTooCommon)
+		}
+		return nil
+	})
+	policy := New(Conf{}, &log, noRepeat)
+	if err := policy.Validate(model.PasswordCandidate{Password: "aaaaaaaaaa"}); errormsg.GetErrorCode(err) != svcerr.CodePasswordTooCommon {
+		t.Fatalf("got %v", err)
+	}
+	if err := policy.Validate(model.PasswordCandidate{Password: "abcdefghij"}); err != nil {
+		t.Fatalf("got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package passwordpolicy
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+)
+
+// minPersonalInfoLength keeps short names, like "Al", from rejecting half
+// of all passwords.
+const minPersonalInfoLength = 3
+
+// Rule is a single password requirement. Check returns nil when the
+// candidate satisfies it.
+type Rule interface {
+	Check(v model.PasswordCandidate) *model.PasswordViolation
+}
+
+// RuleFunc adapts a function to Rule.
+type RuleFunc func(v model.PasswordCandidate) *model.PasswordViolation
+
+func (f RuleFunc) Check(v model.PasswordCandidate) *model.PasswordViolation {
+	return f(v)
+}
+
+// MinLength and MaxLength count characters, not bytes.
+func MinLength(n int) Rule {
+	return RuleFunc(func(v model.PasswordCandidate) *model.PasswordViolation {
+		if utf8.RuneCountInString(v.Password) < n {
+			return violation(svcerr.AccountSVCInvalidMinimumPassword)
+		}
+		return nil
+	})
+}
+
+func MaxLength(n int) Rule {
+	return RuleFunc(func(v model.PasswordCandidate) *model.PasswordViolation {
+		if utf8.RuneCountInString(v.Password) > n {
+			return violation(svcerr.AccountSVCInvalidMaximumPassword)
+		}
+		return nil
+	})
+}
+
+func Uppercase() Rule {
+	return characterClass(unicode.IsUpper, svcerr.AccountSVCPasswordMissingUppercase)
+}
+
+func Lowercase() Rule {
+	return characterClass(unicode.IsLower, svcerr.AccountSVCPasswordMissingLowercase)
+}
+
+func Digit() Rule {
+	return characterClass(unicode.IsDigit, svcerr.AccountSVCPasswordMissingDigit)
+}
+
+// Symbol accepts any character that is not a letter, digit or space.
+func Symbol() Rule {
+	return characterClass(func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r)
+	}, svcerr.AccountSVCPasswordMissingSymbol)
+}
+
+// CommonPassword rejects passwords found in list, compared
+// case-insensitively. list is expected in lower case.
+func CommonPassword(list []string) Rule {
+	set := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		set[v] = struct{}{}
+	}
+	return RuleFunc(func(v model.PasswordCandidate) *model.PasswordViolation {
+		if _, ok := set[strings.ToLower(v.Password)]; ok {
+			return violation(svcerr.AccountSVCPasswordTooCommon)
+		}
+		return nil
+	})
+}
+
+// NoPersonalInfo rejects passwords containing the local part of the email
+// or any word of the name.
+func NoPersonalInfo() Rule {
+	return RuleFunc(func(v model.PasswordCandidate) *model.PasswordViolation {
+		password := strings.ToLower(v.Password)
+		parts := strings.Fields(strings.ToLower(v.Name))
+		if local, _, ok := strings.Cut(strings.ToLower(v.Email), "@"); ok {
+			parts = append(parts, local)
+		}
+
+		for _, part := range parts {
+			if utf8.RuneCountInString(part) >= minPersonalInfoLength && strings.Contains(password, part) {
+				return violation(svcerr.AccountSVCPasswordContainsPersonalInfo)
+			}
+		}
+		return nil
+	})
+}
+
+func characterClass(match func(rune) bool, msg errormsg.Message) Rule {
+	return RuleFunc(func(v model.PasswordCandidate) *model.PasswordViolation {
+		for _, r := range v.Password {
+			if match(r) {
+				return nil
+			}
+		}
+		return violation(msg)
+	})
+}
+
+func violation(msg errormsg.Message) *model.PasswordViolation {
+	res := model.NewPasswordViolation(msg)
+	return &res
+}
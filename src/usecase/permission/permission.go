@@ -0,0 +1,116 @@
+package permission
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/permission"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+)
+
+type PermissionDep struct {
+	log        logger.Logger
+	conf       Conf
+	permission permission.PermissionInterface
+}
+
+type Conf struct{}
+
+type PermissionInterface interface {
+	Create(ctx *gin.Context, v model.CreatePermission) (model.Permission, error)
+	GetByParam(ctx *gin.Context, cacheControl string, v model.GetPermissionsByParam) ([]model.Permission, model.Pagination, error)
+	GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Permission, error)
+	UpdateByID(ctx *gin.Context, id int64, v model.UpdatePermission) (model.Permission, error)
+	DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error
+}
+
+func New(conf Conf, logger *logger.Logger, permission permission.PermissionInterface) PermissionInterface {
+	return &PermissionDep{
+		conf:       conf,
+		log:        *logger,
+		permission: permission,
+	}
+}
+
+func (p *PermissionDep) Create(ctx *gin.Context, v model.CreatePermission) (model.Permission, error) {
+	var result model.Permission
+	err := v.Validate()
+	if err != nil {
+		return result, err
+	}
+
+	permission := &psqlmodel.Permission{
+		Name:        v.Name,
+		Description: v.Description,
+		CreatedBy:   int(v.CreatedBy),
+		UpdatedBy:   int(v.CreatedBy),
+	}
+
+	err = p.permission.Insert(ctx, permission)
+	if err != nil {
+		return result, err
+	}
+
+	return model.TransformPSQLSinglePermission(permission), nil
+}
+
+func (p *PermissionDep) GetByParam(ctx *gin.Context, cacheControl string, v model.GetPermissionsByParam) ([]model.Permission, model.Pagination, error) {
+	permissionSlice, pagination, err := p.permission.GetByParam(ctx, cacheControl, &v)
+	if err != nil {
+		return []model.Permission{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get by param")
+	}
+	return model.TransformPSQLPermission(&permissionSlice), pagination, nil
+}
+
+func (p *PermissionDep) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.Permission, error) {
+	permission, err := p.permission.GetSingleByParam(ctx, cacheControl, &model.GetPermissionByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.Permission{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "data not found")
+	}
+	return model.TransformPSQLSinglePermission(&permission), nil
+}
+
+// UpdateByID changes a permission. A new name reaches tokens only after the
+// cached names of the roles holding it are dropped.
+func (p *PermissionDep) UpdateByID(ctx *gin.Context, id int64, v model.UpdatePermission) (model.Permission, error) {
+	permission, err := p.permission.GetSingleByParam(ctx, model.MustRevalidate, &model.GetPermissionByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.Permission{}, err
+	}
+
+	if !v.Name.Valid && !v.Description.Valid {
+		return model.TransformPSQLSinglePermission(&permission), nil
+	}
+
+	if err = v.Validate(); err != nil {
+		return model.Permission{}, err
+	}
+
+	v.FillEntity(&permission)
+	permission.UpdatedBy = int(v.UpdatedBy)
+
+	err = p.permission.Update(ctx, &permission)
+	if err != nil {
+		return model.Permission{}, err
+	}
+
+	return model.TransformPSQLSinglePermission(&permission), nil
+}
+
+func (p *PermissionDep) DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error {
+	permission, err := p.permission.GetSingleByParam(ctx, model.MustRevalidate, &model.GetPermissionByParam{
+		ID: null.NewInt64(vid, true),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.permission.Delete(ctx, &permission, id, isHardDelete)
+}
@@ -1,6 +1,8 @@
 package role
 
 import (
+	"strings"
+
 	"github.com/achwanyusuf/carrent-accountsvc/src/domain/role"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model"
 	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
@@ -51,11 +53,15 @@ func (r *RoleDep) Create(ctx *gin.Context, v model.CreateRole) (model.Role, erro
 	}
 
 	role := &psqlmodel.Role{
-		Scope:     v.Scope,
-		Cid:       v.Cid,
-		Sec:       secret,
-		CreatedBy: int(v.CreatedBy),
-		UpdatedBy: int(v.CreatedBy),
+		Scope:              v.Scope,
+		Cid:                v.Cid,
+		Sec:                secret,
+		RedirectUris:       strings.Join(v.RedirectURIs, " "),
+		MfaRequired:        v.MFARequired,
+		PasswordMaxAgeDays: v.PasswordMaxAgeDays,
+		PublicClient:       v.PublicClient,
+		CreatedBy:          int(v.CreatedBy),
+		UpdatedBy:          int(v.CreatedBy),
 	}
 
 	err = r.role.Insert(ctx, role)
@@ -92,10 +98,14 @@ func (r *RoleDep) UpdateByID(ctx *gin.Context, id int64, v model.UpdateRole) (mo
 		return model.Role{}, err
 	}
 
-	if !v.Scope.Valid && !v.Cid.Valid && !v.Sec.Valid {
+	if !v.Scope.Valid && !v.Cid.Valid && !v.Sec.Valid && v.RedirectURIs == nil && !v.MFARequired.Valid && !v.PasswordMaxAgeDays.Valid && !v.PublicClient.Valid {
 		return model.TransformPSQLSingleRole(&role), nil
 	}
 
+	if err = model.ValidateRedirectURIs(v.RedirectURIs); err != nil {
+		return model.Role{}, err
+	}
+
 	if v.Scope.Valid {
 		role.Scope = v.Scope.String
 	}
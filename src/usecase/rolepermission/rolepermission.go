@@ -0,0 +1,106 @@
+package rolepermission
+
+import (
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/permission"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/role"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/rolepermission"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+)
+
+type RolePermissionDep struct {
+	log            logger.Logger
+	conf           Conf
+	role           role.RoleInterface
+	permission     permission.PermissionInterface
+	rolePermission rolepermission.RolePermissionInterface
+}
+
+type Conf struct{}
+
+// RolePermissionInterface grants permissions to roles.
+type RolePermissionInterface interface {
+	Create(ctx *gin.Context, v model.CreateRolePermission) (model.RolePermission, error)
+	GetByParam(ctx *gin.Context, cacheControl string, v model.GetRolePermissionsByParam) ([]model.RolePermission, model.Pagination, error)
+	GetByID(ctx *gin.Context, cacheControl string, id int64) (model.RolePermission, error)
+	DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error
+}
+
+func New(conf Conf, logger *logger.Logger, role role.RoleInterface, permission permission.PermissionInterface, rolePermission rolepermission.RolePermissionInterface) RolePermissionInterface {
+	return &RolePermissionDep{
+		conf:           conf,
+		log:            *logger,
+		role:           role,
+		permission:     permission,
+		rolePermission: rolePermission,
+	}
+}
+
+func (r *RolePermissionDep) Create(ctx *gin.Context, v model.CreateRolePermission) (model.RolePermission, error) {
+	var result model.RolePermission
+	err := v.Validate()
+	if err != nil {
+		return result, err
+	}
+
+	_, err = r.role.GetSingleByParam(ctx, model.MustRevalidate, &model.GetRoleByParam{
+		ID: null.NewInt64(v.RoleID, true),
+	})
+	if err != nil {
+		return result, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "role not found")
+	}
+
+	_, err = r.permission.GetSingleByParam(ctx, model.MustRevalidate, &model.GetPermissionByParam{
+		ID: null.NewInt64(v.PermissionID, true),
+	})
+	if err != nil {
+		return result, errormsg.WrapErr(svcerr.AccountSVCInvalidPermission, err, "permission not found")
+	}
+
+	rolePermission := &psqlmodel.RolePermission{
+		RoleID:       int(v.RoleID),
+		PermissionID: int(v.PermissionID),
+		CreatedBy:    int(v.CreatedBy),
+		UpdatedBy:    int(v.CreatedBy),
+	}
+
+	err = r.rolePermission.Insert(ctx, rolePermission)
+	if err != nil {
+		return result, err
+	}
+
+	return model.TransformPSQLSingleRolePermission(rolePermission), nil
+}
+
+func (r *RolePermissionDep) GetByParam(ctx *gin.Context, cacheControl string, v model.GetRolePermissionsByParam) ([]model.RolePermission, model.Pagination, error) {
+	rolePermissionSlice, pagination, err := r.rolePermission.GetByParam(ctx, cacheControl, &v)
+	if err != nil {
+		return []model.RolePermission{}, model.Pagination{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error get by param")
+	}
+	return model.TransformPSQLRolePermission(&rolePermissionSlice), pagination, nil
+}
+
+func (r *RolePermissionDep) GetByID(ctx *gin.Context, cacheControl string, id int64) (model.RolePermission, error) {
+	rolePermission, err := r.rolePermission.GetSingleByParam(ctx, cacheControl, &model.GetRolePermissionByParam{
+		ID: null.NewInt64(id, true),
+	})
+	if err != nil {
+		return model.RolePermission{}, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "data not found")
+	}
+	return model.TransformPSQLSingleRolePermission(&rolePermission), nil
+}
+
+func (r *RolePermissionDep) DeleteByID(ctx *gin.Context, id int64, isHardDelete bool, vid int64) error {
+	rolePermission, err := r.rolePermission.GetSingleByParam(ctx, model.MustRevalidate, &model.GetRolePermissionByParam{
+		ID: null.NewInt64(vid, true),
+	})
+	if err != nil {
+		return err
+	}
+	return r.rolePermission.Delete(ctx, &rolePermission, id, isHardDelete)
+}
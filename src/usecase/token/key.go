@@ -0,0 +1,135 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/common"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/hash"
+	"github.com/golang-jwt/jwt"
+)
+
+type key struct {
+	kid       string
+	method    jwt.SigningMethod
+	private   crypto.PrivateKey
+	public    crypto.PublicKey
+	createdAt time.Time
+}
+
+func (k *key) jwk() model.JWK {
+	res := model.JWK{
+		Use: model.KeyUseSignature,
+		Kid: k.kid,
+		Alg: k.method.Alg(),
+	}
+	switch pub := k.public.(type) {
+	case *rsa.PublicKey:
+		res.Kty = model.KeyTypeRSA
+		res.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		res.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		res.Kty = model.KeyTypeOKP
+		res.Crv = model.CurveEd25519
+		res.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return res
+}
+
+func (t *TokenDep) generateKey(expiredAt time.Time) (*psqlmodel.SigningKey, error) {
+	var (
+		private crypto.PrivateKey
+		public  crypto.PublicKey
+		err     error
+	)
+	switch t.conf.Algorithm {
+	case model.AlgorithmRS256:
+		var rsaKey *rsa.PrivateKey
+		rsaKey, err = rsa.GenerateKey(rand.Reader, t.conf.RSAKeySize)
+		if err == nil {
+			private, public = rsaKey, &rsaKey.PublicKey
+		}
+	case model.AlgorithmEdDSA:
+		public, private, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "unsupported signing algorithm "+t.conf.Algorithm)
+	}
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate signing key")
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal private key")
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error marshal public key")
+	}
+
+	privatePEM, err := hash.EncAES(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})), t.conf.AESSecret)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error encrypt private key")
+	}
+
+	kid, err := common.GenerateRandomToken(16)
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate kid")
+	}
+
+	return &psqlmodel.SigningKey{
+		Kid:        kid,
+		Algorithm:  t.conf.Algorithm,
+		PrivateKey: privatePEM,
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+		ExpiredAt:  expiredAt,
+	}, nil
+}
+
+func (t *TokenDep) parseKey(data *psqlmodel.SigningKey) (*key, error) {
+	method := jwt.GetSigningMethod(data.Algorithm)
+	if method == nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "unsupported signing algorithm "+data.Algorithm)
+	}
+
+	privatePEM, err := hash.DecAES(data.PrivateKey, t.conf.AESSecret)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(privatePEM))
+	if block == nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "invalid private key pem")
+	}
+	private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ = pem.Decode([]byte(data.PublicKey))
+	if block == nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "invalid public key pem")
+	}
+	public, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key{
+		kid:       data.Kid,
+		method:    method,
+		private:   private,
+		public:    public,
+		createdAt: data.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+)
+
+func TestGenerateKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm string
+		kty       string
+		crv       string
+	}{
+		{name: "rs256", algorithm: model.AlgorithmRS256, kty: model.KeyTypeRSA},
+		{name: "eddsa", algorithm: model.AlgorithmEdDSA, kty: model.KeyTypeOKP, crv: model.CurveEd25519},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tok, _, _ := newTestToken(t, Conf{Algorithm: tt.algorithm})
+			expiredAt := time.Now().Add(time.Hour)
+			data, err := tok.generateKey(expiredAt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if data.Algorithm != tt.algorithm || data.Kid == "" || !data.ExpiredAt.Equal(expiredAt) {
+				t.Fatalf("got %+v", data)
+			}
+			// only the public half is stored in the clear
+			if strings.Contains(data.PrivateKey, "PRIVATE KEY") {
+				t.Fatal("private key stored unencrypted")
+			}
+			if !strings.HasPrefix(data.PublicKey, "-----BEGIN PUBLIC KEY-----") {
+				t.Fatalf("public key %q", data.PublicKey)
+			}
+
+			k, err := tok.parseKey(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if k.kid != data.Kid || k.method.Alg() != tt.algorithm {
+				t.Fatalf("got kid %s alg %s", k.kid, k.method.Alg())
+			}
+			switch pub := k.public.(type) {
+			case *rsa.PublicKey:
+				if !pub.Equal(&k.private.(*rsa.PrivateKey).PublicKey) {
+					t.Fatal("public key does not match the private key")
+				}
+			case ed25519.PublicKey:
+				if !pub.Equal(k.private.(ed25519.PrivateKey).Public()) {
+					t.Fatal("public key does not match the private key")
+				}
+			}
+
+			jwk := k.jwk()
+			if jwk.Kid != data.Kid || jwk.Alg != tt.algorithm || jwk.Use != model.KeyUseSignature || jwk.Kty != tt.kty || jwk.Crv != tt.crv {
+				t.Fatalf("got %+v", jwk)
+			}
+		})
+	}
+}
+
+func TestGenerateKeyUnsupportedAlgorithm(t *testing.T) {
+	tok, _, _ := newTestToken(t, Conf{Algorithm: "HS256"})
+	if _, err := tok.generateKey(time.Now().Add(time.Hour)); err == nil {
+		t.Fatal("generated a key for HS256")
+	}
+}
+
+func TestParseKeyWrongSecret(t *testing.T) {
+	tok, _, _ := newTestToken(t, Conf{Algorithm: model.AlgorithmEdDSA})
+	data, err := tok.generateKey(time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, _, _ := newTestToken(t, Conf{Algorithm: model.AlgorithmEdDSA, AESSecret: "fedcba9876543210fedcba9876543210"})
+	if _, err = other.parseKey(data); err == nil {
+		t.Fatal("private key decrypted with another secret")
+	}
+}
@@ -0,0 +1,420 @@
+package token
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/denylist"
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/signingkey"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+)
+
+type TokenDep struct {
+	log        logger.Logger
+	conf       Conf
+	signingKey signingkey.SigningKeyInterface
+	denyList   denylist.DenyListInterface
+
+	mu       sync.RWMutex
+	signer   *key
+	keys     map[string]*key
+	loadedAt time.Time
+}
+
+type Conf struct {
+	Algorithm        string        `mapstructure:"algorithm"`
+	RSAKeySize       int           `mapstructure:"rsa_key_size"`
+	RotationInterval time.Duration `mapstructure:"rotation_interval"`
+	RetentionPeriod  time.Duration `mapstructure:"retention_period"`
+	RefreshInterval  time.Duration `mapstructure:"refresh_interval"`
+	AESSecret        string        `mapstructure:"aes_secret"`
+	Issuer           string        `mapstructure:"issuer"`
+}
+
+// TokenInterface signs and verifies JWTs with the asymmetric key set stored
+// in postgres. Every instance loads the same keys, so a token signed by one
+// instance can be verified by any other and by downstream services via JWKS.
+// The typ header tells token kinds apart, and Parse only accepts access tokens.
+// Restricted tokens have a typ of their own so that neither Parse nor a
+// downstream service checking for an access token accepts them.
+type TokenInterface interface {
+	Sign(ctx context.Context, typ string, claims jwt.MapClaims) (string, error)
+	Parse(ctx context.Context, token string) (jwt.MapClaims, error)
+	ParseRestricted(ctx context.Context, token string) (jwt.MapClaims, error)
+	Verify(ctx context.Context, typ string, token string) (jwt.MapClaims, error)
+	Consume(ctx context.Context, claims jwt.MapClaims) error
+	JWKS(ctx context.Context) (model.JWKS, error)
+	Revoke(ctx context.Context, claims jwt.MapClaims) error
+	RevokeAccount(ctx context.Context, accountID int64, ttl time.Duration) error
+	Issuer() string
+	Algorithm() string
+	Rotate(ctx context.Context) error
+	Run(ctx context.Context)
+}
+
+func New(conf Conf, logger *logger.Logger, signingKey signingkey.SigningKeyInterface, denyList denylist.DenyListInterface) TokenInterface {
+	if conf.Algorithm == "" {
+		conf.Algorithm = model.AlgorithmRS256
+	}
+	if conf.RSAKeySize == 0 {
+		conf.RSAKeySize = model.DefaultRSAKeySize
+	}
+	if conf.RotationInterval == 0 {
+		conf.RotationInterval = model.DefaultKeyRotationInterval
+	}
+	if conf.RetentionPeriod == 0 {
+		conf.RetentionPeriod = model.DefaultKeyRetentionPeriod
+	}
+	if conf.RefreshInterval == 0 {
+		conf.RefreshInterval = model.DefaultKeySetRefreshInterval
+	}
+	return &TokenDep{
+		conf:       conf,
+		log:        *logger,
+		signingKey: signingKey,
+		denyList:   denyList,
+		keys:       map[string]*key{},
+	}
+}
+
+func (t *TokenDep) Sign(ctx context.Context, typ string, claims jwt.MapClaims) (string, error) {
+	signer, err := t.currentSigner(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if _, ok := claims["iss"]; !ok && t.conf.Issuer != "" {
+		claims["iss"] = t.conf.Issuer
+	}
+	if _, ok := claims["iat"]; !ok {
+		claims["iat"] = time.Now().Unix()
+	}
+	if _, ok := claims["jti"]; !ok {
+		// a version 7 jti carries the issue time to the millisecond, which
+		// isRevoked compares against account revocations
+		jti, err := uuid.NewV7()
+		if err != nil {
+			return "", errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error generate jti")
+		}
+		claims["jti"] = jti.String()
+	}
+
+	token := jwt.NewWithClaims(signer.method, claims)
+	token.Header["kid"] = signer.kid
+	token.Header["typ"] = typ
+	res, err := token.SignedString(signer.private)
+	if err != nil {
+		return "", errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error sign token")
+	}
+	return res, nil
+}
+
+func (t *TokenDep) Parse(ctx context.Context, tokenStr string) (jwt.MapClaims, error) {
+	return t.parse(ctx, model.JWTTypeAccessToken, tokenStr)
+}
+
+// ParseRestricted accepts only restricted tokens, for the routes listed in
+// model.RestrictedRoutes. A restricted token without a restriction claim
+// would be let through everywhere by the caller, so it is rejected.
+func (t *TokenDep) ParseRestricted(ctx context.Context, tokenStr string) (jwt.MapClaims, error) {
+	claims, err := t.parse(ctx, model.JWTTypeRestrictedToken, tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	if restriction, _ := claims["restriction"].(string); restriction == "" {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "restricted token has no restriction")
+	}
+	return claims, nil
+}
+
+func (t *TokenDep) parse(ctx context.Context, typ string, tokenStr string) (jwt.MapClaims, error) {
+	claims, err := t.Verify(ctx, typ, tokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := t.isRevoked(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "token revoked")
+	}
+	return claims, nil
+}
+
+// Verify checks the signature, typ header, expiry and issuer of a token of
+// any kind. It does not consult the deny-list; use Parse for access tokens
+// and ParseRestricted for restricted tokens.
+func (t *TokenDep) Verify(ctx context.Context, typ string, tokenStr string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		k, err := t.verificationKey(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != k.method.Alg() {
+			return nil, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "unexpected signing method")
+		}
+		if header, _ := token.Header["typ"].(string); header != typ {
+			return nil, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "unexpected token type")
+		}
+		return k.public, nil
+	})
+	if err != nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, err, "invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "invalid claims")
+	}
+
+	if t.conf.Issuer != "" && !claims.VerifyIssuer(t.conf.Issuer, true) {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "invalid issuer")
+	}
+	return claims, nil
+}
+
+// Consume marks a single-use token as used. It fails when the token was
+// already consumed, so only one of several concurrent callers succeeds.
+func (t *TokenDep) Consume(ctx context.Context, claims jwt.MapClaims) error {
+	jti, _ := claims["jti"].(string)
+	exp, _ := claims["exp"].(float64)
+	if jti == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "token has no jti")
+	}
+
+	ok, err := t.denyList.ConsumeToken(ctx, jti, time.Until(time.Unix(int64(exp), 0)))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "token already used")
+	}
+	return nil
+}
+
+// Revoke puts the token on the deny-list until it expires.
+func (t *TokenDep) Revoke(ctx context.Context, claims jwt.MapClaims) error {
+	jti, _ := claims["jti"].(string)
+	exp, _ := claims["exp"].(float64)
+	if jti == "" {
+		return errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "token has no jti")
+	}
+	return t.denyList.RevokeToken(ctx, jti, time.Until(time.Unix(int64(exp), 0)))
+}
+
+// RevokeAccount rejects every token of the account issued before now, or
+// within the current millisecond. ttl must cover the lifetime of the longest
+// access token still in circulation.
+func (t *TokenDep) RevokeAccount(ctx context.Context, accountID int64, ttl time.Duration) error {
+	return t.denyList.RevokeAccount(ctx, accountID, time.Now(), ttl)
+}
+
+// isRevoked checks the deny-list. A token that cannot be checked is not
+// accepted, so the lookup errors fail the request rather than letting a
+// revoked token through while redis is unavailable.
+func (t *TokenDep) isRevoked(ctx context.Context, claims jwt.MapClaims) (bool, error) {
+	if jti, ok := claims["jti"].(string); ok {
+		revoked, err := t.denyList.IsTokenRevoked(ctx, jti)
+		if err != nil {
+			return false, errormsg.WrapErr(svcerr.AccountSVCServiceUnavailable, err, "error check revoked token")
+		}
+		if revoked {
+			return true, nil
+		}
+	}
+
+	id, ok := claims["id"].(float64)
+	if !ok {
+		return false, nil
+	}
+	revokedAt, err := t.denyList.GetAccountRevokedAt(ctx, int64(id))
+	if err != nil {
+		return false, errormsg.WrapErr(svcerr.AccountSVCServiceUnavailable, err, "error check revoked account")
+	}
+	return !issuedAt(claims).After(revokedAt), nil
+}
+
+// issuedAt returns when the token was issued, to the millisecond from a
+// version 7 jti. Other tokens fall back to iat, whose second precision
+// makes a token issued within the second of a revocation count as
+// predating it.
+func issuedAt(claims jwt.MapClaims) time.Time {
+	if jti, ok := claims["jti"].(string); ok {
+		if id, err := uuid.Parse(jti); err == nil && id.Version() == 7 {
+			return time.Unix(id.Time().UnixTime())
+		}
+	}
+	iat, _ := claims["iat"].(float64)
+	return time.Unix(int64(iat), 0)
+}
+
+func (t *TokenDep) Issuer() string {
+	return t.conf.Issuer
+}
+
+func (t *TokenDep) Algorithm() string {
+	return t.conf.Algorithm
+}
+
+func (t *TokenDep) JWKS(ctx context.Context) (model.JWKS, error) {
+	res := model.JWKS{Keys: []model.JWK{}}
+	if _, err := t.currentSigner(ctx); err != nil {
+		return res, err
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, k := range t.keys {
+		res.Keys = append(res.Keys, k.jwk())
+	}
+	return res, nil
+}
+
+// Rotate generates a new signing key. Older keys stay published until
+// they expire so tokens they signed can still be verified.
+func (t *TokenDep) Rotate(ctx context.Context) error {
+	now := time.Now()
+	data, err := t.generateKey(now.Add(t.conf.RotationInterval + t.conf.RetentionPeriod))
+	if err != nil {
+		return err
+	}
+
+	err = t.signingKey.Insert(ctx, data)
+	if err != nil {
+		return err
+	}
+	t.log.Info(ctx, "signing key rotated, new kid "+data.Kid)
+	return t.load(ctx)
+}
+
+// Run reloads the key set periodically and rotates the signing key once it
+// is older than the rotation interval.
+func (t *TokenDep) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.conf.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := t.rotateIfDue(ctx); err != nil {
+			t.log.Error(ctx, errormsg.WriteErr(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *TokenDep) rotateIfDue(ctx context.Context) error {
+	if err := t.load(ctx); err != nil {
+		return err
+	}
+
+	t.mu.RLock()
+	signer := t.signer
+	t.mu.RUnlock()
+	if signer != nil && time.Since(signer.createdAt) < t.conf.RotationInterval {
+		return nil
+	}
+	return t.Rotate(ctx)
+}
+
+func (t *TokenDep) currentSigner(ctx context.Context) (*key, error) {
+	t.mu.RLock()
+	signer := t.signer
+	t.mu.RUnlock()
+	if signer != nil {
+		return signer, nil
+	}
+
+	if err := t.load(ctx); err != nil {
+		return nil, err
+	}
+
+	t.mu.RLock()
+	signer = t.signer
+	t.mu.RUnlock()
+	if signer != nil {
+		return signer, nil
+	}
+
+	if err := t.Rotate(ctx); err != nil {
+		return nil, err
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.signer == nil {
+		return nil, errormsg.WrapErr(svcerr.AccountSVCBadRequest, nil, "no signing key available")
+	}
+	return t.signer, nil
+}
+
+// verificationKey looks kid up in the loaded key set. An unknown kid may
+// have been created by another instance, so the set is reloaded once.
+func (t *TokenDep) verificationKey(ctx context.Context, kid string) (*key, error) {
+	t.mu.RLock()
+	k, ok := t.keys[kid]
+	loadedAt := t.loadedAt
+	t.mu.RUnlock()
+	if ok {
+		return k, nil
+	}
+
+	if time.Since(loadedAt) > time.Second {
+		if err := t.load(ctx); err != nil {
+			return nil, err
+		}
+		t.mu.RLock()
+		k, ok = t.keys[kid]
+		t.mu.RUnlock()
+		if ok {
+			return k, nil
+		}
+	}
+	return nil, errormsg.WrapErr(svcerr.AccountSVCNotAuthorized, nil, "unknown kid")
+}
+
+func (t *TokenDep) load(ctx context.Context) error {
+	now := time.Now()
+	data, err := t.signingKey.GetByParam(ctx, &model.GetSigningKeysByParam{
+		ExpiredAfter: null.TimeFrom(now),
+	})
+	if err != nil {
+		return err
+	}
+
+	var signer *key
+	keys := make(map[string]*key, len(data))
+	for _, v := range data {
+		k, err := t.parseKey(v)
+		if err != nil {
+			t.log.Warn(ctx, errormsg.WrapErr(svcerr.AccountSVCBadRequest, err, "error parse signing key "+v.Kid))
+			continue
+		}
+		keys[k.kid] = k
+		if k.method.Alg() != t.conf.Algorithm {
+			continue
+		}
+		if signer == nil || k.createdAt.After(signer.createdAt) {
+			signer = k
+		}
+	}
+
+	t.mu.Lock()
+	t.signer = signer
+	t.keys = keys
+	t.loadedAt = now
+	t.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,377 @@
+package token
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/achwanyusuf/carrent-accountsvc/src/domain/denylist"
+	mock_signingkey "github.com/achwanyusuf/carrent-accountsvc/src/domain/mock/signingkey"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/psqlmodel"
+	"github.com/achwanyusuf/carrent-accountsvc/src/model/svcerr"
+	"github.com/achwanyusuf/carrent-lib/pkg/errormsg"
+	"github.com/achwanyusuf/carrent-lib/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	goredislib "github.com/redis/go-redis/v9"
+)
+
+const (
+	testIssuer    = "https://account.example.com"
+	testAESSecret = "0123456789abcdef0123456789abcdef"
+)
+
+// keyStore keeps the keys inserted through the signing key mock, and lists
+// them the way the postgres query does.
+type keyStore struct {
+	keys psqlmodel.SigningKeySlice
+}
+
+// newTestToken returns the usecase on a signing key mock backed by a
+// keyStore, and on a deny-list in miniredis.
+func newTestToken(t *testing.T, conf Conf) (*TokenDep, *keyStore, *miniredis.Miniredis) {
+	t.Helper()
+	if conf.AESSecret == "" {
+		conf.AESSecret = testAESSecret
+	}
+	if conf.RSAKeySize == 0 {
+		conf.RSAKeySize = 1024
+	}
+
+	store := &keyStore{}
+	signingKey := mock_signingkey.NewMockSigningKeyInterface(gomock.NewController(t))
+	signingKey.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, v *psqlmodel.SigningKey) error {
+		v.CreatedAt = time.Now()
+		store.keys = append(store.keys, v)
+		return nil
+	}).AnyTimes()
+	signingKey.EXPECT().GetByParam(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, param *model.GetSigningKeysByParam) (psqlmodel.SigningKeySlice, error) {
+		var res psqlmodel.SigningKeySlice
+		for _, v := range store.keys {
+			if !param.ExpiredAfter.Valid || v.ExpiredAt.After(param.ExpiredAfter.Time) {
+				res = append(res, v)
+			}
+		}
+		return res, nil
+	}).AnyTimes()
+
+	mr := miniredis.RunT(t)
+	rds := goredislib.NewClient(&goredislib.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rds.Close() })
+
+	log := logger.New(&logger.Config{Level: logger.LevelError})
+	tok := New(conf, &log, signingKey, denylist.New(denylist.Conf{}, &log, rds)).(*TokenDep)
+	return tok, store, mr
+}
+
+// testClaims are typed as parsed claims are, Revoke reads them that way.
+func testClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"id":  float64(7),
+		"sub": "7",
+		"exp": float64(time.Now().Add(time.Hour).Unix()),
+	}
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  string
+		// claims changes the claims the token is signed with
+		claims func(jwt.MapClaims)
+		// other signs the token with the key of another key set
+		other bool
+		ok    bool
+	}{
+		{name: "access token", typ: model.JWTTypeAccessToken, ok: true},
+		{name: "other typ", typ: model.JWTTypeRestrictedToken},
+		{
+			name:   "no issuer",
+			typ:    model.JWTTypeAccessToken,
+			claims: func(c jwt.MapClaims) { c["iss"] = nil },
+		},
+		{
+			name:   "other issuer",
+			typ:    model.JWTTypeAccessToken,
+			claims: func(c jwt.MapClaims) { c["iss"] = "https://other.example.com" },
+		},
+		{
+			name:   "expired",
+			typ:    model.JWTTypeAccessToken,
+			claims: func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		},
+		{name: "unknown kid", typ: model.JWTTypeAccessToken, other: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			conf := Conf{Algorithm: model.AlgorithmEdDSA, Issuer: testIssuer}
+			tok, _, _ := newTestToken(t, conf)
+			signer := tok
+			if tt.other {
+				signer, _, _ = newTestToken(t, conf)
+			}
+
+			claims := testClaims()
+			if tt.claims != nil {
+				tt.claims(claims)
+			}
+			s, err := signer.Sign(ctx, model.JWTTypeAccessToken, claims)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := tok.Verify(ctx, tt.typ, s)
+			if !tt.ok {
+				if code := errormsg.GetErrorCode(err); code != svcerr.CodeNotAuthorized {
+					t.Fatalf("got code %d (%v), want %d", code, err, svcerr.CodeNotAuthorized)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res["iss"] != testIssuer || res["sub"] != "7" || res["jti"] == "" {
+				t.Fatalf("got %v", res)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		typ        string
+		claims     jwt.MapClaims
+		restricted bool
+		ok         bool
+	}{
+		{name: "access token", typ: model.JWTTypeAccessToken, ok: true},
+		{name: "restricted token", typ: model.JWTTypeRestrictedToken, claims: jwt.MapClaims{"restriction": model.RestrictionMFAEnrollment}},
+		{name: "id token", typ: model.JWTTypeIDToken},
+		{name: "restricted token on a restricted route", typ: model.JWTTypeRestrictedToken, claims: jwt.MapClaims{"restriction": model.RestrictionMFAEnrollment}, restricted: true, ok: true},
+		{name: "access token on a restricted route", typ: model.JWTTypeAccessToken, restricted: true},
+		{name: "restricted token without restriction", typ: model.JWTTypeRestrictedToken, restricted: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tok, _, _ := newTestToken(t, Conf{Algorithm: model.AlgorithmEdDSA})
+			claims := testClaims()
+			for k, v := range tt.claims {
+				claims[k] = v
+			}
+			s, err := tok.Sign(ctx, tt.typ, claims)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			parse := tok.Parse
+			if tt.restricted {
+				parse = tok.ParseRestricted
+			}
+			_, err = parse(ctx, s)
+			if tt.ok != (err == nil) {
+				t.Fatalf("got %v, want ok %t", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestIsRevoked(t *testing.T) {
+	tests := []struct {
+		name string
+		// revoke runs before the token is signed when before is set
+		revoke func(ctx context.Context, tok *TokenDep, claims jwt.MapClaims) error
+		before bool
+		// down stops redis before the token is parsed
+		down bool
+		code int64
+	}{
+		{name: "not revoked"},
+		{
+			name: "token revoked",
+			revoke: func(ctx context.Context, tok *TokenDep, claims jwt.MapClaims) error {
+				return tok.Revoke(ctx, claims)
+			},
+			code: svcerr.CodeNotAuthorized,
+		},
+		{
+			name: "account revoked after issue",
+			revoke: func(ctx context.Context, tok *TokenDep, _ jwt.MapClaims) error {
+				return tok.RevokeAccount(ctx, 7, time.Hour)
+			},
+			code: svcerr.CodeNotAuthorized,
+		},
+		{
+			name: "account revoked before issue",
+			revoke: func(ctx context.Context, tok *TokenDep, _ jwt.MapClaims) error {
+				return tok.RevokeAccount(ctx, 7, time.Hour)
+			},
+			before: true,
+		},
+		{name: "redis down", down: true, code: svcerr.CodeServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tok, _, mr := newTestToken(t, Conf{Algorithm: model.AlgorithmEdDSA})
+			claims := testClaims()
+			if tt.before {
+				if err := tt.revoke(ctx, tok, claims); err != nil {
+					t.Fatal(err)
+				}
+				// the jti is compared to the revocation to the millisecond
+				time.Sleep(2 * time.Millisecond)
+			}
+			s, err := tok.Sign(ctx, model.JWTTypeAccessToken, claims)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.revoke != nil && !tt.before {
+				if err = tt.revoke(ctx, tok, claims); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.down {
+				mr.Close()
+			}
+
+			// a token that cannot be checked is not accepted
+			_, err = tok.Parse(ctx, s)
+			if tt.code != 0 {
+				if code := errormsg.GetErrorCode(err); code != tt.code {
+					t.Fatalf("got code %d (%v), want %d", code, err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestIssuedAt(t *testing.T) {
+	v7, err := uuid.NewV7()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		want   time.Time
+	}{
+		{
+			name:   "version 7 jti",
+			claims: jwt.MapClaims{"jti": v7.String(), "iat": float64(1000)},
+			want:   time.Unix(v7.Time().UnixTime()),
+		},
+		{
+			name:   "version 4 jti",
+			claims: jwt.MapClaims{"jti": uuid.NewString(), "iat": float64(1000)},
+			want:   time.Unix(1000, 0),
+		},
+		{
+			name:   "no jti",
+			claims: jwt.MapClaims{"iat": float64(1000)},
+			want:   time.Unix(1000, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := issuedAt(tt.claims); !got.Equal(tt.want) {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	ctx := context.Background()
+	tok, store, _ := newTestToken(t, Conf{Algorithm: model.AlgorithmRS256, RotationInterval: time.Hour, RetentionPeriod: time.Hour})
+
+	old, err := tok.Sign(ctx, model.JWTTypeAccessToken, testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tok.Rotate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	current, err := tok.Sign(ctx, model.JWTTypeAccessToken, testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid(t, old) == kid(t, current) {
+		t.Fatal("signed with the old key after rotation")
+	}
+
+	// the old key is still published and accepted until it expires
+	if _, err = tok.Verify(ctx, model.JWTTypeAccessToken, old); err != nil {
+		t.Fatal(err)
+	}
+	jwks, err := tok.JWKS(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("got %d keys, want 2", len(jwks.Keys))
+	}
+
+	store.keys[0].ExpiredAt = time.Now().Add(-time.Second)
+	if err = tok.load(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tok.Verify(ctx, model.JWTTypeAccessToken, old); err == nil {
+		t.Fatal("accepted a token of an expired key")
+	}
+	if _, err = tok.Verify(ctx, model.JWTTypeAccessToken, current); err != nil {
+		t.Fatal(err)
+	}
+	jwks, err = tok.JWKS(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jwks.Keys) != 1 || jwks.Keys[0].Kid != kid(t, current) {
+		t.Fatalf("got %+v", jwks.Keys)
+	}
+}
+
+func TestRotateIfDue(t *testing.T) {
+	ctx := context.Background()
+	tok, store, _ := newTestToken(t, Conf{Algorithm: model.AlgorithmEdDSA, RotationInterval: time.Hour})
+
+	// the first run creates the key set, the next ones keep a fresh key
+	for i := 0; i < 2; i++ {
+		if err := tok.rotateIfDue(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(store.keys) != 1 {
+		t.Fatalf("got %d keys, want 1", len(store.keys))
+	}
+
+	store.keys[0].CreatedAt = time.Now().Add(-2 * time.Hour)
+	if err := tok.rotateIfDue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.keys) != 2 {
+		t.Fatalf("got %d keys, want 2", len(store.keys))
+	}
+	if tok.signer.kid != store.keys[1].Kid {
+		t.Fatalf("signing with %s, want the newest key %s", tok.signer.kid, store.keys[1].Kid)
+	}
+}
+
+func kid(t *testing.T, s string) string {
+	t.Helper()
+	token, _, err := new(jwt.Parser).ParseUnverified(s, jwt.MapClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, _ := token.Header["kid"].(string)
+	return res
+}
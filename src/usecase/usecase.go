@@ -4,7 +4,19 @@ import (
 	"github.com/achwanyusuf/carrent-accountsvc/src/domain"
 	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/account"
 	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/accountrole"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/apikey"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/auditlog"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/grantexpiry"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/impersonation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/invitation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/organisation"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/organisationmember"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/passwordhash"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/passwordpolicy"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/permission"
 	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/role"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/rolepermission"
+	"github.com/achwanyusuf/carrent-accountsvc/src/usecase/token"
 	"github.com/achwanyusuf/carrent-lib/pkg/logger"
 )
 
@@ -15,21 +27,57 @@ type UsecaseDep struct {
 }
 
 type Config struct {
-	Account     account.Conf     `mapstructure:"account"`
-	Role        role.Conf        `mapstructure:"role"`
-	AccountRole accountrole.Conf `mapstructure:"account_role"`
+	Account            account.Conf            `mapstructure:"account"`
+	Role               role.Conf               `mapstructure:"role"`
+	AccountRole        accountrole.Conf        `mapstructure:"account_role"`
+	Token              token.Conf              `mapstructure:"token"`
+	PasswordPolicy     passwordpolicy.Conf     `mapstructure:"password_policy"`
+	PasswordHash       passwordhash.Conf       `mapstructure:"password_hash"`
+	Permission         permission.Conf         `mapstructure:"permission"`
+	RolePermission     rolepermission.Conf     `mapstructure:"role_permission"`
+	Organisation       organisation.Conf       `mapstructure:"organisation"`
+	OrganisationMember organisationmember.Conf `mapstructure:"organisation_member"`
+	Invitation         invitation.Conf         `mapstructure:"invitation"`
+	AuditLog           auditlog.Conf           `mapstructure:"audit_log"`
+	Impersonation      impersonation.Conf      `mapstructure:"impersonation"`
+	APIKey             apikey.Conf             `mapstructure:"api_key"`
+	GrantExpiry        grantexpiry.Conf        `mapstructure:"grant_expiry"`
 }
 
 type UsecaseInterface struct {
-	Account     account.AccountInterface
-	Role        role.RoleInterface
-	AccountRole accountrole.AccountRoleInterface
+	Account            account.AccountInterface
+	Role               role.RoleInterface
+	AccountRole        accountrole.AccountRoleInterface
+	Token              token.TokenInterface
+	Permission         permission.PermissionInterface
+	RolePermission     rolepermission.RolePermissionInterface
+	Organisation       organisation.OrganisationInterface
+	OrganisationMember organisationmember.OrganisationMemberInterface
+	Invitation         invitation.InvitationInterface
+	AuditLog           auditlog.AuditLogInterface
+	Impersonation      impersonation.ImpersonationInterface
+	APIKey             apikey.APIKeyInterface
+	GrantExpiry        grantexpiry.GrantExpiryInterface
 }
 
 func New(u *UsecaseDep) *UsecaseInterface {
+	tokenUsecase := token.New(u.Conf.Token, u.Log, u.Domain.SigningKey, u.Domain.DenyList)
+	passwordPolicy := passwordpolicy.New(u.Conf.PasswordPolicy, u.Log)
+	passwordHash := passwordhash.New(u.Conf.PasswordHash, u.Log)
+	accountUsecase := account.New(u.Conf.Account, u.Log, u.Domain.Account, u.Domain.Role, u.Domain.AccountRole, u.Domain.RefreshToken, tokenUsecase, u.Domain.AuthCode, u.Domain.Mailer, u.Domain.RateLimit, u.Domain.PasswordReset, u.Domain.RecoveryCode, u.Domain.LoginAttempt, passwordPolicy, u.Domain.PasswordHistory, passwordHash, u.Domain.RolePermission, u.Domain.OrganisationMember, u.Domain.APIKey)
 	return &UsecaseInterface{
-		account.New(u.Conf.Account, u.Log, u.Domain.Account, u.Domain.Role, u.Domain.AccountRole),
+		accountUsecase,
 		role.New(u.Conf.Role, u.Log, u.Domain.Role),
-		accountrole.New(u.Conf.AccountRole, u.Log, u.Domain.AccountRole),
+		accountrole.New(u.Conf.AccountRole, u.Log, u.Domain.AccountRole, u.Domain.Role, u.Domain.OrganisationMember),
+		tokenUsecase,
+		permission.New(u.Conf.Permission, u.Log, u.Domain.Permission),
+		rolepermission.New(u.Conf.RolePermission, u.Log, u.Domain.Role, u.Domain.Permission, u.Domain.RolePermission),
+		organisation.New(u.Conf.Organisation, u.Log, u.Domain.Organisation, u.Domain.OrganisationMember),
+		organisationmember.New(u.Conf.OrganisationMember, u.Log, u.Domain.Account, u.Domain.AccountRole, u.Domain.Organisation, u.Domain.OrganisationMember),
+		invitation.New(u.Conf.Invitation, u.Log, u.Domain.Account, u.Domain.Role, u.Domain.Organisation, u.Domain.Invitation, tokenUsecase, u.Domain.Mailer, passwordPolicy, passwordHash, accountUsecase),
+		auditlog.New(u.Conf.AuditLog, u.Log, u.Domain.AuditLog),
+		impersonation.New(u.Conf.Impersonation, u.Log, u.Domain.Account, u.Domain.AuditLog, tokenUsecase, accountUsecase),
+		apikey.New(u.Conf.APIKey, u.Log, u.Domain.Account, u.Domain.APIKey, accountUsecase),
+		grantexpiry.New(u.Conf.GrantExpiry, u.Log, u.Domain.AccountRole, accountUsecase),
 	}
 }